@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/zilliztech/milvus-backup/core"
+	"github.com/zilliztech/milvus-backup/core/paramtable"
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+var (
+	cleanupDryRun            bool
+	cleanupGracePeriod       int64
+	cleanupContinuationToken string
+	cleanupLimit             int32
+)
+
+var cleanupCmd = &cobra.Command{
+	Use:   "cleanup",
+	Short: "cleanup subcommand remove orphan partial backups left by crashed backups.",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		var params paramtable.BackupParams
+		params.GlobalInitWithYaml(config)
+		params.Init()
+
+		context := context.Background()
+		backupContext := core.CreateBackupContext(context, params)
+
+		resp := backupContext.CleanupOrphans(context, &backuppb.CleanupOrphansRequest{
+			DryRun:             cleanupDryRun,
+			GracePeriodSeconds: cleanupGracePeriod,
+			ContinuationToken:  cleanupContinuationToken,
+			Limit:              cleanupLimit,
+		})
+
+		fmt.Println(resp.GetMsg())
+		if cleanupDryRun {
+			fmt.Println("orphan backups: " + strings.Join(resp.GetOrphans(), ","))
+		} else {
+			fmt.Println("removed orphan backups: " + strings.Join(resp.GetOrphans(), ","))
+		}
+		if resp.GetNextContinuationToken() != "" {
+			fmt.Println("more backups to check, continue with --continuation_token " + resp.GetNextContinuationToken())
+		}
+	},
+}
+
+func init() {
+	cleanupCmd.Flags().BoolVarP(&cleanupDryRun, "dry_run", "", false, "if true, only list the orphan backups without removing them")
+	cleanupCmd.Flags().Int64VarP(&cleanupGracePeriod, "grace_period", "", 86400, "seconds, orphan backups modified within the grace period are kept")
+	cleanupCmd.Flags().StringVarP(&cleanupContinuationToken, "continuation_token", "", "", "continue cleanup from the token printed by the last page")
+	cleanupCmd.Flags().Int32VarP(&cleanupLimit, "limit", "", 0, "max number of backup dirs to check in one page, 0 means no limit")
+
+	rootCmd.AddCommand(cleanupCmd)
+}
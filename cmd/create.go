@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,10 +18,21 @@ import (
 var (
 	backupName      string
 	collectionNames string
+	collectionIDs   string
 	databases       string
 	dbCollections   string
 	force           bool
 	metaOnly        bool
+	schemaTemplate  bool
+	binlogTypes     string
+	maxSpread       int64
+	verify          bool
+	backupDatabases bool
+	partitionScope  string
+	continueOnError bool
+	resume          bool
+	baseBackupName  string
+	propSelector    map[string]string
 )
 
 var createBackupCmd = &cobra.Command{
@@ -44,6 +56,22 @@ var createBackupCmd = &cobra.Command{
 			collectionNameArr = strings.Split(collectionNames, ",")
 		}
 
+		// database_collections has the first priority in CreateBackup, reject the flags it would silently override
+		if dbCollections != "" && (databases != "" || collectionNames != "") {
+			fmt.Println("--database_collections can't be used together with --databases or --colls")
+			return
+		}
+		if databases != "" && collectionNames != "" {
+			fmt.Println("--databases can't be used together with --colls, use --database_collections to select collections of databases")
+			return
+		}
+		if dbCollections != "" {
+			var parsed core.BackupDbCollections
+			if err := jsoniter.UnmarshalFromString(dbCollections, &parsed); err != nil {
+				fmt.Println("illegal database_collections input, err: " + err.Error())
+				return
+			}
+		}
 		if dbCollections == "" && databases != "" {
 			dbCollectionDict := make(map[string][]string)
 			splits := strings.Split(databases, ",")
@@ -57,15 +85,44 @@ var createBackupCmd = &cobra.Command{
 				return
 			}
 		}
+		var collectionIDArr []int64
+		if collectionIDs != "" {
+			for _, id := range strings.Split(collectionIDs, ",") {
+				collectionID, err := strconv.ParseInt(strings.TrimSpace(id), 10, 64)
+				if err != nil {
+					fmt.Println("illegal coll_ids input, err: " + err.Error())
+					return
+				}
+				collectionIDArr = append(collectionIDArr, collectionID)
+			}
+		}
+		var binlogTypeArr []string
+		if binlogTypes != "" {
+			binlogTypeArr = strings.Split(binlogTypes, ",")
+		}
 		resp := backupContext.CreateBackup(context, &backuppb.CreateBackupRequest{
-			BackupName:      backupName,
-			CollectionNames: collectionNameArr,
-			DbCollections:   utils.WrapDBCollections(dbCollections),
-			Force:           force,
-			MetaOnly:        metaOnly,
+			BackupName:               backupName,
+			CollectionNames:          collectionNameArr,
+			CollectionIds:            collectionIDArr,
+			DbCollections:            utils.WrapDBCollections(dbCollections),
+			Force:                    force,
+			MetaOnly:                 metaOnly,
+			SchemaTemplateOnly:       schemaTemplate,
+			BinlogTypes:              binlogTypeArr,
+			MaxSnapshotSpreadSeconds: maxSpread,
+			Verify:                   verify,
+			BackupDatabases:          backupDatabases,
+			PartitionScope:           partitionScope,
+			ContinueOnError:          continueOnError,
+			Resume:                   resume,
+			BaseBackupName:           baseBackupName,
+			PropertySelector:         propSelector,
 		})
 
 		fmt.Println(resp.GetMsg())
+		if resp.GetData().GetBackupTimestamp() != 0 {
+			fmt.Println(fmt.Sprintf("backup timestamp: %d (%s)", resp.GetData().GetBackupTimestamp(), resp.GetData().GetBackupTime()))
+		}
 		duration := time.Now().Unix() - start
 		fmt.Println(fmt.Sprintf("duration:%d s", duration))
 	},
@@ -74,10 +131,21 @@ var createBackupCmd = &cobra.Command{
 func init() {
 	createBackupCmd.Flags().StringVarP(&backupName, "name", "n", "", "backup name, if unset will generate a name automatically")
 	createBackupCmd.Flags().StringVarP(&collectionNames, "colls", "c", "", "collectionNames to backup, use ',' to connect multiple collections")
-	createBackupCmd.Flags().StringVarP(&databases, "databases", "d", "", "databases to backup")
-	createBackupCmd.Flags().StringVarP(&dbCollections, "database_collections", "a", "", "databases and collections to backup, json format: {\"db1\":[\"c1\", \"c2\"],\"db2\":[]}")
+	createBackupCmd.Flags().StringVarP(&collectionIDs, "coll_ids", "", "", "ids of the collections to backup, use ',' to connect multiple ids, can not be used with colls, databases or database_collections")
+	createBackupCmd.Flags().StringVarP(&databases, "databases", "d", "", "databases to backup, use ',' to connect multiple databases, all collections of them are backed up")
+	createBackupCmd.Flags().StringVarP(&dbCollections, "database_collections", "a", "", "databases and collections to backup, json format: {\"db1\":[\"c1\", \"c2\"],\"db2\":[]}, use {\"name\":\"c1\",\"force\":true} to skip flush of a collection")
 	createBackupCmd.Flags().BoolVarP(&force, "force", "f", false, "force backup, will skip flush, should make sure data has been stored into disk when using it")
 	createBackupCmd.Flags().BoolVarP(&metaOnly, "meta_only", "", false, "only backup collection meta instead of data")
+	createBackupCmd.Flags().BoolVarP(&schemaTemplate, "schema_template_only", "", false, "only backup schema, index and partitions as a template, restore creates empty collections from it")
+	createBackupCmd.Flags().StringVarP(&binlogTypes, "binlog_types", "", "", "binlog types to copy, use ',' to connect multiple types, support insert, delta, stats and index. if unset use backup.binlogTypes in config")
+	createBackupCmd.Flags().BoolVarP(&verify, "verify", "", false, "check all the segments existing at the flush of the collections are backed up and the copied objects exist with the right sizes, mark the backup failed if not")
+	createBackupCmd.Flags().BoolVarP(&backupDatabases, "backup_databases", "", false, "backup all databases of the cluster with their properties, to recreate them by restore --restore_databases")
+	createBackupCmd.Flags().StringVarP(&partitionScope, "partition_scope", "", "all", "partitions of the collections to backup: all, default_only or exclude_default. partition key collections only support all")
+	createBackupCmd.Flags().StringToStringVarP(&propSelector, "property_selector", "", nil, "only backup the collections having all the properties, e.g. tier=gold,env=prod. with no collection names set, select from all the collections")
+	createBackupCmd.Flags().BoolVarP(&continueOnError, "continue_on_error", "", false, "if true, skip the collections dropped during the backup instead of failing the backup")
+	createBackupCmd.Flags().BoolVarP(&resume, "resume", "", false, "resume the interrupted backup with the name, the prepared collections are not flushed again and the copied binlogs are skipped")
+	createBackupCmd.Flags().StringVarP(&baseBackupName, "base", "", "", "create an incremental backup on top of the backup with the name, only the segments new or changed since it are copied")
+	createBackupCmd.Flags().Int64VarP(&maxSpread, "max_snapshot_spread", "", 0, "seconds, fail the backup if backup timestamps of the collections differ by more than it. if unset use backup.maxSnapshotSpreadSeconds in config")
 
 	createBackupCmd.Flags().SortFlags = false
 
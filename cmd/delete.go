@@ -12,6 +12,7 @@ import (
 
 var (
 	deleteBackName string
+	deleteForce    bool
 )
 
 var deleteBackupCmd = &cobra.Command{
@@ -28,6 +29,7 @@ var deleteBackupCmd = &cobra.Command{
 
 		resp := backupContext.DeleteBackup(context, &backuppb.DeleteBackupRequest{
 			BackupName: deleteBackName,
+			Force:      deleteForce,
 		})
 
 		fmt.Println(resp.GetMsg())
@@ -36,6 +38,7 @@ var deleteBackupCmd = &cobra.Command{
 
 func init() {
 	deleteBackupCmd.Flags().StringVarP(&deleteBackName, "name", "n", "", "get backup with this name")
+	deleteBackupCmd.Flags().BoolVarP(&deleteForce, "force", "f", false, "delete the backup even if it is the base of incremental backups")
 
 	rootCmd.AddCommand(deleteBackupCmd)
 }
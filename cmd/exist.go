@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/zilliztech/milvus-backup/core"
+	"github.com/zilliztech/milvus-backup/core/paramtable"
+)
+
+var (
+	existBackupName string
+)
+
+var existBackupCmd = &cobra.Command{
+	Use:   "exist",
+	Short: "exist subcommand check whether a backup with the name exists, exit code is 1 if not.",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		var params paramtable.BackupParams
+		params.GlobalInitWithYaml(config)
+		params.Init()
+
+		context := context.Background()
+		backupContext := core.CreateBackupContext(context, params)
+
+		exist, err := backupContext.HasBackup(context, existBackupName)
+		if err != nil {
+			fmt.Println(err.Error())
+			os.Exit(2)
+		}
+		fmt.Println(exist)
+		if !exist {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	existBackupCmd.Flags().StringVarP(&existBackupName, "name", "n", "", "backup name to check")
+
+	rootCmd.AddCommand(existBackupCmd)
+}
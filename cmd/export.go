@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/zilliztech/milvus-backup/core"
+	"github.com/zilliztech/milvus-backup/core/paramtable"
+)
+
+var (
+	exportBackupName string
+	exportFile       string
+	exportGzip       bool
+)
+
+var exportBackupCmd = &cobra.Command{
+	Use:   "export",
+	Short: "export subcommand write a backup into a tar file, which can be imported in another environment.",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		var params paramtable.BackupParams
+		params.GlobalInitWithYaml(config)
+		params.Init()
+
+		if exportFile == "" {
+			exportFile = exportBackupName + ".tar"
+			if exportGzip {
+				exportFile = exportFile + ".gz"
+			}
+		}
+		file, err := os.Create(exportFile)
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		defer file.Close()
+
+		context := context.Background()
+		backupContext := core.CreateBackupContext(context, params)
+
+		err = backupContext.ExportBackup(context, exportBackupName, file, exportGzip)
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		fmt.Println("export backup " + exportBackupName + " to " + exportFile)
+	},
+}
+
+func init() {
+	exportBackupCmd.Flags().StringVarP(&exportBackupName, "name", "n", "", "backup name to export")
+	exportBackupCmd.Flags().StringVarP(&exportFile, "file", "f", "", "tar file to write, default to <name>.tar or <name>.tar.gz")
+	exportBackupCmd.Flags().BoolVarP(&exportGzip, "gzip", "z", false, "if true, gzip the tar file")
+
+	rootCmd.AddCommand(exportBackupCmd)
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/zilliztech/milvus-backup/core"
+	"github.com/zilliztech/milvus-backup/core/paramtable"
+)
+
+var (
+	exportDataBackupName     string
+	exportDataDatabaseName   string
+	exportDataCollectionName string
+	exportDataDir            string
+)
+
+var exportDataCmd = &cobra.Command{
+	Use:   "export-data",
+	Short: "export-data subcommand download the insert binlogs of a collection in a backup to a local dir.",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		var params paramtable.BackupParams
+		params.GlobalInitWithYaml(config)
+		params.Init()
+
+		if exportDataCollectionName == "" {
+			fmt.Println("empty collection name, please set it by --collection")
+			return
+		}
+		if exportDataDir == "" {
+			exportDataDir = exportDataBackupName + "_" + exportDataCollectionName
+		}
+
+		context := context.Background()
+		backupContext := core.CreateBackupContext(context, params)
+
+		err := backupContext.ExportCollectionData(context, exportDataBackupName, exportDataDatabaseName, exportDataCollectionName, exportDataDir)
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		fmt.Println("export data of collection " + exportDataCollectionName + " to " + exportDataDir)
+	},
+}
+
+func init() {
+	exportDataCmd.Flags().StringVarP(&exportDataBackupName, "name", "n", "", "backup name to export data from")
+	exportDataCmd.Flags().StringVarP(&exportDataDatabaseName, "database", "d", "default", "database of the collection")
+	exportDataCmd.Flags().StringVarP(&exportDataCollectionName, "collection", "c", "", "collection to export data")
+	exportDataCmd.Flags().StringVarP(&exportDataDir, "dir", "", "", "local dir to write the binlogs, default to <name>_<collection>")
+
+	rootCmd.AddCommand(exportDataCmd)
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/zilliztech/milvus-backup/core"
+	"github.com/zilliztech/milvus-backup/core/paramtable"
+)
+
+var (
+	importFile       string
+	importBucketName string
+)
+
+var importBackupCmd = &cobra.Command{
+	Use:   "import",
+	Short: "import subcommand unpack a backup tar file made by export into the backup bucket.",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		var params paramtable.BackupParams
+		params.GlobalInitWithYaml(config)
+		params.Init()
+
+		file, err := os.Open(importFile)
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		defer file.Close()
+
+		context := context.Background()
+		backupContext := core.CreateBackupContext(context, params)
+
+		backupName, err := backupContext.ImportBackup(context, file, importBucketName)
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		fmt.Println("import backup " + backupName + " from " + importFile)
+	},
+}
+
+func init() {
+	importBackupCmd.Flags().StringVarP(&importFile, "file", "f", "", "tar file made by export, gzipped or not")
+	importBackupCmd.Flags().StringVarP(&importBucketName, "bucket", "b", "", "bucket to import the backup into, default to minio.backupBucketName")
+
+	rootCmd.AddCommand(importBackupCmd)
+}
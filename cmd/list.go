@@ -11,7 +11,9 @@ import (
 )
 
 var (
-	collectionName string
+	collectionName    string
+	continuationToken string
+	listLimit         int32
 )
 
 var listBackupCmd = &cobra.Command{
@@ -28,18 +30,25 @@ var listBackupCmd = &cobra.Command{
 		backupContext := core.CreateBackupContext(context, params)
 
 		backups := backupContext.ListBackups(context, &backuppb.ListBackupsRequest{
-			CollectionName: collectionName,
+			CollectionName:    collectionName,
+			ContinuationToken: continuationToken,
+			Limit:             listLimit,
 		})
 
 		fmt.Println(">> Backups:")
 		for _, backup := range backups.GetData() {
 			fmt.Println(backup.GetName())
 		}
+		if backups.GetNextContinuationToken() != "" {
+			fmt.Println(">> More backups, continue with --continuation_token " + backups.GetNextContinuationToken())
+		}
 	},
 }
 
 func init() {
 	listBackupCmd.Flags().StringVarP(&collectionName, "collection", "c", "", "only list backups contains a certain collection")
+	listBackupCmd.Flags().StringVarP(&continuationToken, "continuation_token", "", "", "continue listing from the token printed by the last page")
+	listBackupCmd.Flags().Int32VarP(&listLimit, "limit", "", 0, "max number of backup dirs to list in one page, 0 means no limit")
 
 	rootCmd.AddCommand(listBackupCmd)
 }
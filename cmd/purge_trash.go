@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/zilliztech/milvus-backup/core"
+	"github.com/zilliztech/milvus-backup/core/paramtable"
+)
+
+var (
+	purgeTrashAll    bool
+	purgeTrashDryRun bool
+)
+
+var purgeTrashCmd = &cobra.Command{
+	Use:   "purge-trash",
+	Short: "purge-trash subcommand remove the backups deleted with backup.softDelete from the trash after backup.trashRetentionSeconds.",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		var params paramtable.BackupParams
+		params.GlobalInitWithYaml(config)
+		params.Init()
+
+		context := context.Background()
+		backupContext := core.CreateBackupContext(context, params)
+
+		purged, err := backupContext.PurgeTrash(context, purgeTrashAll, purgeTrashDryRun)
+		if purgeTrashDryRun {
+			fmt.Println("backups to purge: " + strings.Join(purged, ","))
+		} else {
+			fmt.Println("purged backups: " + strings.Join(purged, ","))
+		}
+		if err != nil {
+			fmt.Println(err.Error())
+		}
+	},
+}
+
+func init() {
+	purgeTrashCmd.Flags().BoolVarP(&purgeTrashAll, "all", "", false, "if true, purge all the backups in the trash regardless of the retention")
+	purgeTrashCmd.Flags().BoolVarP(&purgeTrashDryRun, "dry_run", "", false, "if true, only list the backups to purge without removing them")
+
+	rootCmd.AddCommand(purgeTrashCmd)
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/zilliztech/milvus-backup/core"
+	"github.com/zilliztech/milvus-backup/core/paramtable"
+)
+
+var (
+	relocateBackupName string
+	relocateOldPrefix  string
+	relocateNewPrefix  string
+)
+
+var relocateBackupCmd = &cobra.Command{
+	Use:   "relocate",
+	Short: "relocate subcommand rewrites the binlog paths in the meta of a backup after the milvus storage is migrated.",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		var params paramtable.BackupParams
+		params.GlobalInitWithYaml(config)
+		params.Init()
+
+		context := context.Background()
+		backupContext := core.CreateBackupContext(context, params)
+
+		if err := backupContext.RelocateBackup(context, relocateBackupName, relocateOldPrefix, relocateNewPrefix); err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		fmt.Println("success")
+	},
+}
+
+func init() {
+	relocateBackupCmd.Flags().StringVarP(&relocateBackupName, "name", "n", "", "name of the backup to relocate")
+	relocateBackupCmd.Flags().StringVarP(&relocateOldPrefix, "old_prefix", "", "", "prefix of the binlog paths to replace, e.g. the old milvus rootPath")
+	relocateBackupCmd.Flags().StringVarP(&relocateNewPrefix, "new_prefix", "", "", "new prefix of the binlog paths")
+
+	rootCmd.AddCommand(relocateBackupCmd)
+}
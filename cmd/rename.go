@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/zilliztech/milvus-backup/core"
+	"github.com/zilliztech/milvus-backup/core/paramtable"
+)
+
+var (
+	renameBackupName string
+	renameNewName    string
+)
+
+var renameBackupCmd = &cobra.Command{
+	Use:   "rename",
+	Short: "rename subcommand rename a backup.",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		var params paramtable.BackupParams
+		params.GlobalInitWithYaml(config)
+		params.Init()
+
+		context := context.Background()
+		backupContext := core.CreateBackupContext(context, params)
+
+		if err := backupContext.RenameBackup(context, renameBackupName, renameNewName); err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		fmt.Println("success")
+	},
+}
+
+func init() {
+	renameBackupCmd.Flags().StringVarP(&renameBackupName, "name", "n", "", "name of the backup to rename")
+	renameBackupCmd.Flags().StringVarP(&renameNewName, "new_name", "", "", "new name of the backup")
+
+	rootCmd.AddCommand(renameBackupCmd)
+}
@@ -30,6 +30,23 @@ var (
 	restoreDropExistCollection  bool
 	restoreDropExistIndex       bool
 	restoreSkipCreateCollection bool
+	restoreContinueOnError      bool
+	restoreIndexOverrides       string
+	restoreVectorTransformers   string
+	restoreCheckPrivileges      bool
+	restoreTimeout              int64
+	restoreAllDatabases         bool
+	restoreCreateMissingDB      bool
+	restoreAutoReload           bool
+	restoreLoadRestoredOnly     bool
+	restoreIndexBeforeImport    bool
+	restoreDeltaOnly            bool
+	restoreSanitizeNames        bool
+	restoreAliases              bool
+	restoreDynamicField         string
+	restoreLatestPattern        string
+	restoreExistingPolicy       string
+	restoreCollectionProps      bool
 )
 
 var restoreBackupCmd = &cobra.Command{
@@ -80,40 +97,101 @@ var restoreBackupCmd = &cobra.Command{
 				return
 			}
 		}
+		var indexOverrides []*backuppb.IndexParamOverride
+		if restoreIndexOverrides != "" {
+			err := jsoniter.UnmarshalFromString(restoreIndexOverrides, &indexOverrides)
+			if err != nil {
+				fmt.Println("illegal index_overrides input")
+				return
+			}
+		}
+		var vectorTransformers []*backuppb.VectorTransformerOption
+		if restoreVectorTransformers != "" {
+			err := jsoniter.UnmarshalFromString(restoreVectorTransformers, &vectorTransformers)
+			if err != nil {
+				fmt.Println("illegal vector_transformers input")
+				return
+			}
+		}
 		resp := backupContext.RestoreBackup(context, &backuppb.RestoreBackupRequest{
-			BackupName:           restoreBackupName,
-			CollectionNames:      collectionNameArr,
-			CollectionSuffix:     renameSuffix,
-			CollectionRenames:    renameMap,
-			DbCollections:        utils.WrapDBCollections(restoreDatabaseCollections),
-			MetaOnly:             restoreMetaOnly,
-			RestoreIndex:         restoreRestoreIndex,
-			UseAutoIndex:         restoreUseAutoIndex,
-			DropExistCollection:  restoreDropExistCollection,
-			DropExistIndex:       restoreDropExistIndex,
-			SkipCreateCollection: restoreSkipCreateCollection,
+			BackupName:                  restoreBackupName,
+			CollectionNames:             collectionNameArr,
+			CollectionSuffix:            renameSuffix,
+			CollectionRenames:           renameMap,
+			DbCollections:               utils.WrapDBCollections(restoreDatabaseCollections),
+			MetaOnly:                    restoreMetaOnly,
+			RestoreIndex:                restoreRestoreIndex,
+			UseAutoIndex:                restoreUseAutoIndex,
+			DropExistCollection:         restoreDropExistCollection,
+			DropExistIndex:              restoreDropExistIndex,
+			SkipCreateCollection:        restoreSkipCreateCollection,
+			ContinueOnError:             restoreContinueOnError,
+			IndexOverrides:              indexOverrides,
+			VectorTransformers:          vectorTransformers,
+			CheckPrivileges:             restoreCheckPrivileges,
+			TimeoutSeconds:              restoreTimeout,
+			RestoreDatabases:            restoreAllDatabases,
+			CreateMissingDatabase:       restoreCreateMissingDB,
+			AutoReloadPreviouslyLoaded:  restoreAutoReload,
+			LoadRestoredPartitionsOnly:  restoreLoadRestoredOnly,
+			BuildIndexBeforeImport:      restoreIndexBeforeImport,
+			DeltaOnly:                   restoreDeltaOnly,
+			SanitizeCollectionNames:     restoreSanitizeNames,
+			RestoreAliases:              restoreAliases,
+			DynamicField:                restoreDynamicField,
+			LatestNamePattern:           restoreLatestPattern,
+			ExistingCollectionPolicy:    restoreExistingPolicy,
+			RestoreCollectionProperties: restoreCollectionProps,
 		})
 
 		fmt.Println(resp.GetMsg())
+		if restoreBackupName == core.LatestBackupName && resp.GetData().GetBackupName() != "" {
+			fmt.Println(fmt.Sprintf("restored the latest backup: %s", resp.GetData().GetBackupName()))
+		}
+		for original, sanitized := range resp.GetData().GetSanitizedCollectionNames() {
+			fmt.Println(fmt.Sprintf("sanitized collection name: %s -> %s", original, sanitized))
+		}
+		if restoreContinueOnError {
+			for _, collTask := range resp.GetData().GetCollectionRestoreTasks() {
+				fmt.Println(fmt.Sprintf("%s.%s: %s %s", collTask.GetTargetDbName(), collTask.GetTargetCollectionName(), collTask.GetStateCode(), collTask.GetErrorMessage()))
+			}
+		}
 		duration := time.Now().Unix() - start
 		fmt.Println(fmt.Sprintf("duration:%d s", duration))
 	},
 }
 
 func init() {
-	restoreBackupCmd.Flags().StringVarP(&restoreBackupName, "name", "n", "", "backup name to restore")
+	restoreBackupCmd.Flags().StringVarP(&restoreBackupName, "name", "n", "", "backup name to restore, latest to restore the latest backup containing the collections")
+	restoreBackupCmd.Flags().StringVarP(&restoreLatestPattern, "latest_pattern", "", "", "with --name latest, only restore the latest backup whose name matches this glob pattern, e.g. daily_*")
 	restoreBackupCmd.Flags().StringVarP(&restoreCollectionNames, "collections", "c", "", "collectionNames to restore")
 	restoreBackupCmd.Flags().StringVarP(&renameSuffix, "suffix", "s", "", "add a suffix to collection name to restore")
 	restoreBackupCmd.Flags().StringVarP(&renameCollectionNames, "rename", "r", "", "rename collections to new names, format: db1.collection1:db2.collection1_new,db1.collection2:db2.collection2_new")
 	restoreBackupCmd.Flags().StringVarP(&restoreDatabases, "databases", "d", "", "databases to restore, if not set, restore all databases")
-	restoreBackupCmd.Flags().StringVarP(&restoreDatabaseCollections, "database_collections", "a", "", "databases and collections to restore, json format: {\"db1\":[\"c1\", \"c2\"],\"db2\":[]}")
+	restoreBackupCmd.Flags().StringVarP(&restoreDatabaseCollections, "database_collections", "a", "", "databases and collections to restore, json format: {\"db1\":[\"c1\", \"c2\"],\"db2\":[]}, use {\"name\":\"c1\",\"shards_num\":4} to create a collection with another shards num")
 
 	restoreBackupCmd.Flags().BoolVarP(&restoreMetaOnly, "meta_only", "", false, "if true, restore meta only")
 	restoreBackupCmd.Flags().BoolVarP(&restoreRestoreIndex, "restore_index", "", false, "if true, restore index")
 	restoreBackupCmd.Flags().BoolVarP(&restoreUseAutoIndex, "use_auto_index", "", false, "if true, replace vector index with autoindex")
 	restoreBackupCmd.Flags().BoolVarP(&restoreDropExistCollection, "drop_exist_collection", "", false, "if true, drop existing target collection before create")
 	restoreBackupCmd.Flags().BoolVarP(&restoreDropExistIndex, "drop_exist_index", "", false, "if true, drop existing index of target collection before create")
-	restoreBackupCmd.Flags().BoolVarP(&restoreSkipCreateCollection, "skip_create_collection", "", false, "if true, will skip collection, use when collection exist, restore index or data")
+	restoreBackupCmd.Flags().BoolVarP(&restoreSkipCreateCollection, "skip_create_collection", "", false, "if true, will skip collection, use when collection exist, restore index or data. the existing collection may have extra nullable or default value fields")
+	restoreBackupCmd.Flags().BoolVarP(&restoreContinueOnError, "continue_on_error", "", false, "if true, keep restoring the remaining collections when one collection fails")
+	restoreBackupCmd.Flags().BoolVarP(&restoreCheckPrivileges, "check_privileges", "", false, "if true, check the milvus user has the privileges to restore before starting, only for clusters with RBAC")
+	restoreBackupCmd.Flags().Int64VarP(&restoreTimeout, "timeout", "", 0, "seconds, stop the restore and mark it TIMEOUT when exceeded. if unset use backup.restoreTimeoutSeconds in config")
+	restoreBackupCmd.Flags().BoolVarP(&restoreAllDatabases, "restore_databases", "", false, "if true, create all the databases in the backup with their properties before restoring collections, the backup must be created with --backup_databases")
+	restoreBackupCmd.Flags().BoolVarP(&restoreCreateMissingDB, "create_missing_database", "", false, "if true, create the target databases which don't exist, otherwise the restore fails on them")
+	restoreBackupCmd.Flags().BoolVarP(&restoreAutoReload, "auto_reload", "", false, "if true, load the collections and partitions loaded at backup time after restore, index is needed to load")
+	restoreBackupCmd.Flags().BoolVarP(&restoreIndexBeforeImport, "build_index_before_import", "", false, "if true, create the indexes before importing the data, otherwise after the import. use with --restore_index")
+	restoreBackupCmd.Flags().BoolVarP(&restoreLoadRestoredOnly, "load_restored_partitions_only", "", false, "if true, auto_reload only loads the restored partitions instead of the whole collection")
+	restoreBackupCmd.Flags().BoolVarP(&restoreSanitizeNames, "sanitize_collection_names", "", false, "if true, replace the illegal characters of invalid target collection names by '_' and truncate too long names instead of failing")
+	restoreBackupCmd.Flags().BoolVarP(&restoreAliases, "restore_aliases", "", false, "if true, create the aliases of the collections in the backup after all the collections are restored")
+	restoreBackupCmd.Flags().BoolVarP(&restoreCollectionProps, "restore_collection_properties", "", false, "if true, create the collections with the properties of the backup, e.g. collection.ttl.seconds")
+	restoreBackupCmd.Flags().StringVarP(&restoreDynamicField, "dynamic_field", "", "", "dynamic field of the restored collections, disable to drop the dynamic field and its data, enable to add an empty one, default keeps the one of the backup")
+	restoreBackupCmd.Flags().StringVarP(&restoreExistingPolicy, "existing_collection_policy", "", "", "with --skip_create_collection, fail to fail the restore if an existing collection has rows, default imports the data beside them")
+	restoreBackupCmd.Flags().BoolVarP(&restoreDeltaOnly, "delta_only", "", false, "if true, only apply the delta logs of the backup as deletions to the existing collections, use with --skip_create_collection")
+	restoreBackupCmd.Flags().StringVarP(&restoreIndexOverrides, "index_overrides", "", "", "override index params when restore_index, json format: [{\"collection_name\":\"db1.c1\",\"field_name\":\"vec\",\"index_type\":\"IVF_FLAT\",\"params\":{\"nlist\":\"2048\"}}]")
+	restoreBackupCmd.Flags().StringVarP(&restoreVectorTransformers, "vector_transformers", "", "", "opt in transforming float vectors before import, json format: [{\"collection_name\":\"db1.c1\",\"field_name\":\"vec\",\"name\":\"pad_truncate\",\"dim\":768}]. it needs the insert binlogs rewritten, which is not supported yet, only noop is accepted")
 
 	// won't print flags in character order
 	restoreBackupCmd.Flags().SortFlags = false
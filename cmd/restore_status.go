@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zilliztech/milvus-backup/core"
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+var (
+	restoreStatusID       string
+	restoreStatusServer   string
+	restoreStatusWatch    bool
+	restoreStatusInterval int
+)
+
+// restore tasks only live in the process running them, so the state is queried from a backup server
+var restoreStatusCmd = &cobra.Command{
+	Use:   "restore-status",
+	Short: "restore-status subcommand get the state of a restore from a backup server.",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		if restoreStatusID == "" {
+			fmt.Println("empty restore id, please set it by --id")
+			return
+		}
+		for {
+			resp, err := getRestoreFromServer(restoreStatusServer, restoreStatusID)
+			if err != nil {
+				fmt.Println(err.Error())
+				return
+			}
+			if resp.GetCode() != backuppb.ResponseCode_Success {
+				fmt.Println(resp.GetMsg())
+				return
+			}
+			printRestoreTask(resp.GetData())
+
+			state := resp.GetData().GetStateCode()
+			if !restoreStatusWatch || state == backuppb.RestoreTaskStateCode_SUCCESS ||
+				state == backuppb.RestoreTaskStateCode_FAIL || state == backuppb.RestoreTaskStateCode_TIMEOUT {
+				return
+			}
+			time.Sleep(time.Duration(restoreStatusInterval) * time.Second)
+		}
+	},
+}
+
+func getRestoreFromServer(server string, id string) (*backuppb.RestoreBackupResponse, error) {
+	address := strings.TrimSuffix(server, "/") + core.API_V1_PREFIX + core.GET_RESTORE_API + "?id=" + url.QueryEscape(id)
+	httpResp, err := http.Get(address)
+	if err != nil {
+		return nil, fmt.Errorf("fail to request backup server %s, err: %s", server, err)
+	}
+	defer httpResp.Body.Close()
+
+	resp := &backuppb.RestoreBackupResponse{}
+	if err := json.NewDecoder(httpResp.Body).Decode(resp); err != nil {
+		return nil, fmt.Errorf("fail to decode response of backup server %s, err: %s", server, err)
+	}
+	return resp, nil
+}
+
+func printRestoreTask(task *backuppb.RestoreBackupTask) {
+	fmt.Println(fmt.Sprintf("restore: %s state: %s progress: %d%% restored/total size: %d/%d",
+		task.GetId(), task.GetStateCode(), task.GetProgress(), task.GetRestoredSize(), task.GetToRestoreSize()))
+	if task.GetErrorMessage() != "" {
+		fmt.Println("error: " + task.GetErrorMessage())
+	}
+	for _, collTask := range task.GetCollectionRestoreTasks() {
+		fmt.Println(fmt.Sprintf("  %s.%s: %s progress: %d%% restored/total size: %d/%d %s",
+			collTask.GetTargetDbName(), collTask.GetTargetCollectionName(), collTask.GetStateCode(), collTask.GetProgress(),
+			collTask.GetRestoredSize(), collTask.GetToRestoreSize(), collTask.GetErrorMessage()))
+		for _, partTask := range collTask.GetPartitionRestoreTasks() {
+			fmt.Println(fmt.Sprintf("    %s: %s progress: %d%% restored/total size: %d/%d",
+				partTask.GetPartitionName(), partTask.GetStateCode(), partTask.GetProgress(),
+				partTask.GetRestoredSize(), partTask.GetToRestoreSize()))
+		}
+	}
+}
+
+func init() {
+	restoreStatusCmd.Flags().StringVarP(&restoreStatusID, "id", "i", "", "id of the restore")
+	restoreStatusCmd.Flags().StringVarP(&restoreStatusServer, "server", "", "http://localhost:"+DefaultServerPort, "address of the backup server running the restore")
+	restoreStatusCmd.Flags().BoolVarP(&restoreStatusWatch, "watch", "w", false, "poll until the restore finishes")
+	restoreStatusCmd.Flags().IntVarP(&restoreStatusInterval, "interval", "", 5, "seconds between polls when watch")
+
+	rootCmd.AddCommand(restoreStatusCmd)
+}
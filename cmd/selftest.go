@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/zilliztech/milvus-backup/core"
+	"github.com/zilliztech/milvus-backup/core/paramtable"
+)
+
+var selfTestCmd = &cobra.Command{
+	Use:   "selftest",
+	Short: "selftest subcommand backup and restore a tiny temporary collection to validate the config end to end, exit code is 1 if failed.",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		var params paramtable.BackupParams
+		fmt.Println("config:" + config)
+		params.GlobalInitWithYaml(config)
+		params.Init()
+
+		context := context.Background()
+		backupContext := core.CreateBackupContext(context, params)
+
+		failed := false
+		for _, stage := range backupContext.SelfTest(context) {
+			if stage.Err != nil {
+				failed = true
+				fmt.Println(fmt.Sprintf("%s: FAIL %s", stage.Name, stage.Err.Error()))
+			} else {
+				fmt.Println(fmt.Sprintf("%s: PASS", stage.Name))
+			}
+		}
+		if failed {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(selfTestCmd)
+}
@@ -33,7 +33,8 @@ var serverCmd = &cobra.Command{
 		context := context.Background()
 		server, err := core.NewServer(context, params, core.Port(port))
 		if err != nil {
-			fmt.Errorf("fail to create backup server, %s", err.Error())
+			fmt.Println("fail to create backup server, " + err.Error())
+			os.Exit(1)
 		}
 		server.Init()
 		server.Start()
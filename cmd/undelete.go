@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/zilliztech/milvus-backup/core"
+	"github.com/zilliztech/milvus-backup/core/paramtable"
+)
+
+var (
+	undeleteBackupName string
+)
+
+var undeleteBackupCmd = &cobra.Command{
+	Use:   "undelete",
+	Short: "undelete subcommand recover a backup deleted with backup.softDelete from the trash.",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		var params paramtable.BackupParams
+		params.GlobalInitWithYaml(config)
+		params.Init()
+
+		context := context.Background()
+		backupContext := core.CreateBackupContext(context, params)
+
+		if err := backupContext.UndeleteBackup(context, undeleteBackupName); err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		fmt.Println("success")
+	},
+}
+
+func init() {
+	undeleteBackupCmd.Flags().StringVarP(&undeleteBackupName, "name", "n", "", "name of the deleted backup, the last deleted one is recovered if deleted more than once")
+
+	rootCmd.AddCommand(undeleteBackupCmd)
+}
@@ -19,6 +19,10 @@ type Backup interface {
 	RestoreBackup(context.Context, *backuppb.RestoreBackupRequest) *backuppb.RestoreBackupResponse
 	// Get restore state by given id
 	GetRestore(context.Context, *backuppb.GetRestoreStateRequest) *backuppb.RestoreBackupResponse
+	// Get events of a backup or restore by given id
+	GetEvents(context.Context, *backuppb.GetEventsRequest) *backuppb.GetEventsResponse
+	// Remove partial backups left by crashed backups
+	CleanupOrphans(context.Context, *backuppb.CleanupOrphansRequest) *backuppb.CleanupOrphansResponse
 	// Copy backuppb between buckets
 	//CopyBackup(context.Context, *backuppb.CopyBackupRequest) (*backuppb.CopyBackupResponse, error)
 }
@@ -2,14 +2,18 @@ package core
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	gomilvus "github.com/milvus-io/milvus-sdk-go/v2/client"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"golang.org/x/time/rate"
 
 	"github.com/zilliztech/milvus-backup/core/paramtable"
 	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
@@ -22,14 +26,26 @@ import (
 const (
 	BULKINSERT_TIMEOUT            = 60 * 60
 	BULKINSERT_SLEEP_INTERVAL     = 5
+	BULKINSERT_RETRY_SLEEP        = 10 * time.Second
+	BULKINSERT_RETRY_MAX_SLEEP    = 2 * time.Minute
 	BACKUP_NAME                   = "BACKUP_NAME"
+	DATABASE_NAME                 = "DATABASE_NAME"
 	COLLECTION_RENAME_SUFFIX      = "COLLECTION_RENAME_SUFFIX"
+	COLLECTION_NAME               = "COLLECTION_NAME"
 	RPS                           = 1000
 	BackupSegmentGroupMaxSizeInMB = 256
 
+	// port of milvus management API and healthz
+	DefaultMilvusManagementPort = 9091
+	// management address of milvus if it can't be discovered from milvus.address
+	DefaultMilvusGCAddress = "http://localhost:9091"
+
 	GC_Warn_Message = "This warn won't fail the backup process. Pause GC can protect data not to be GCed during backup, it is necessary to backup very large data(cost more than a hour)."
 )
 
+// ErrReadOnly is returned by the operations modifying milvus or the backup storage with backup.readOnly
+var ErrReadOnly = errors.New("backup service is read-only, only getting, listing and verifying backups are allowed")
+
 // makes sure BackupContext implements `Backup`
 var _ Backup = (*BackupContext)(nil)
 
@@ -53,8 +69,34 @@ type BackupContext struct {
 	meta *MetaManager
 
 	backupCollectionWorkerPool *common.WorkerPool
+	backupPrepareWorkerPool    *common.WorkerPool
 	backupCopyDataWorkerPool   *common.WorkerPool
+	backupListMetaWorkerPool   *common.WorkerPool
 	bulkinsertWorkerPools      map[string]*common.WorkerPool
+
+	// limit the concurrent flush calls to milvus
+	flushSemaphore chan struct{}
+	// database -> collections waiting for the next batch flush, in batch flush mode
+	flushBatchMu   sync.Mutex
+	pendingFlushes map[string][]*flushRequest
+
+	// backup id -> collection id -> ids of the segments existing at the flush of the collection,
+	// only for the backups to verify
+	snapshotSegments sync.Map
+
+	// encrypted data key of a backup -> cipher of its binlogs
+	binlogCiphers sync.Map
+
+	// throughput of the copy phase of the executing backup, backups are executed one by one
+	copyStats *copyStats
+	// retry budget of the executing backup, nil if unlimited
+	retryBudget *retryBudget
+	// segment id -> segment in the base backup of the executing incremental backup, nil for a full backup
+	baseSegments map[int64]*backuppb.SegmentBackupInfo
+	// index files of milvus listed once for all the collections of the executing backup
+	milvusIndexFiles *milvusIndexFiles
+	// bytes copied per second by all the copy data workers, nil if unlimited
+	copyLimiter *rate.Limiter
 }
 
 func CreateMilvusClient(ctx context.Context, params paramtable.BackupParams) (gomilvus.Client, error) {
@@ -86,15 +128,28 @@ func CreateStorageClient(ctx context.Context, params paramtable.BackupParams) (s
 	log.Debug("Start minio client",
 		zap.String("address", minioEndPoint),
 		zap.String("bucket", params.MinioCfg.BucketName),
-		zap.String("backupBucket", params.MinioCfg.BackupBucketName))
+		zap.String("backupBucket", params.MinioCfg.BackupBucketName),
+		zap.String("endpointOverride", params.MinioCfg.EndpointOverride),
+		zap.Bool("insecureSkipVerify", params.MinioCfg.InsecureSkipVerify))
 	minioClient, err := storage.NewChunkManager(ctx, params)
 	return minioClient, err
 }
 
 func (b *BackupContext) Start() error {
+	if b.params.BackupCfg.CheckPathOverlap && !b.params.MinioCfg.HasSeparateBackupStorage() {
+		if err := checkPathOverlap(b.milvusBucketName, b.milvusRootPath, b.backupBucketName, b.backupRootPath); err != nil {
+			log.Error("milvus storage path and backup path overlap", zap.Error(err))
+			return err
+		}
+	}
 	b.started = true
 	log.Info(fmt.Sprintf("%+v", b.params.BackupCfg))
 	log.Info(fmt.Sprintf("%+v", b.params.HTTPCfg))
+	if b.copyLimiter != nil {
+		log.Info("copy data bandwidth limit", zap.Int64("maxBytesPerSec", b.params.BackupCfg.CopyDataMaxBytesPerSec))
+	} else {
+		log.Info("copy data bandwidth limit", zap.String("maxBytesPerSec", "unlimited"))
+	}
 	return nil
 }
 
@@ -117,6 +172,8 @@ func CreateBackupContext(ctx context.Context, params paramtable.BackupParams) *B
 		backupRootPath:        params.MinioCfg.BackupRootPath,
 		bulkinsertWorkerPools: make(map[string]*common.WorkerPool),
 		meta:                  newMetaManager(),
+		flushSemaphore:        make(chan struct{}, params.BackupCfg.FlushParallelism),
+		copyLimiter:           newCopyLimiter(params.BackupCfg.CopyDataMaxBytesPerSec),
 	}
 }
 
@@ -159,6 +216,52 @@ func (b *BackupContext) getBackupCollectionWorkerPool() *common.WorkerPool {
 	return b.backupCollectionWorkerPool
 }
 
+// getBackupPrepareWorkerPool returns the pool preparing the collections of a backup, the flush is in the prepare of a
+// collection, so the pool has backup.flushParallelism workers if more than parallelism.backupCollection.
+// The flushes are limited by flushSemaphore, not by the pool.
+func (b *BackupContext) getBackupPrepareWorkerPool() *common.WorkerPool {
+	if b.backupPrepareWorkerPool == nil {
+		workerNum := b.params.BackupCfg.BackupCollectionParallelism
+		if b.params.BackupCfg.FlushParallelism > workerNum {
+			workerNum = b.params.BackupCfg.FlushParallelism
+		}
+		wp, err := common.NewWorkerPool(b.ctx, workerNum, RPS)
+		if err != nil {
+			log.Error("failed to initial collection prepare worker pool", zap.Error(err))
+			panic(err)
+		}
+		b.backupPrepareWorkerPool = wp
+		b.backupPrepareWorkerPool.Start()
+	}
+	return b.backupPrepareWorkerPool
+}
+
+// autoCopyDataParallelism sizes the copy data pool by the segments to copy, a segment is copied by one worker
+func autoCopyDataParallelism(segments, min, max int) int {
+	if segments < min {
+		return min
+	}
+	if segments > max {
+		return max
+	}
+	return segments
+}
+
+// resizeCopyDataWorkerPool replaces the copy data pool by one of workerNum workers. It is only called between the
+// phases of a backup, backups are executed one at a time so that the pool has no running jobs.
+func (b *BackupContext) resizeCopyDataWorkerPool(workerNum int) {
+	if b.backupCopyDataWorkerPool != nil {
+		b.backupCopyDataWorkerPool.Done()
+	}
+	wp, err := common.NewWorkerPool(b.ctx, workerNum, RPS)
+	if err != nil {
+		log.Error("failed to initial copy data worker pool", zap.Error(err))
+		panic(err)
+	}
+	b.backupCopyDataWorkerPool = wp
+	b.backupCopyDataWorkerPool.Start()
+}
+
 func (b *BackupContext) getCopyDataWorkerPool() *common.WorkerPool {
 	if b.backupCopyDataWorkerPool == nil {
 		wp, err := common.NewWorkerPool(b.ctx, b.params.BackupCfg.BackupCopyDataParallelism, RPS)
@@ -172,6 +275,19 @@ func (b *BackupContext) getCopyDataWorkerPool() *common.WorkerPool {
 	return b.backupCopyDataWorkerPool
 }
 
+func (b *BackupContext) getListMetaWorkerPool() *common.WorkerPool {
+	if b.backupListMetaWorkerPool == nil {
+		wp, err := common.NewWorkerPool(b.ctx, b.params.BackupCfg.BackupListMetaParallelism, RPS)
+		if err != nil {
+			log.Error("failed to initial list meta worker pool", zap.Error(err))
+			panic(err)
+		}
+		b.backupListMetaWorkerPool = wp
+		b.backupListMetaWorkerPool.Start()
+	}
+	return b.backupListMetaWorkerPool
+}
+
 func (b *BackupContext) getRestoreWorkerPool(id string) *common.WorkerPool {
 	if pool, exist := b.bulkinsertWorkerPools[id]; exist {
 		return pool
@@ -236,10 +352,10 @@ func (b *BackupContext) GetBackup(ctx context.Context, request *backuppb.GetBack
 			var backupPath string
 			if request.GetBucketName() == "" || request.GetPath() == "" {
 				backupBucketName = b.backupBucketName
-				backupPath = b.backupRootPath + SEPERATOR + request.GetBackupName()
+				backupPath = b.backupPathOf(request.GetBackupName())
 			} else {
 				backupBucketName = request.GetBucketName()
-				backupPath = request.GetPath() + SEPERATOR + request.GetBackupName()
+				backupPath = BackupPath(request.GetPath(), request.GetBackupName())
 			}
 			backup, err := b.readBackup(ctx, backupBucketName, backupPath)
 			if err != nil {
@@ -250,6 +366,10 @@ func (b *BackupContext) GetBackup(ctx context.Context, request *backuppb.GetBack
 				resp.Code = backuppb.ResponseCode_Fail
 				resp.Msg = err.Error()
 			}
+			// the meta records the name the backup was created with, a nested backup is named by its relative path
+			if backup != nil && request.GetBucketName() == "" && b.nestedLayout() {
+				backup.Name = request.GetBackupName()
+			}
 
 			resp.Data = backup
 			if backup == nil {
@@ -291,7 +411,9 @@ func (b *BackupContext) ListBackups(ctx context.Context, request *backuppb.ListB
 	}
 	log.Info("receive ListBackupsRequest",
 		zap.String("requestId", request.GetRequestId()),
-		zap.String("collectionName", request.GetCollectionName()))
+		zap.String("collectionName", request.GetCollectionName()),
+		zap.String("continuationToken", request.GetContinuationToken()),
+		zap.Int32("limit", request.GetLimit()))
 
 	resp := &backuppb.ListBackupsResponse{
 		RequestId: request.GetRequestId(),
@@ -306,25 +428,51 @@ func (b *BackupContext) ListBackups(ctx context.Context, request *backuppb.ListB
 		}
 	}
 
-	// 1, trigger inner sync to get the newest backup list in the milvus cluster
-	backupPaths, _, err := b.getStorageClient().ListWithPrefix(ctx, b.backupBucketName, b.backupRootPath+SEPERATOR, false)
-	if err != nil {
-		log.Error("Fail to list backup directory", zap.Error(err))
-		resp.Code = backuppb.ResponseCode_Fail
-		resp.Msg = err.Error()
+	if request.GetLimit() < 0 {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = "limit can not be negative"
 		return resp
 	}
 
-	log.Info("List Backups' path", zap.Strings("backup_paths", backupPaths))
+	// 1, trigger inner sync to get the newest backup list in the milvus cluster
+	var listedNames []string
+	var nextToken string
+	if b.nestedLayout() {
+		// the continuation token is the last backup name of the previous page in the nested layout
+		names, err := b.listNestedBackupNames(ctx)
+		if err != nil {
+			log.Error("Fail to list backup directory", zap.Error(err))
+			resp.Code = backuppb.ResponseCode_Fail
+			resp.Msg = err.Error()
+			return resp
+		}
+		listedNames, nextToken = pageBackupNames(names, request.GetContinuationToken(), int(request.GetLimit()))
+	} else {
+		backupPaths, _, token, err := b.getStorageClient().ListWithPrefixPage(ctx, b.backupBucketName, b.backupRootPath+SEPERATOR, false,
+			request.GetContinuationToken(), int(request.GetLimit()))
+		if err != nil {
+			log.Error("Fail to list backup directory", zap.Error(err))
+			resp.Code = backuppb.ResponseCode_Fail
+			resp.Msg = err.Error()
+			return resp
+		}
+		log.Info("List Backups' path", zap.Strings("backup_paths", backupPaths))
+		listedNames = make([]string, 0, len(backupPaths))
+		for _, backupPath := range backupPaths {
+			listedNames = append(listedNames, BackupPathToName(b.backupRootPath, backupPath))
+		}
+		nextToken = token
+	}
+
 	backupInfos := make([]*backuppb.BackupInfo, 0)
 	backupNames := make([]string, 0)
-	for _, backupPath := range backupPaths {
+	for _, listedName := range listedNames {
 		backupResp := b.GetBackup(ctx, &backuppb.GetBackupRequest{
-			BackupName: BackupPathToName(b.backupRootPath, backupPath),
+			BackupName: listedName,
 		})
 		if backupResp.GetCode() != backuppb.ResponseCode_Success {
 			log.Warn("Fail to read backup",
-				zap.String("path", backupPath),
+				zap.String("backupName", listedName),
 				zap.String("error", backupResp.GetMsg()))
 			// ignore get failed
 			continue
@@ -354,14 +502,40 @@ func (b *BackupContext) ListBackups(ctx context.Context, request *backuppb.ListB
 	resp.Code = backuppb.ResponseCode_Success
 	resp.Msg = "success"
 	resp.Data = backupInfos
+	resp.NextContinuationToken = nextToken
 	log.Info("return ListBackupsResponse",
 		zap.String("requestId", resp.GetRequestId()),
 		zap.Int32("code", int32(resp.GetCode())),
 		zap.String("msg", resp.GetMsg()),
-		zap.Strings("data: list_backup_names", backupNames))
+		zap.Strings("data: list_backup_names", backupNames),
+		zap.String("nextContinuationToken", nextToken))
 	return resp
 }
 
+// HasBackup returns whether a complete backup with the name exists in the backup root path or the backup is being
+// created in this process, by checking the backup meta file only. It is much cheaper than GetBackup.
+// Partial backups left by crashed backups are not counted, they can be removed by CleanupOrphans.
+func (b *BackupContext) HasBackup(ctx context.Context, backupName string) (bool, error) {
+	log.Info("receive HasBackup", zap.String("backupName", backupName))
+	if !b.started {
+		err := b.Start()
+		if err != nil {
+			return false, err
+		}
+	}
+	if backupName == "" {
+		return false, errors.New("empty backup name")
+	}
+	if b.meta.IsBackupInProgress(backupName) {
+		return true, nil
+	}
+	exist, err := b.getStorageClient().Exist(ctx, b.backupBucketName, b.backupPathOf(backupName)+SEPERATOR+META_PREFIX+SEPERATOR+BACKUP_META_FILE)
+	if err != nil {
+		return false, fmt.Errorf("fail to check backup %s exist, err: %w", backupName, err)
+	}
+	return exist, nil
+}
+
 func (b *BackupContext) DeleteBackup(ctx context.Context, request *backuppb.DeleteBackupRequest) *backuppb.DeleteBackupResponse {
 	if request.GetRequestId() == "" {
 		request.RequestId = utils.UUID()
@@ -382,6 +556,11 @@ func (b *BackupContext) DeleteBackup(ctx context.Context, request *backuppb.Dele
 			return resp
 		}
 	}
+	if b.params.BackupCfg.ReadOnly {
+		resp.Code = backuppb.ResponseCode_No_Permission
+		resp.Msg = ErrReadOnly.Error()
+		return resp
+	}
 
 	if request.GetBackupName() == "" {
 		resp.Code = backuppb.ResponseCode_Parameter_Error
@@ -392,8 +571,21 @@ func (b *BackupContext) DeleteBackup(ctx context.Context, request *backuppb.Dele
 	getResp := b.GetBackup(b.ctx, &backuppb.GetBackupRequest{
 		BackupName: request.GetBackupName(),
 	})
-	// always trigger a remove to make sure it is deleted
-	err := b.getStorageClient().RemoveWithPrefix(ctx, b.backupBucketName, BackupDirPath(b.backupRootPath, request.GetBackupName()))
+	if !request.GetForce() && getResp.GetCode() == backuppb.ResponseCode_Success && getResp.GetData() != nil {
+		if err := b.checkNoIncrementalDependents(ctx, request.GetBackupName()); err != nil {
+			log.Warn("refuse to delete a base backup", zap.String("backupName", request.GetBackupName()), zap.Error(err))
+			resp.Code = backuppb.ResponseCode_Fail
+			resp.Msg = err.Error() + ", delete them first or delete with force"
+			return resp
+		}
+	}
+	var err error
+	if b.params.BackupCfg.SoftDelete && getResp.GetCode() == backuppb.ResponseCode_Success && getResp.GetData() != nil {
+		err = b.moveBackupToTrash(ctx, request.GetBackupName())
+	} else {
+		// always trigger a remove to make sure it is deleted
+		err = b.removeBackupObjects(ctx, request.GetBackupName())
+	}
 
 	if getResp.GetCode() == backuppb.ResponseCode_Request_Object_Not_Found {
 		resp.Code = backuppb.ResponseCode_Request_Object_Not_Found
@@ -415,7 +607,7 @@ func (b *BackupContext) DeleteBackup(ctx context.Context, request *backuppb.Dele
 	if err != nil {
 		log.Error("Fail to delete backup", zap.String("backupName", request.GetBackupName()), zap.Error(err))
 		resp.Code = backuppb.ResponseCode_Fail
-		resp.Msg = getResp.GetMsg()
+		resp.Msg = err.Error()
 		return resp
 	}
 
@@ -427,6 +619,111 @@ func (b *BackupContext) DeleteBackup(ctx context.Context, request *backuppb.Dele
 	return resp
 }
 
+// objects listed at a time when deleting a backup object by object
+const deleteBackupPageSize = 1000
+
+// removeBackupObjects deletes all the objects of a backup. With backup.parallelism.deleteBackup, the objects are listed
+// page by page and deleted by a pool of workers, the meta files are deleted last so that an interrupted delete keeps
+// the backup visible and deleting it again removes the rest.
+func (b *BackupContext) removeBackupObjects(ctx context.Context, backupName string) error {
+	backupDir := b.backupPathOf(backupName) + SEPERATOR
+	parallelism := b.params.BackupCfg.DeleteBackupParallelism
+	if parallelism <= 0 {
+		return b.getStorageClient().RemoveWithPrefix(ctx, b.backupBucketName, backupDir)
+	}
+
+	metaDir := backupDir + META_PREFIX + SEPERATOR
+	metaKeys := make([]string, 0)
+	removed := 0
+	startAfter := ""
+	for {
+		keys, _, nextToken, err := b.getStorageClient().ListWithPrefixPage(ctx, b.backupBucketName, backupDir, true, startAfter, deleteBackupPageSize)
+		if err != nil {
+			return fmt.Errorf("fail to list objects of backup %s, err: %w", backupName, err)
+		}
+		dataKeys := make([]string, 0, len(keys))
+		for _, key := range keys {
+			if strings.HasPrefix(key, metaDir) {
+				metaKeys = append(metaKeys, key)
+			} else {
+				dataKeys = append(dataKeys, key)
+			}
+		}
+		if err := b.removeObjects(ctx, dataKeys, parallelism); err != nil {
+			return fmt.Errorf("fail to delete objects of backup %s, err: %w", backupName, err)
+		}
+		removed += len(dataKeys)
+		if len(dataKeys) > 0 {
+			log.Info("deleting backup", zap.String("backupName", backupName), zap.Int("removedObjects", removed))
+		}
+		if nextToken == "" {
+			break
+		}
+		startAfter = nextToken
+	}
+	if err := b.removeObjects(ctx, metaKeys, parallelism); err != nil {
+		return fmt.Errorf("fail to delete meta of backup %s, err: %w", backupName, err)
+	}
+	log.Info("deleted backup objects", zap.String("backupName", backupName), zap.Int("removedObjects", removed+len(metaKeys)))
+	return nil
+}
+
+// copyBackupObjects copies all the objects under the backup path fromPath to toPath in the backup bucket. The meta
+// dir is copied last so that the copy is only readable as a backup once complete, withMeta false leaves it out
+// for the callers rewriting the meta.
+func (b *BackupContext) copyBackupObjects(ctx context.Context, fromPath, toPath string, withMeta bool) error {
+	fromDir := fromPath + SEPERATOR
+	keys, _, err := b.getStorageClient().ListWithPrefix(ctx, b.backupBucketName, fromDir, true)
+	if err != nil {
+		return fmt.Errorf("fail to list objects of %s, err: %w", fromPath, err)
+	}
+	// copied by the top level dirs, a key directly under the path is copied as a dir of one object
+	metaDir := META_PREFIX + SEPERATOR
+	tops := make([]string, 0)
+	hasMeta := false
+	for _, key := range keys {
+		top := strings.SplitN(strings.TrimPrefix(key, fromDir), SEPERATOR, 2)
+		switch {
+		case len(top) == 1:
+			tops = append(tops, top[0])
+		case top[0]+SEPERATOR == metaDir:
+			hasMeta = true
+		default:
+			tops = append(tops, top[0]+SEPERATOR)
+		}
+	}
+	tops = lo.Uniq(tops)
+	if hasMeta && withMeta {
+		tops = append(tops, metaDir)
+	}
+	for _, top := range tops {
+		if err := b.getStorageClient().Copy(ctx, b.backupBucketName, b.backupBucketName, fromDir+top, toPath+SEPERATOR+top); err != nil {
+			return fmt.Errorf("fail to copy %s of %s, err: %w", top, fromPath, err)
+		}
+	}
+	return nil
+}
+
+// removeObjects deletes the objects in the backup bucket with a pool of parallelism workers
+func (b *BackupContext) removeObjects(ctx context.Context, keys []string, parallelism int) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	wp, err := common.NewWorkerPool(ctx, parallelism, RPS)
+	if err != nil {
+		return err
+	}
+	wp.Start()
+	for _, key := range keys {
+		key := key
+		wp.Submit(func(ctx context.Context) error {
+			return b.getStorageClient().Remove(ctx, b.backupBucketName, key)
+		})
+	}
+	wp.Done()
+	return wp.Wait()
+}
+
 func (b *BackupContext) readBackup(ctx context.Context, bucketName string, backupPath string) (*backuppb.BackupInfo, error) {
 	backupMetaDirPath := backupPath + SEPERATOR + META_PREFIX
 	backupMetaPath := backupMetaDirPath + SEPERATOR + BACKUP_META_FILE
@@ -459,17 +756,33 @@ func (b *BackupContext) readBackup(ctx context.Context, bucketName string, backu
 		log.Error("Read partition meta failed", zap.String("path", partitionMetaPath), zap.Error(err))
 		return nil, err
 	}
-	segmentBackupMetaBytes, err := b.getStorageClient().Read(ctx, bucketName, segmentMetaPath)
-	if err != nil {
-		log.Error("Read segment meta failed", zap.String("path", segmentMetaPath), zap.Error(err))
-		return nil, err
-	}
-
 	completeBackupMetas := &BackupMetaBytes{
 		BackupMetaBytes:     backupMetaBytes,
 		CollectionMetaBytes: collectionBackupMetaBytes,
 		PartitionMetaBytes:  partitionBackupMetaBytes,
-		SegmentMetaBytes:    segmentBackupMetaBytes,
+	}
+	backupLevel := &backuppb.BackupInfo{}
+	if err := json.Unmarshal(backupMetaBytes, backupLevel); err != nil {
+		log.Error("Fail to unmarshal backup meta", zap.String("path", backupMetaPath), zap.Error(err))
+		return nil, err
+	}
+	if backupLevel.GetSegmentMetaShards() > 0 {
+		for i := 0; i < int(backupLevel.GetSegmentMetaShards()); i++ {
+			shardPath := backupMetaDirPath + SEPERATOR + fmt.Sprintf(SEGMENT_META_SHARD_FILE, i)
+			shardBytes, err := b.getStorageClient().Read(ctx, bucketName, shardPath)
+			if err != nil {
+				log.Error("Read segment meta shard failed", zap.String("path", shardPath), zap.Error(err))
+				return nil, err
+			}
+			completeBackupMetas.SegmentMetaShards = append(completeBackupMetas.SegmentMetaShards, shardBytes)
+		}
+	} else {
+		segmentBackupMetaBytes, err := b.getStorageClient().Read(ctx, bucketName, segmentMetaPath)
+		if err != nil {
+			log.Error("Read segment meta failed", zap.String("path", segmentMetaPath), zap.Error(err))
+			return nil, err
+		}
+		completeBackupMetas.SegmentMetaBytes = segmentBackupMetaBytes
 	}
 
 	backupInfo, err := deserialize(completeBackupMetas)
@@ -509,13 +822,14 @@ func (b *BackupContext) GetRestore(ctx context.Context, request *backuppb.GetRes
 	}
 
 	task := b.meta.GetRestoreTask(request.GetId())
-	progress := int32(float32(task.GetRestoredSize()) * 100 / float32(task.GetToRestoreSize()))
-	// don't return zero
-	if progress == 0 {
-		progress = 1
-	}
-	task.Progress = progress
 	if task != nil {
+		task.Progress = restoreProgress(task.GetStateCode(), task.GetRestoredSize(), task.GetToRestoreSize())
+		for _, collTask := range task.GetCollectionRestoreTasks() {
+			collTask.Progress = restoreProgress(collTask.GetStateCode(), collTask.GetRestoredSize(), collTask.GetToRestoreSize())
+			for _, partTask := range collTask.GetPartitionRestoreTasks() {
+				partTask.Progress = restoreProgress(partTask.GetStateCode(), partTask.GetRestoredSize(), partTask.GetToRestoreSize())
+			}
+		}
 		resp.Code = backuppb.ResponseCode_Success
 		resp.Msg = "success"
 		resp.Data = task
@@ -527,6 +841,115 @@ func (b *BackupContext) GetRestore(ctx context.Context, request *backuppb.GetRes
 	}
 }
 
+// restoreProgress is the percent of restored size. Only a succeeded task is 100 and a not started one is 0,
+// an executing task is at least 1 even before any data is restored or if it has no data, and at most 99
+func restoreProgress(stateCode backuppb.RestoreTaskStateCode, restoredSize, toRestoreSize int64) int32 {
+	switch stateCode {
+	case backuppb.RestoreTaskStateCode_SUCCESS:
+		return 100
+	case backuppb.RestoreTaskStateCode_INITIAL:
+		return 0
+	}
+	var progress int32
+	if toRestoreSize > 0 {
+		progress = int32(float64(restoredSize) * 100 / float64(toRestoreSize))
+	}
+	if progress > 99 {
+		progress = 99
+	}
+	if progress < 1 && stateCode == backuppb.RestoreTaskStateCode_EXECUTING {
+		progress = 1
+	}
+	return progress
+}
+
+func (b *BackupContext) GetEvents(ctx context.Context, request *backuppb.GetEventsRequest) *backuppb.GetEventsResponse {
+	if request.GetRequestId() == "" {
+		request.RequestId = utils.UUID()
+	}
+	log.Debug("receive GetEventsRequest",
+		zap.String("requestId", request.GetRequestId()),
+		zap.String("id", request.GetId()),
+		zap.Int64("afterSeq", request.GetAfterSeq()),
+		zap.Int32("waitSeconds", request.GetWaitSeconds()))
+
+	resp := &backuppb.GetEventsResponse{
+		RequestId: request.GetRequestId(),
+	}
+
+	if request.GetId() == "" {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = "empty operation id"
+		return resp
+	}
+
+	timer := time.NewTimer(time.Duration(request.GetWaitSeconds()) * time.Second)
+	defer timer.Stop()
+	for {
+		events, notifier, exist := b.meta.GetEvents(request.GetId(), request.GetAfterSeq())
+		if !exist {
+			resp.Code = backuppb.ResponseCode_Request_Object_Not_Found
+			resp.Msg = "no events of the id in context"
+			return resp
+		}
+		if len(events) > 0 || request.GetWaitSeconds() <= 0 {
+			resp.Code = backuppb.ResponseCode_Success
+			resp.Msg = "success"
+			resp.Data = events
+			return resp
+		}
+		// long poll until a new event comes or timeout
+		select {
+		case <-notifier:
+		case <-timer.C:
+			resp.Code = backuppb.ResponseCode_Success
+			resp.Msg = "success"
+			resp.Data = events
+			return resp
+		case <-ctx.Done():
+			resp.Code = backuppb.ResponseCode_Fail
+			resp.Msg = ctx.Err().Error()
+			return resp
+		}
+	}
+}
+
+// WatchBackup returns a channel of the full meta of a backup being created in this process. The current meta is sent
+// first, then an update whenever the backup, its collections, partitions or segments change. Updates are coalesced
+// if the receiver is slower than the backup. The channel is closed after the terminal state is sent or ctx is done.
+func (b *BackupContext) WatchBackup(ctx context.Context, id string) (<-chan *backuppb.BackupInfo, error) {
+	if _, exist := b.meta.WatchBackup(id); !exist {
+		return nil, fmt.Errorf("backup %s is not being created in this process", id)
+	}
+	updates := make(chan *backuppb.BackupInfo)
+	go func() {
+		defer close(updates)
+		for {
+			// watch before reading the meta, so no change after the read is missed
+			notifier, exist := b.meta.WatchBackup(id)
+			if !exist {
+				return
+			}
+			backup := b.meta.GetFullMeta(id)
+			select {
+			case updates <- backup:
+			case <-ctx.Done():
+				return
+			}
+			switch backup.GetStateCode() {
+			case backuppb.BackupTaskStateCode_BACKUP_SUCCESS, backuppb.BackupTaskStateCode_BACKUP_FAIL, backuppb.BackupTaskStateCode_BACKUP_TIMEOUT:
+				return
+			}
+			select {
+			case <-notifier:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return updates, nil
+}
+
 func (b *BackupContext) Check(ctx context.Context) string {
 	version, err := b.getMilvusClient().GetVersion(ctx)
 	if err != nil {
@@ -556,6 +979,10 @@ func (b *BackupContext) Check(ctx context.Context) string {
 		return "Failed to connect to storage backup path " + info + err.Error()
 	}
 
+	if b.params.BackupCfg.ReadOnly {
+		return "Succeed to connect to milvus and storage, skip the write check in read-only mode.\n" + info
+	}
+
 	CHECK_PATH := "milvus_backup_check_" + time.Now().String()
 
 	err = b.getStorageClient().Write(ctx, b.milvusBucketName, b.milvusRootPath+SEPERATOR+CHECK_PATH, []byte{1})
@@ -576,3 +1003,35 @@ func (b *BackupContext) Check(ctx context.Context) string {
 
 	return "Succeed to connect to milvus and storage.\n" + info
 }
+
+// isSubPath returns whether path is base or under base, an empty base is the whole bucket
+func isSubPath(path, base string) bool {
+	path = strings.Trim(path, SEPERATOR)
+	base = strings.Trim(base, SEPERATOR)
+	return base == "" || path == base || strings.HasPrefix(path, base+SEPERATOR)
+}
+
+// checkPathOverlap rejects a milvus root path in the backup root path, which makes backups copy backup data,
+// and a backup root path in the binlog dirs of milvus, where backups are mixed with the milvus data.
+// A backup root path beside the binlog dirs, e.g. under an empty milvus root path, is fine.
+func checkPathOverlap(milvusBucket, milvusRootPath, backupBucket, backupRootPath string) error {
+	if milvusBucket != backupBucket {
+		return nil
+	}
+	if isSubPath(milvusRootPath, backupRootPath) {
+		return fmt.Errorf("milvus root path %s/%s is in the backup root path %s/%s, check minio.rootPath and minio.backupRootPath, or set backup.checkPathOverlap to false",
+			milvusBucket, milvusRootPath, backupBucket, backupRootPath)
+	}
+	milvusRoot := strings.Trim(milvusRootPath, SEPERATOR)
+	for _, dir := range []string{INSERT_LOG_DIR, DELTA_LOG_DIR, STATS_LOG_DIR} {
+		binlogDir := dir
+		if milvusRoot != "" {
+			binlogDir = milvusRoot + SEPERATOR + dir
+		}
+		if isSubPath(backupRootPath, binlogDir) {
+			return fmt.Errorf("backup root path %s/%s is in the binlog dir %s of milvus, check minio.rootPath and minio.backupRootPath, or set backup.checkPathOverlap to false",
+				backupBucket, backupRootPath, binlogDir)
+		}
+	}
+	return nil
+}
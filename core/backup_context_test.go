@@ -9,11 +9,45 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/zilliztech/milvus-backup/core/paramtable"
 	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+	"github.com/zilliztech/milvus-backup/core/storage"
 	"github.com/zilliztech/milvus-backup/core/utils"
 	"github.com/zilliztech/milvus-backup/internal/log"
 	"go.uber.org/zap"
 )
 
+// newLocalBackupContext returns a backup context on a local storage in a temp dir, for the tests without milvus
+func newLocalBackupContext(t *testing.T) *BackupContext {
+	var params paramtable.BackupParams
+	params.Init()
+	dir := t.TempDir()
+	params.MinioCfg.StorageType = paramtable.Local
+	params.MinioCfg.BackupStorageType = paramtable.Local
+	params.MinioCfg.RootPath = dir + "/files"
+	params.MinioCfg.BackupRootPath = dir + "/backup"
+	ctx := context.Background()
+	storageClient, err := storage.NewChunkManager(ctx, params)
+	assert.NoError(t, err)
+	return &BackupContext{
+		ctx:              ctx,
+		params:           params,
+		storageClient:    &storageClient,
+		milvusBucketName: "a-bucket",
+		backupBucketName: "backup-bucket",
+		milvusRootPath:   params.MinioCfg.RootPath,
+		backupRootPath:   params.MinioCfg.BackupRootPath,
+		meta:             newMetaManager(),
+	}
+}
+
+// writeLocalBackup writes the meta files of a complete backup to the backup root path
+func writeLocalBackup(t *testing.T, b *BackupContext, backupInfo *backuppb.BackupInfo) {
+	output, err := serializeWithSegmentShards(backupInfo, 0)
+	assert.NoError(t, err)
+	for _, metaFile := range backupMetaFiles(b.backupRootPath, backupInfo.GetName(), output) {
+		assert.NoError(t, b.getStorageClient().Write(b.ctx, b.backupBucketName, metaFile.path, metaFile.content))
+	}
+}
+
 func TestCreateBackup(t *testing.T) {
 	var params paramtable.BackupParams
 	params.Init()
@@ -302,3 +336,90 @@ func TestCreateAndRestoreBackup(t *testing.T) {
 		BackupName: randBackupName,
 	})
 }
+
+func TestRestoreProgress(t *testing.T) {
+	assert.Equal(t, int32(0), restoreProgress(backuppb.RestoreTaskStateCode_INITIAL, 0, 0))
+	assert.Equal(t, int32(0), restoreProgress(backuppb.RestoreTaskStateCode_INITIAL, 0, 100))
+	assert.Equal(t, int32(1), restoreProgress(backuppb.RestoreTaskStateCode_EXECUTING, 0, 100))
+	assert.Equal(t, int32(1), restoreProgress(backuppb.RestoreTaskStateCode_EXECUTING, 0, 0))
+	assert.Equal(t, int32(1), restoreProgress(backuppb.RestoreTaskStateCode_EXECUTING, 1, 1000))
+	assert.Equal(t, int32(50), restoreProgress(backuppb.RestoreTaskStateCode_EXECUTING, 50, 100))
+	// data restored but e.g. the indexes are still building
+	assert.Equal(t, int32(99), restoreProgress(backuppb.RestoreTaskStateCode_EXECUTING, 100, 100))
+	assert.Equal(t, int32(100), restoreProgress(backuppb.RestoreTaskStateCode_SUCCESS, 0, 0))
+	assert.Equal(t, int32(0), restoreProgress(backuppb.RestoreTaskStateCode_FAIL, 0, 100))
+	assert.Equal(t, int32(30), restoreProgress(backuppb.RestoreTaskStateCode_TIMEOUT, 30, 100))
+}
+
+func TestAutoCopyDataParallelism(t *testing.T) {
+	assert.Equal(t, 4, autoCopyDataParallelism(0, 4, 128))
+	assert.Equal(t, 4, autoCopyDataParallelism(3, 4, 128))
+	assert.Equal(t, 57, autoCopyDataParallelism(57, 4, 128))
+	assert.Equal(t, 128, autoCopyDataParallelism(10000, 4, 128))
+}
+
+func TestCheckPathOverlap(t *testing.T) {
+	assert.NoError(t, checkPathOverlap("a-bucket", "files", "a-bucket", "backup"))
+	assert.NoError(t, checkPathOverlap("a-bucket", "files", "b-bucket", "files"))
+	assert.NoError(t, checkPathOverlap("a-bucket", "", "a-bucket", "backup"))
+	assert.NoError(t, checkPathOverlap("a-bucket", "files", "a-bucket", "files/backup"))
+	assert.NoError(t, checkPathOverlap("a-bucket", "backup2", "a-bucket", "backup"))
+
+	assert.Error(t, checkPathOverlap("a-bucket", "backup", "a-bucket", "backup/"))
+	assert.Error(t, checkPathOverlap("a-bucket", "backup/files", "a-bucket", "backup"))
+	assert.Error(t, checkPathOverlap("a-bucket", "files", "a-bucket", ""))
+	assert.Error(t, checkPathOverlap("a-bucket", "files", "a-bucket", "files/insert_log/backup"))
+	assert.Error(t, checkPathOverlap("a-bucket", "", "a-bucket", "delta_log"))
+}
+
+func TestWatchBackup(t *testing.T) {
+	meta := newMetaManager()
+	b := &BackupContext{meta: meta}
+	_, err := b.WatchBackup(context.Background(), "backup")
+	assert.Error(t, err)
+
+	meta.AddBackup(&backuppb.BackupInfo{Id: "backup", StateCode: backuppb.BackupTaskStateCode_BACKUP_EXECUTING})
+	meta.AddCollection(&backuppb.CollectionBackupInfo{Id: "backup", CollectionId: 1})
+	meta.AddPartition(&backuppb.PartitionBackupInfo{CollectionId: 1, PartitionId: 10})
+	meta.AddSegment(&backuppb.SegmentBackupInfo{CollectionId: 1, PartitionId: 10, SegmentId: 100, Size: 10})
+	segmentBackuped := func(backup *backuppb.BackupInfo) bool {
+		return backup.GetCollectionBackups()[0].GetPartitionBackups()[0].GetSegmentBackups()[0].GetBackuped()
+	}
+
+	// multiple subscribers get the same updates
+	watchers := make([]<-chan *backuppb.BackupInfo, 0)
+	for i := 0; i < 2; i++ {
+		watcher, err := b.WatchBackup(context.Background(), "backup")
+		assert.NoError(t, err)
+		watchers = append(watchers, watcher)
+	}
+	for _, watcher := range watchers {
+		assert.False(t, segmentBackuped(<-watcher))
+	}
+	meta.UpdateSegment(10, 100, setSegmentBackuped(true))
+	for _, watcher := range watchers {
+		backup := <-watcher
+		assert.True(t, segmentBackuped(backup))
+		assert.Equal(t, int32(100), backup.GetProgress())
+	}
+
+	// closed after the terminal state
+	meta.UpdateBackup("backup", setStateCode(backuppb.BackupTaskStateCode_BACKUP_SUCCESS))
+	for _, watcher := range watchers {
+		var last *backuppb.BackupInfo
+		for backup := range watcher {
+			last = backup
+		}
+		assert.Equal(t, backuppb.BackupTaskStateCode_BACKUP_SUCCESS, last.GetStateCode())
+	}
+
+	// closed when ctx is done
+	meta.AddBackup(&backuppb.BackupInfo{Id: "backup2"})
+	ctx, cancel := context.WithCancel(context.Background())
+	watcher, err := b.WatchBackup(ctx, "backup2")
+	assert.NoError(t, err)
+	<-watcher
+	cancel()
+	for range watcher {
+	}
+}
@@ -0,0 +1,115 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+	"github.com/zilliztech/milvus-backup/internal/log"
+)
+
+// interval of the throughput log during the copy phase of a backup
+const copyStatsLogInterval = 30 * time.Second
+
+// copyStats aggregates the binlog copies of one backup, the copy workers record into it concurrently
+type copyStats struct {
+	provider string
+	start    time.Time
+
+	mu       sync.Mutex
+	bytes    int64
+	objects  int64
+	copyTime time.Duration
+}
+
+func newCopyStats(provider string) *copyStats {
+	return &copyStats{provider: provider, start: time.Now()}
+}
+
+func (s *copyStats) record(size int64, duration time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bytes += size
+	s.objects++
+	s.copyTime += duration
+}
+
+func (s *copyStats) toProto() *backuppb.CopyStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	elapsed := time.Since(s.start)
+	stats := &backuppb.CopyStats{
+		Provider:      s.provider,
+		CopiedBytes:   s.bytes,
+		CopiedObjects: s.objects,
+		ElapsedMs:     elapsed.Milliseconds(),
+		CopyTimeMs:    s.copyTime.Milliseconds(),
+	}
+	if elapsed > 0 {
+		stats.AvgMbPerSecond = float64(s.bytes) / 1024 / 1024 / elapsed.Seconds()
+	}
+	return stats
+}
+
+func copyStatsFields(stats *backuppb.CopyStats) []zap.Field {
+	return []zap.Field{
+		zap.String("provider", stats.GetProvider()),
+		zap.Int64("copiedBytes", stats.GetCopiedBytes()),
+		zap.Int64("copiedObjects", stats.GetCopiedObjects()),
+		zap.Int64("elapsedMs", stats.GetElapsedMs()),
+		zap.Int64("copyTimeMs", stats.GetCopyTimeMs()),
+		zap.Float64("avgMBPerSecond", stats.GetAvgMbPerSecond()),
+	}
+}
+
+// logPeriodically logs the aggregate throughput until ctx is done
+func (s *copyStats) logPeriodically(ctx context.Context, backupName string) {
+	ticker := time.NewTicker(copyStatsLogInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			log.Info("copy throughput of backup", append([]zap.Field{zap.String("backupName", backupName)}, copyStatsFields(s.toProto())...)...)
+		}
+	}
+}
+
+func (b *BackupContext) recordCopy(size int64, duration time.Duration) {
+	if b.copyStats != nil {
+		b.copyStats.record(size, duration)
+	}
+}
+
+// newCopyLimiter returns the limiter of the bytes copied per second shared by all the copy data workers, nil if unlimited
+func newCopyLimiter(maxBytesPerSec int64) *rate.Limiter {
+	if maxBytesPerSec <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(maxBytesPerSec), int(maxBytesPerSec))
+}
+
+// waitCopyBandwidth blocks until the object of the size can be copied under backup.copydata.maxBytesPerSec,
+// an object larger than the bytes of one second is charged by chunks of one second
+func (b *BackupContext) waitCopyBandwidth(ctx context.Context, size int64) error {
+	if b.copyLimiter == nil {
+		return nil
+	}
+	burst := int64(b.copyLimiter.Burst())
+	for size > 0 {
+		n := size
+		if n > burst {
+			n = burst
+		}
+		if err := b.copyLimiter.WaitN(ctx, int(n)); err != nil {
+			return err
+		}
+		size -= n
+	}
+	return nil
+}
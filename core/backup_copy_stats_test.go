@@ -0,0 +1,27 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitCopyBandwidth(t *testing.T) {
+	assert.Nil(t, newCopyLimiter(0))
+	b := &BackupContext{}
+	assert.NoError(t, b.waitCopyBandwidth(context.Background(), 1<<40))
+
+	// the burst of one second is available at start, the rest is charged at the limit
+	b.copyLimiter = newCopyLimiter(1000)
+	start := time.Now()
+	assert.NoError(t, b.waitCopyBandwidth(context.Background(), 1000))
+	assert.NoError(t, b.waitCopyBandwidth(context.Background(), 200))
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+
+	// objects larger than the burst are charged by chunks
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	assert.Error(t, b.waitCopyBandwidth(ctx, 5000))
+}
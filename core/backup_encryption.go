@@ -0,0 +1,312 @@
+package core
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+const (
+	EncryptionSchemeAES256GCM = "AES-256-GCM"
+
+	encryptionKeySize  = 32
+	encryptionSaltSize = 16
+	// scrypt cost of the key derivation from the passphrase, it is done once per backup or restore
+	encryptionScryptN = 1 << 15
+	encryptionScryptR = 8
+	encryptionScryptP = 1
+
+	// size of the plain data sealed in one frame of an encrypted binlog
+	encryptionFrameSize = 64 << 10
+)
+
+// binlogCipher encrypts and decrypts the binlogs of a backup with its data key.
+// A binlog is encrypted as a stream, so it is never held in memory as a whole: a random nonce followed by frames of
+// encryptionFrameSize bytes of data sealed by AES-256-GCM, the last frame is shorter, or empty. The nonce of a frame is
+// the nonce of the binlog xor its index, and the last frame is sealed with a marker, so the frames can't be reordered,
+// removed or truncated. The data key of the backup itself is sealed as a whole by encrypt.
+type binlogCipher struct {
+	aead cipher.AEAD
+}
+
+func newBinlogCipher(key []byte) (*binlogCipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &binlogCipher{aead: aead}, nil
+}
+
+func (c *binlogCipher) encrypt(data []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize(), c.aead.NonceSize()+len(data)+c.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return c.aead.Seal(nonce, nonce, data, nil), nil
+}
+
+func (c *binlogCipher) decrypt(data []byte) ([]byte, error) {
+	nonceSize := c.aead.NonceSize()
+	if len(data) < nonceSize+c.aead.Overhead() {
+		return nil, fmt.Errorf("encrypted data of %d bytes is too short", len(data))
+	}
+	return c.aead.Open(nil, data[:nonceSize], data[nonceSize:], nil)
+}
+
+// encryptedSize is the size of a binlog of the size after encryption
+func (c *binlogCipher) encryptedSize(size int64) int64 {
+	frames := size/encryptionFrameSize + 1
+	return int64(c.aead.NonceSize()) + size + frames*int64(c.aead.Overhead())
+}
+
+// frameNonce is the nonce of the frame at the index of a binlog
+func frameNonce(nonce []byte, index uint64) []byte {
+	frame := append([]byte{}, nonce...)
+	counter := frame[len(frame)-8:]
+	binary.BigEndian.PutUint64(counter, binary.BigEndian.Uint64(counter)^index)
+	return frame
+}
+
+// frameAdditionalData marks the last frame of a binlog
+func frameAdditionalData(last bool) []byte {
+	if last {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+// encryptReader returns the encrypted stream of the binlog read from src
+func (c *binlogCipher) encryptReader(src io.Reader) (io.Reader, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return &encryptReader{
+		aead:  c.aead,
+		src:   src,
+		nonce: nonce,
+		plain: make([]byte, encryptionFrameSize),
+		out:   append([]byte{}, nonce...),
+	}, nil
+}
+
+type encryptReader struct {
+	aead  cipher.AEAD
+	src   io.Reader
+	nonce []byte
+	index uint64
+	plain []byte
+	// sealed bytes not read yet
+	out  []byte
+	done bool
+}
+
+func (r *encryptReader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		n, err := io.ReadFull(r.src, r.plain)
+		last := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !last {
+			return 0, err
+		}
+		r.out = r.aead.Seal(r.out[:0], frameNonce(r.nonce, r.index), r.plain[:n], frameAdditionalData(last))
+		r.index++
+		r.done = last
+	}
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+// decryptReader returns the plain stream of the encrypted binlog read from src,
+// reading fails if the binlog isn't exactly the one encrypted by the cipher
+func (c *binlogCipher) decryptReader(src io.Reader) io.Reader {
+	return &decryptReader{
+		aead:   c.aead,
+		src:    src,
+		sealed: make([]byte, encryptionFrameSize+c.aead.Overhead()),
+	}
+}
+
+type decryptReader struct {
+	aead   cipher.AEAD
+	src    io.Reader
+	nonce  []byte
+	index  uint64
+	sealed []byte
+	// opened bytes not read yet
+	out  []byte
+	done bool
+}
+
+func (r *decryptReader) Read(p []byte) (int, error) {
+	if r.nonce == nil {
+		nonce := make([]byte, r.aead.NonceSize())
+		if _, err := io.ReadFull(r.src, nonce); err != nil {
+			return 0, fmt.Errorf("encrypted data is too short, err: %w", err)
+		}
+		r.nonce = nonce
+	}
+	for len(r.out) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		// all the frames but the last one are full
+		n, err := io.ReadFull(r.src, r.sealed)
+		last := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !last {
+			return 0, err
+		}
+		opened, err := r.aead.Open(r.out[:0], frameNonce(r.nonce, r.index), r.sealed[:n], frameAdditionalData(last))
+		if err != nil {
+			return 0, fmt.Errorf("fail to decrypt frame %d, err: %w", r.index, err)
+		}
+		r.out = opened
+		r.index++
+		r.done = last
+	}
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+func deriveKeyEncryptionKey(passphrase string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(passphrase), salt, encryptionScryptN, encryptionScryptR, encryptionScryptP, encryptionKeySize)
+}
+
+// newBackupEncryption generates the random data key of a new backup,
+// and records it encrypted by the key derived from the passphrase in the backup meta
+func newBackupEncryption(passphrase, keyID string, backup *backuppb.BackupInfo) error {
+	if passphrase == "" {
+		return errors.New("backup.encryption.passphrase is required to encrypt backups")
+	}
+	salt := make([]byte, encryptionSaltSize)
+	dataKey := make([]byte, encryptionKeySize)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	if _, err := rand.Read(dataKey); err != nil {
+		return err
+	}
+	keyEncryptionKey, err := deriveKeyEncryptionKey(passphrase, salt)
+	if err != nil {
+		return err
+	}
+	keyCipher, err := newBinlogCipher(keyEncryptionKey)
+	if err != nil {
+		return err
+	}
+	encryptedDataKey, err := keyCipher.encrypt(dataKey)
+	if err != nil {
+		return err
+	}
+	backup.EncryptionScheme = EncryptionSchemeAES256GCM
+	backup.EncryptionKeyId = keyID
+	backup.EncryptionSalt = salt
+	backup.EncryptedDataKey = encryptedDataKey
+	return nil
+}
+
+// openBackupEncryption decrypts the data key of an encrypted backup with the passphrase,
+// it returns nil for the backups without encryption
+func openBackupEncryption(passphrase, keyID string, backup *backuppb.BackupInfo) (*binlogCipher, error) {
+	switch backup.GetEncryptionScheme() {
+	case "":
+		return nil, nil
+	case EncryptionSchemeAES256GCM:
+	default:
+		return nil, fmt.Errorf("backup %s is encrypted by unsupported scheme %s", backup.GetName(), backup.GetEncryptionScheme())
+	}
+	if passphrase == "" {
+		return nil, fmt.Errorf("backup %s is encrypted with the key id %q, set backup.encryption.passphrase to read it", backup.GetName(), backup.GetEncryptionKeyId())
+	}
+	if keyID != "" && backup.GetEncryptionKeyId() != "" && keyID != backup.GetEncryptionKeyId() {
+		return nil, fmt.Errorf("backup %s is encrypted with the key id %q, but backup.encryption.keyId is %q", backup.GetName(), backup.GetEncryptionKeyId(), keyID)
+	}
+	keyEncryptionKey, err := deriveKeyEncryptionKey(passphrase, backup.GetEncryptionSalt())
+	if err != nil {
+		return nil, err
+	}
+	keyCipher, err := newBinlogCipher(keyEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := keyCipher.decrypt(backup.GetEncryptedDataKey())
+	if err != nil {
+		return nil, fmt.Errorf("fail to decrypt the data key of backup %s, check backup.encryption.passphrase, err: %w", backup.GetName(), err)
+	}
+	return newBinlogCipher(dataKey)
+}
+
+// backupCipher returns the cipher of the binlogs of the backup, nil if they are not encrypted.
+// The data key is decrypted once per backup, the key derivation is slow by design.
+func (b *BackupContext) backupCipher(backup *backuppb.BackupInfo) (*binlogCipher, error) {
+	if backup.GetEncryptionScheme() == "" {
+		return nil, nil
+	}
+	if value, ok := b.binlogCiphers.Load(string(backup.GetEncryptedDataKey())); ok {
+		return value.(*binlogCipher), nil
+	}
+	binlogCipher, err := openBackupEncryption(b.params.BackupCfg.EncryptionPassphrase, b.params.BackupCfg.EncryptionKeyID, backup)
+	if err != nil {
+		return nil, err
+	}
+	b.binlogCiphers.Store(string(backup.GetEncryptedDataKey()), binlogCipher)
+	return binlogCipher, nil
+}
+
+// copyEncryptedBinlog streams a binlog of milvus encrypted into the backup bucket
+func (b *BackupContext) copyEncryptedBinlog(ctx context.Context, binlogCipher *binlogCipher, fromPath, toPath string) error {
+	reader, err := b.getStorageClient().Reader(ctx, b.milvusBucketName, fromPath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	encrypted, err := binlogCipher.encryptReader(reader)
+	if err != nil {
+		return err
+	}
+	return b.getStorageClient().WriteStream(ctx, b.backupBucketName, toPath, encrypted, -1)
+}
+
+// copyDecryptedFiles streams the objects with the prefix decrypted into the staging bucket, for bulk insert to read them
+func (b *BackupContext) copyDecryptedFiles(ctx context.Context, binlogCipher *binlogCipher, fromBucketName, toBucketName, fromPath, toPath string) error {
+	keys, _, err := b.getStorageClient().ListWithPrefix(ctx, fromBucketName, fromPath, true)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := b.copyDecryptedFile(ctx, binlogCipher, fromBucketName, toBucketName, key, strings.Replace(key, fromPath, toPath, 1)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *BackupContext) copyDecryptedFile(ctx context.Context, binlogCipher *binlogCipher, fromBucketName, toBucketName, fromPath, toPath string) error {
+	reader, err := b.getStorageClient().Reader(ctx, fromBucketName, fromPath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	if err := b.getStorageClient().WriteStream(ctx, toBucketName, toPath, binlogCipher.decryptReader(reader), -1); err != nil {
+		return fmt.Errorf("fail to decrypt %s, err: %w", fromPath, err)
+	}
+	return nil
+}
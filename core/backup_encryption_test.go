@@ -0,0 +1,84 @@
+package core
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+func TestBinlogCipherStream(t *testing.T) {
+	key := make([]byte, encryptionKeySize)
+	_, err := rand.Read(key)
+	assert.NoError(t, err)
+	binlogCipher, err := newBinlogCipher(key)
+	assert.NoError(t, err)
+
+	for _, size := range []int{0, 1, encryptionFrameSize - 1, encryptionFrameSize, 3*encryptionFrameSize + 5} {
+		data := make([]byte, size)
+		_, err := rand.Read(data)
+		assert.NoError(t, err)
+		reader, err := binlogCipher.encryptReader(bytes.NewReader(data))
+		assert.NoError(t, err)
+		encrypted, err := io.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, binlogCipher.encryptedSize(int64(size)), int64(len(encrypted)))
+
+		decrypted, err := io.ReadAll(binlogCipher.decryptReader(bytes.NewReader(encrypted)))
+		assert.NoError(t, err)
+		assert.Equal(t, data, decrypted)
+
+		// a changed byte, a truncated binlog or a missing frame fails the decryption
+		corrupted := append([]byte{}, encrypted...)
+		corrupted[len(corrupted)/2] ^= 1
+		_, err = io.ReadAll(binlogCipher.decryptReader(bytes.NewReader(corrupted)))
+		assert.Error(t, err)
+		_, err = io.ReadAll(binlogCipher.decryptReader(bytes.NewReader(encrypted[:len(encrypted)-1])))
+		assert.Error(t, err)
+		if size >= encryptionFrameSize {
+			frame := encryptionFrameSize + binlogCipher.aead.Overhead()
+			nonceSize := binlogCipher.aead.NonceSize()
+			_, err = io.ReadAll(binlogCipher.decryptReader(bytes.NewReader(append(append([]byte{}, encrypted[:nonceSize]...), encrypted[nonceSize+frame:]...))))
+			assert.Error(t, err)
+			_, err = io.ReadAll(binlogCipher.decryptReader(bytes.NewReader(encrypted[:nonceSize+frame])))
+			assert.Error(t, err)
+		}
+	}
+	_, err = io.ReadAll(binlogCipher.decryptReader(bytes.NewReader(nil)))
+	assert.Error(t, err)
+}
+
+func TestBinlogCipher(t *testing.T) {
+	backup := &backuppb.BackupInfo{Name: "b1"}
+	binlogCipher, err := openBackupEncryption("secret", "", backup)
+	assert.NoError(t, err)
+	assert.Nil(t, binlogCipher)
+
+	assert.Error(t, newBackupEncryption("", "key1", backup))
+	assert.NoError(t, newBackupEncryption("secret", "key1", backup))
+	assert.Equal(t, EncryptionSchemeAES256GCM, backup.GetEncryptionScheme())
+	assert.Equal(t, "key1", backup.GetEncryptionKeyId())
+
+	binlogCipher, err = openBackupEncryption("secret", "key1", backup)
+	assert.NoError(t, err)
+	encrypted, err := binlogCipher.encrypt([]byte("binlog"))
+	assert.NoError(t, err)
+	assert.Equal(t, binlogCipher.encryptedSize(int64(len("binlog"))), int64(len(encrypted)))
+	decrypted, err := binlogCipher.decrypt(encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("binlog"), decrypted)
+	encrypted[len(encrypted)-1] ^= 1
+	_, err = binlogCipher.decrypt(encrypted)
+	assert.Error(t, err)
+
+	_, err = openBackupEncryption("wrong", "key1", backup)
+	assert.Error(t, err)
+	_, err = openBackupEncryption("secret", "key2", backup)
+	assert.Error(t, err)
+	_, err = openBackupEncryption("", "", backup)
+	assert.Error(t, err)
+}
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zilliztech/milvus-backup/internal/log"
+)
+
+// time to wait for the other collections of the same database to join a batch flush
+const FlushBatchWindow = 200 * time.Millisecond
+
+type flushRequest struct {
+	collectionName string
+	result         chan flushResponse
+}
+
+type flushResponse struct {
+	FlushResult
+	err error
+}
+
+// flushCollectionInBatch adds the collection to the pending batch of its database and waits for the result.
+// A batch is flushed once it has backup.flushBatchSize collections or FlushBatchWindow after its first collection.
+func (b *BackupContext) flushCollectionInBatch(ctx context.Context, db, collectionName string) (FlushResult, error) {
+	req := &flushRequest{collectionName: collectionName, result: make(chan flushResponse, 1)}
+	b.flushBatchMu.Lock()
+	if b.pendingFlushes == nil {
+		b.pendingFlushes = make(map[string][]*flushRequest)
+	}
+	pending := append(b.pendingFlushes[db], req)
+	// backups are executed one by one, the collections of a batch are of the same backup and share its ctx
+	if len(pending) >= b.params.BackupCfg.FlushBatchSize {
+		delete(b.pendingFlushes, db)
+		go b.executeFlushBatch(ctx, db, pending)
+	} else {
+		b.pendingFlushes[db] = pending
+		if len(pending) == 1 {
+			time.AfterFunc(FlushBatchWindow, func() { b.takeFlushBatch(ctx, db) })
+		}
+	}
+	b.flushBatchMu.Unlock()
+
+	select {
+	case resp := <-req.result:
+		return resp.FlushResult, resp.err
+	case <-ctx.Done():
+		return FlushResult{}, ctx.Err()
+	}
+}
+
+func (b *BackupContext) takeFlushBatch(ctx context.Context, db string) {
+	b.flushBatchMu.Lock()
+	pending := b.pendingFlushes[db]
+	delete(b.pendingFlushes, db)
+	b.flushBatchMu.Unlock()
+	if len(pending) > 0 {
+		b.executeFlushBatch(ctx, db, pending)
+	}
+}
+
+// executeFlushBatch flushes the collections in one call, the collections without result in it are flushed one by one.
+// The collections fail with the error of ctx if it is done before the batch gets its turn to flush.
+func (b *BackupContext) executeFlushBatch(ctx context.Context, db string, reqs []*flushRequest) {
+	select {
+	case b.flushSemaphore <- struct{}{}:
+	case <-ctx.Done():
+		for _, req := range reqs {
+			req.result <- flushResponse{err: ctx.Err()}
+		}
+		return
+	}
+	defer func() { <-b.flushSemaphore }()
+
+	collectionNames := make([]string, 0, len(reqs))
+	for _, req := range reqs {
+		collectionNames = append(collectionNames, req.collectionName)
+	}
+	start := time.Now()
+	results, err := b.getMilvusClient().FlushBatch(b.ctx, db, collectionNames)
+	if err != nil {
+		log.Warn("fail to flush collections in batch, flush them one by one",
+			zap.String("databaseName", db),
+			zap.Strings("collectionNames", collectionNames),
+			zap.Error(err))
+	} else {
+		log.Debug("flush collections in batch done",
+			zap.String("databaseName", db),
+			zap.Strings("collectionNames", collectionNames),
+			zap.Duration("cost", time.Since(start)))
+	}
+
+	for _, req := range reqs {
+		if result, ok := results[req.collectionName]; ok {
+			req.result <- flushResponse{FlushResult: result}
+			continue
+		}
+		newSealedSegmentIDs, flushedSegmentIDs, timeOfSeal, channelCPs, err := b.getMilvusClient().FlushV2(b.ctx, db, req.collectionName, false)
+		req.result <- flushResponse{
+			FlushResult: FlushResult{
+				NewSealedSegmentIDs: newSealedSegmentIDs,
+				FlushedSegmentIDs:   flushedSegmentIDs,
+				TimeOfSeal:          timeOfSeal,
+				ChannelCPs:          channelCPs,
+			},
+			err: err,
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	gomilvus "github.com/milvus-io/milvus-sdk-go/v2/client"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// flushClient is a milvus client without batch flush, its FlushV2 fails for the collections in errs
+type flushClient struct {
+	gomilvus.Client
+	mu      sync.Mutex
+	flushed []string
+	errs    map[string]error
+}
+
+func (c *flushClient) UsingDatabase(ctx context.Context, dbName string) error {
+	return nil
+}
+
+func (c *flushClient) FlushV2(ctx context.Context, collName string, async bool, opts ...gomilvus.FlushOption) ([]int64, []int64, int64, map[string]msgpb.MsgPosition, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.flushed = append(c.flushed, collName)
+	if err := c.errs[collName]; err != nil {
+		return nil, nil, 0, nil, err
+	}
+	return []int64{int64(len(c.flushed))}, nil, int64(len(c.flushed)), nil, nil
+}
+
+func TestFlushCollectionInBatch(t *testing.T) {
+	client := &flushClient{errs: map[string]error{"c2": errors.New("mock flush error")}}
+	b := &BackupContext{
+		ctx:            context.Background(),
+		milvusClient:   &MilvusClient{client: client},
+		flushSemaphore: make(chan struct{}, 1),
+	}
+	b.params.BackupCfg.FlushBatchSize = 2
+
+	// the first two collections are a full batch, the third one is flushed after the batch window,
+	// the batch flush fails and each collection is flushed alone
+	var wg sync.WaitGroup
+	results := make([]FlushResult, 3)
+	errs := make([]error, 3)
+	for i, collectionName := range []string{"c1", "c2", "c3"} {
+		wg.Add(1)
+		go func(i int, collectionName string) {
+			defer wg.Done()
+			results[i], errs[i] = b.flushCollectionInBatch(context.Background(), "db1", collectionName)
+		}(i, collectionName)
+		time.Sleep(20 * time.Millisecond)
+	}
+	wg.Wait()
+	assert.ElementsMatch(t, []string{"c1", "c2", "c3"}, client.flushed)
+	assert.NoError(t, errs[0])
+	assert.NotZero(t, results[0].TimeOfSeal)
+	assert.ErrorContains(t, errs[1], "mock flush error")
+	assert.NoError(t, errs[2])
+	assert.NotZero(t, results[2].TimeOfSeal)
+	b.flushBatchMu.Lock()
+	defer b.flushBatchMu.Unlock()
+	assert.Empty(t, b.pendingFlushes["db1"])
+}
+
+func TestExecuteFlushBatchCanceled(t *testing.T) {
+	client := &flushClient{}
+	b := &BackupContext{
+		ctx:            context.Background(),
+		milvusClient:   &MilvusClient{client: client},
+		flushSemaphore: make(chan struct{}, 1),
+	}
+	// the only flush slot is taken, the canceled batch gives up instead of waiting for it
+	b.flushSemaphore <- struct{}{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := &flushRequest{collectionName: "c1", result: make(chan flushResponse, 1)}
+	b.executeFlushBatch(ctx, "db1", []*flushRequest{req})
+	resp := <-req.result
+	assert.ErrorIs(t, resp.err, context.Canceled)
+	assert.Empty(t, client.flushed)
+}
+
+// flushStateService returns the flush states in order, the last one again after them
+type flushStateService struct {
+	milvuspb.MilvusServiceClient
+	calls  int
+	states []*milvuspb.GetFlushStateResponse
+	errs   []error
+}
+
+func (s *flushStateService) GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error) {
+	i := s.calls
+	if i >= len(s.states) {
+		i = len(s.states) - 1
+	}
+	s.calls++
+	return s.states[i], s.errs[i]
+}
+
+func TestWaitBatchFlushed(t *testing.T) {
+	req := &milvuspb.GetFlushStateRequest{SegmentIDs: []int64{1}, CollectionName: "c1"}
+
+	// an error while polling is retried
+	service := &flushStateService{
+		states: []*milvuspb.GetFlushStateResponse{nil, {Status: &commonpb.Status{}, Flushed: true}},
+		errs:   []error{errors.New("mock get flush state error"), nil},
+	}
+	assert.NoError(t, waitBatchFlushed(context.Background(), service, req))
+	assert.Equal(t, 2, service.calls)
+
+	// the poll gives up with its context and returns the last error
+	service = &flushStateService{
+		states: []*milvuspb.GetFlushStateResponse{{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock not ready"}}},
+		errs:   []error{nil},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	err := waitBatchFlushed(ctx, service, req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "mock not ready")
+}
@@ -0,0 +1,153 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+	"github.com/zilliztech/milvus-backup/core/utils"
+	"github.com/zilliztech/milvus-backup/internal/log"
+)
+
+const DefaultOrphanGracePeriod = 24 * time.Hour
+
+// CleanupOrphans removes the backup dirs without backup meta, which are left by crashed backups.
+// Backups still in progress and dirs modified within the grace period are never removed.
+func (b *BackupContext) CleanupOrphans(ctx context.Context, request *backuppb.CleanupOrphansRequest) *backuppb.CleanupOrphansResponse {
+	if request.GetRequestId() == "" {
+		request.RequestId = utils.UUID()
+	}
+	log.Info("receive CleanupOrphansRequest",
+		zap.String("requestId", request.GetRequestId()),
+		zap.Bool("dryRun", request.GetDryRun()),
+		zap.Int64("gracePeriodSeconds", request.GetGracePeriodSeconds()),
+		zap.String("continuationToken", request.GetContinuationToken()),
+		zap.Int32("limit", request.GetLimit()))
+
+	resp := &backuppb.CleanupOrphansResponse{
+		RequestId: request.GetRequestId(),
+	}
+
+	if !b.started {
+		err := b.Start()
+		if err != nil {
+			resp.Code = backuppb.ResponseCode_Fail
+			resp.Msg = err.Error()
+			return resp
+		}
+	}
+	if b.params.BackupCfg.ReadOnly && !request.GetDryRun() {
+		resp.Code = backuppb.ResponseCode_No_Permission
+		resp.Msg = ErrReadOnly.Error()
+		return resp
+	}
+
+	if request.GetGracePeriodSeconds() < 0 {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = "grace period can not be negative"
+		return resp
+	}
+	if request.GetLimit() < 0 {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = "limit can not be negative"
+		return resp
+	}
+	gracePeriod := DefaultOrphanGracePeriod
+	if request.GetGracePeriodSeconds() > 0 {
+		gracePeriod = time.Duration(request.GetGracePeriodSeconds()) * time.Second
+	}
+
+	backupPaths, _, nextToken, err := b.getStorageClient().ListWithPrefixPage(ctx, b.backupBucketName, b.backupRootPath+SEPERATOR, false,
+		request.GetContinuationToken(), int(request.GetLimit()))
+	if err != nil {
+		log.Error("Fail to list backup directory", zap.Error(err))
+		resp.Code = backuppb.ResponseCode_Fail
+		resp.Msg = err.Error()
+		return resp
+	}
+
+	orphans := make([]string, 0)
+	for _, backupPath := range backupPaths {
+		backupName := BackupPathToName(b.backupRootPath, backupPath)
+		if backupName == "" {
+			continue
+		}
+		backupDir := BackupDirPath(b.backupRootPath, backupName)
+		// restore staging dir is under backupRootPath by default, it is not a backup
+		if b.milvusBucketName == b.backupBucketName &&
+			strings.HasPrefix(strings.TrimSuffix(b.params.BackupCfg.RestoreStagingPath, SEPERATOR)+SEPERATOR, backupDir) {
+			continue
+		}
+		if b.meta.IsBackupInProgress(backupName) {
+			log.Info("skip backup in progress", zap.String("backupName", backupName))
+			continue
+		}
+		// backup meta is the last file written by a backup, it exists means the backup is complete
+		exist, err := b.getStorageClient().Exist(ctx, b.backupBucketName, BackupMetaPath(b.backupRootPath, backupName))
+		if err != nil {
+			log.Error("check backup meta file failed", zap.String("backupName", backupName), zap.Error(err))
+			resp.Code = backuppb.ResponseCode_Fail
+			resp.Msg = err.Error()
+			return resp
+		}
+		if exist {
+			continue
+		}
+		// in the nested layout, a dir without backup meta can hold the backups of a subdirectory
+		if b.nestedLayout() {
+			nested, err := b.containsNestedBackup(ctx, backupDir)
+			if err != nil {
+				log.Error("fail to check nested backups", zap.String("backupName", backupName), zap.Error(err))
+				resp.Code = backuppb.ResponseCode_Fail
+				resp.Msg = err.Error()
+				return resp
+			}
+			if nested {
+				continue
+			}
+		}
+		lastModified, err := b.getStorageClient().LastModified(ctx, b.backupBucketName, backupDir)
+		if err != nil {
+			log.Error("fail to get last modified time of backup", zap.String("backupName", backupName), zap.Error(err))
+			resp.Code = backuppb.ResponseCode_Fail
+			resp.Msg = err.Error()
+			return resp
+		}
+		if time.Since(lastModified) < gracePeriod {
+			log.Info("skip orphan backup within grace period",
+				zap.String("backupName", backupName),
+				zap.Time("lastModified", lastModified))
+			continue
+		}
+		orphans = append(orphans, backupName)
+		if request.GetDryRun() {
+			log.Info("found orphan backup", zap.String("backupName", backupName), zap.Time("lastModified", lastModified))
+			continue
+		}
+		err = b.getStorageClient().RemoveWithPrefix(ctx, b.backupBucketName, backupDir)
+		if err != nil {
+			errMsg := fmt.Sprintf("fail to remove orphan backup %s, err: %s", backupName, err)
+			log.Error(errMsg)
+			resp.Code = backuppb.ResponseCode_Fail
+			resp.Msg = errMsg
+			resp.Orphans = orphans
+			return resp
+		}
+		log.Info("removed orphan backup", zap.String("backupName", backupName), zap.Time("lastModified", lastModified))
+	}
+
+	resp.Code = backuppb.ResponseCode_Success
+	resp.Msg = "success"
+	resp.Orphans = orphans
+	resp.NextContinuationToken = nextToken
+	log.Info("return CleanupOrphansResponse",
+		zap.String("requestId", resp.GetRequestId()),
+		zap.Bool("dryRun", request.GetDryRun()),
+		zap.Strings("orphans", orphans),
+		zap.String("nextContinuationToken", nextToken))
+	return resp
+}
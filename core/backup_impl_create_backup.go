@@ -2,24 +2,33 @@ package core
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"path"
+	"runtime/debug"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	jsoniter "github.com/json-iterator/go"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-sdk-go/v2/entity"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 
+	"github.com/zilliztech/milvus-backup/core/paramtable"
 	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
 	"github.com/zilliztech/milvus-backup/core/utils"
+	"github.com/zilliztech/milvus-backup/internal/common"
 	"github.com/zilliztech/milvus-backup/internal/log"
 	"github.com/zilliztech/milvus-backup/internal/util/retry"
 )
@@ -35,7 +44,16 @@ func (b *BackupContext) CreateBackup(ctx context.Context, request *backuppb.Crea
 		zap.String("databaseCollections", utils.GetCreateDBCollections(request)),
 		zap.Bool("async", request.GetAsync()),
 		zap.Bool("force", request.GetForce()),
-		zap.Bool("metaOnly", request.GetMetaOnly()))
+		zap.Bool("metaOnly", request.GetMetaOnly()),
+		zap.Bool("schemaTemplateOnly", request.GetSchemaTemplateOnly()),
+		zap.Strings("binlogTypes", request.GetBinlogTypes()),
+		zap.Int64("maxSnapshotSpreadSeconds", request.GetMaxSnapshotSpreadSeconds()),
+		zap.Bool("verify", request.GetVerify()),
+		zap.String("partitionScope", request.GetPartitionScope()),
+		zap.Bool("continueOnError", request.GetContinueOnError()),
+		zap.Any("propertySelector", request.GetPropertySelector()),
+		zap.Bool("resume", request.GetResume()),
+		zap.String("baseBackupName", request.GetBaseBackupName()))
 
 	resp := &backuppb.BackupInfoResponse{
 		RequestId: request.GetRequestId(),
@@ -49,13 +67,32 @@ func (b *BackupContext) CreateBackup(ctx context.Context, request *backuppb.Crea
 			return resp
 		}
 	}
+	if b.params.BackupCfg.ReadOnly {
+		resp.Code = backuppb.ResponseCode_No_Permission
+		resp.Msg = ErrReadOnly.Error()
+		return resp
+	}
+
+	if request.GetResume() && request.GetBackupName() == "" {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = "backup name is required to resume a backup"
+		return resp
+	}
 
 	// backup name validate
+	resumed := false
 	if request.GetBackupName() == "" {
-		request.BackupName = "backup_" + fmt.Sprint(time.Now().UTC().Format("2006_01_02_15_04_05_")) + fmt.Sprint(time.Now().Nanosecond())
+		name, err := b.generateBackupName(ctx)
+		if err != nil {
+			log.Error("fail to generate backup name", zap.Error(err))
+			resp.Code = backuppb.ResponseCode_Fail
+			resp.Msg = err.Error()
+			return resp
+		}
+		request.BackupName = name
 	}
 	if request.GetBackupName() != "" {
-		exist, err := b.getStorageClient().Exist(b.ctx, b.backupBucketName, b.backupRootPath+SEPERATOR+request.GetBackupName())
+		exist, err := b.getStorageClient().Exist(b.ctx, b.backupBucketName, BackupPath(b.backupRootPath, request.GetBackupName()))
 		if err != nil {
 			errMsg := fmt.Sprintf("fail to check whether exist backup with name: %s", request.GetBackupName())
 			log.Error(errMsg, zap.Error(err))
@@ -63,7 +100,22 @@ func (b *BackupContext) CreateBackup(ctx context.Context, request *backuppb.Crea
 			resp.Msg = errMsg + "/n" + err.Error()
 			return resp
 		}
-		if exist {
+		if exist && request.GetResume() {
+			errMsg, err := b.checkBackupResumable(request.GetBackupName())
+			if err != nil {
+				log.Error(errMsg, zap.Error(err))
+				resp.Code = backuppb.ResponseCode_Fail
+				resp.Msg = errMsg + "/n" + err.Error()
+				return resp
+			}
+			if errMsg != "" {
+				log.Error(errMsg)
+				resp.Code = backuppb.ResponseCode_Parameter_Error
+				resp.Msg = errMsg
+				return resp
+			}
+			resumed = true
+		} else if exist {
 			errMsg := fmt.Sprintf("backup already exist with the name: %s", request.GetBackupName())
 			log.Error(errMsg)
 			resp.Code = backuppb.ResponseCode_Parameter_Error
@@ -79,20 +131,89 @@ func (b *BackupContext) CreateBackup(ctx context.Context, request *backuppb.Crea
 		return resp
 	}
 
-	milvusVersion, err := b.getMilvusClient().GetVersion(b.ctx)
+	if request.GetMaxSnapshotSpreadSeconds() < 0 {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = "max snapshot spread can not be negative"
+		return resp
+	}
+
+	if len(request.GetCollectionIds()) > 0 && (len(request.GetCollectionNames()) > 0 || utils.GetCreateDBCollections(request) != "") {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = "collection ids can not be used with collection names or db collections"
+		return resp
+	}
+
+	if _, err := filterBackupPartitions(nil, request.GetPartitionScope(), false); err != nil {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = err.Error()
+		return resp
+	}
+
+	requestBinlogTypes := request.GetBinlogTypes()
+	if len(requestBinlogTypes) == 0 {
+		requestBinlogTypes = b.params.BackupCfg.BinlogTypes
+	}
+	binlogTypes, err := ParseBinlogTypes(requestBinlogTypes)
 	if err != nil {
-		log.Error("fail to get milvus version", zap.Error(err))
-		resp.Code = backuppb.ResponseCode_Fail
+		log.Error("illegal binlog types", zap.Error(err))
+		resp.Code = backuppb.ResponseCode_Parameter_Error
 		resp.Msg = err.Error()
 		return resp
 	}
 
+	var base *backuppb.BackupInfo
+	if request.GetBaseBackupName() != "" {
+		var errMsg string
+		base, errMsg = b.checkBaseBackup(ctx, request)
+		if errMsg != "" {
+			log.Error(errMsg)
+			resp.Code = backuppb.ResponseCode_Parameter_Error
+			resp.Msg = errMsg
+			return resp
+		}
+	}
+
+	milvusVersion, err := b.getMilvusClient().GetVersion(b.ctx)
+	if err != nil {
+		if !b.params.BackupCfg.IgnoreVersionError {
+			log.Error("fail to get milvus version", zap.Error(err))
+			resp.Code = backuppb.ResponseCode_Fail
+			resp.Msg = err.Error()
+			return resp
+		}
+		// the version is only informational in the backup meta
+		log.Warn("fail to get milvus version, record it as unknown because of backup.ignoreVersionError", zap.Error(err))
+		milvusVersion = UnknownMilvusVersion
+	}
+
 	backup := &backuppb.BackupInfo{
-		Id:            request.GetRequestId(),
-		StateCode:     backuppb.BackupTaskStateCode_BACKUP_INITIAL,
-		StartTime:     time.Now().UnixNano() / int64(time.Millisecond),
-		Name:          request.BackupName,
-		MilvusVersion: milvusVersion,
+		Id:                 request.GetRequestId(),
+		StateCode:          backuppb.BackupTaskStateCode_BACKUP_INITIAL,
+		StartTime:          time.Now().UnixNano() / int64(time.Millisecond),
+		Name:               request.BackupName,
+		MilvusVersion:      milvusVersion,
+		MilvusRootPath:     b.milvusRootPath,
+		SchemaTemplateOnly: request.GetSchemaTemplateOnly(),
+		BinlogTypes:        binlogTypes,
+		Resumed:            resumed,
+		BaseBackupName:     request.GetBaseBackupName(),
+	}
+	if base != nil && b.params.BackupCfg.EncryptionEnable {
+		// the reused binlogs of the base are encrypted with its data key
+		if _, err := b.backupCipher(base); err != nil {
+			log.Error("fail to open the encryption of the base backup", zap.Error(err))
+			resp.Code = backuppb.ResponseCode_Fail
+			resp.Msg = err.Error()
+			return resp
+		}
+		setEncryptionOf(base)(backup)
+	} else if b.params.BackupCfg.EncryptionEnable {
+		if err := newBackupEncryption(b.params.BackupCfg.EncryptionPassphrase, b.params.BackupCfg.EncryptionKeyID, backup); err != nil {
+			log.Error("fail to generate the data key of the backup", zap.Error(err))
+			resp.Code = backuppb.ResponseCode_Fail
+			resp.Msg = err.Error()
+			return resp
+		}
 	}
 	b.meta.AddBackup(backup)
 	//levelBackupInfo := NewLeveledBackupInfo(backup)
@@ -100,7 +221,10 @@ func (b *BackupContext) CreateBackup(ctx context.Context, request *backuppb.Crea
 	//b.backupNameIdDict.Store(name, request.GetRequestId())
 
 	if request.Async {
-		go b.executeCreateBackup(ctx, request, backup)
+		go func() {
+			defer b.recoverBackupPanic(backup.GetId())
+			b.executeCreateBackup(ctx, request, backup)
+		}()
 		asyncResp := &backuppb.BackupInfoResponse{
 			RequestId: request.GetRequestId(),
 			Code:      backuppb.ResponseCode_Success,
@@ -122,27 +246,210 @@ func (b *BackupContext) CreateBackup(ctx context.Context, request *backuppb.Crea
 	}
 }
 
+// checkBackupResumable returns the reason if the existing backup with the name can not be resumed
+func (b *BackupContext) checkBackupResumable(backupName string) (string, error) {
+	if b.meta.IsBackupInProgress(backupName) {
+		return fmt.Sprintf("backup %s is in progress", backupName), nil
+	}
+	complete, err := b.getStorageClient().Exist(b.ctx, b.backupBucketName, BackupMetaPath(b.backupRootPath, backupName))
+	if err != nil {
+		return fmt.Sprintf("fail to check whether backup %s is complete", backupName), err
+	}
+	if complete {
+		return fmt.Sprintf("backup %s is complete, only an interrupted backup can be resumed", backupName), nil
+	}
+	return "", nil
+}
+
+// MaxBackupNameSeq limits the tries of {seq} in backup.nameTemplate
+const MaxBackupNameSeq = 10000
+
+// generateBackupName renders backup.nameTemplate, {seq} starts from 1 and skips the names already used.
+// The rendered name must be a valid backup name, e.g. a template can't start with {date}.
+func (b *BackupContext) generateBackupName(ctx context.Context) (string, error) {
+	template := b.params.BackupCfg.NameTemplate
+	now := time.Now()
+	if template == "" {
+		return "backup_" + fmt.Sprint(now.UTC().Format("2006_01_02_15_04_05_")) + fmt.Sprint(now.Nanosecond()), nil
+	}
+	if !strings.Contains(template, utils.NameTemplateSeq) {
+		// collision is reported by the backup name check
+		name := utils.FormatBackupName(template, now, b.params.BackupCfg.ClusterName, 0)
+		if err := utils.ValidateType(name, BACKUP_NAME); err != nil {
+			return "", fmt.Errorf("backup.nameTemplate %s renders an invalid backup name, err: %w", template, err)
+		}
+		return name, nil
+	}
+	for seq := 1; seq <= MaxBackupNameSeq; seq++ {
+		name := utils.FormatBackupName(template, now, b.params.BackupCfg.ClusterName, seq)
+		if err := utils.ValidateType(name, BACKUP_NAME); err != nil {
+			return "", fmt.Errorf("backup.nameTemplate %s renders an invalid backup name, err: %w", template, err)
+		}
+		if b.meta.IsBackupInProgress(name) {
+			continue
+		}
+		// the dir with the trailing separator, backup_1 is not taken by backup_10
+		exist, err := b.getStorageClient().Exist(ctx, b.backupBucketName, BackupDirPath(b.backupRootPath, name))
+		if err != nil {
+			return "", fmt.Errorf("fail to check whether exist backup with name: %s, err: %w", name, err)
+		}
+		if !exist {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("all %d seq of backup name template %s are used", MaxBackupNameSeq, template)
+}
+
+// resolveCollectionIDs finds the current db and name of the collections by id in all databases
+func (b *BackupContext) resolveCollectionIDs(ctx context.Context, ids []int64) (map[int64]collectionStruct, error) {
+	dbNames := []string{"default"}
+	dbs, err := b.getMilvusClient().ListDatabases(ctx)
+	if err != nil {
+		// compatible to milvus under v2.2.8 without database support
+		if !strings.Contains(err.Error(), "feature not supported") {
+			log.Error("fail in ListDatabases", zap.Error(err))
+			return nil, err
+		}
+	} else {
+		dbNames = lo.Map(dbs, func(db entity.Database, _ int) string { return db.Name })
+	}
+
+	resolved := make(map[int64]collectionStruct, len(ids))
+	for _, db := range dbNames {
+		collections, err := b.getMilvusClient().ListCollections(ctx, db)
+		if err != nil {
+			log.Error("fail in ListCollections", zap.Error(err))
+			return nil, err
+		}
+		for _, coll := range collections {
+			if lo.Contains(ids, coll.ID) {
+				resolved[coll.ID] = collectionStruct{db, coll.Name, false, coll.ID}
+			}
+		}
+	}
+	for _, id := range ids {
+		if _, ok := resolved[id]; !ok {
+			errMsg := fmt.Sprintf("request backup collection does not exist: id %d", id)
+			log.Error(errMsg)
+			return nil, errors.New(errMsg)
+		}
+	}
+	return resolved, nil
+}
+
+var errCollectionDropped = errors.New("collection dropped during backup")
+
+// checkCollectionDropped returns an error wrapping errCollectionDropped if the collection doesn't exist anymore.
+// A failure to check is not taken as dropped, the prepare is retried then
+func (b *BackupContext) checkCollectionDropped(ctx context.Context, collection collectionStruct) error {
+	if collection.id != 0 {
+		// the collection selected by id may be renamed, it is dropped only if the id is gone
+		_, err := b.resolveCollectionIDs(ctx, []int64{collection.id})
+		if err != nil && strings.Contains(err.Error(), "does not exist") {
+			return fmt.Errorf("%w: id %d", errCollectionDropped, collection.id)
+		}
+		return nil
+	}
+	exist, err := b.getMilvusClient().HasCollection(ctx, collection.db, collection.collectionName)
+	if err != nil {
+		log.Warn("fail in HasCollection", zap.Error(err))
+		return nil
+	}
+	if !exist {
+		return fmt.Errorf("%w: %s.%s", errCollectionDropped, collection.db, collection.collectionName)
+	}
+	return nil
+}
+
+// removeDroppedCollection removes the meta of the collection prepared before it is dropped, and records it as skipped
+func (b *BackupContext) removeDroppedCollection(backupID string, collection collectionStruct) {
+	b.meta.RemoveCollections(backupID, func(collectionBackup *backuppb.CollectionBackupInfo) bool {
+		if collection.id != 0 {
+			return collectionBackup.GetCollectionId() == collection.id
+		}
+		return collectionBackup.GetDbName() == collection.db && collectionBackup.GetCollectionName() == collection.collectionName
+	})
+	b.meta.UpdateBackup(backupID, addSkippedCollection(collection.db+"."+collection.collectionName))
+}
+
 type collectionStruct struct {
 	db             string
 	collectionName string
+	// skip flush of this collection
+	force bool
+	// set if the collection is selected by id, the name may be changed before the backup
+	id int64
+}
+
+// parse collections to backup, then keep the ones matching the property selector of the request
+func (b *BackupContext) parseBackupCollections(request *backuppb.CreateBackupRequest) ([]collectionStruct, error) {
+	collections, err := b.parseRequestCollections(request)
+	if err != nil {
+		return nil, err
+	}
+	selector := request.GetPropertySelector()
+	if len(selector) == 0 {
+		return collections, nil
+	}
+	selected := make([]collectionStruct, 0, len(collections))
+	for _, collection := range collections {
+		// properties are only returned by describe collection
+		completeCollection, err := b.getMilvusClient().DescribeCollection(b.ctx, collection.db, collection.collectionName)
+		if err != nil {
+			log.Error("fail in DescribeCollection", zap.String("db", collection.db), zap.String("collectionName", collection.collectionName), zap.Error(err))
+			return nil, err
+		}
+		if matchProperties(completeCollection.Properties, selector) {
+			selected = append(selected, collection)
+		}
+	}
+	log.Info("select collections by properties",
+		zap.Any("propertySelector", selector),
+		zap.Int("candidates", len(collections)),
+		zap.Int("selected", len(selected)))
+	return selected, nil
+}
+
+// matchProperties returns true if properties have all the key values of selector
+func matchProperties(properties map[string]string, selector map[string]string) bool {
+	for key, value := range selector {
+		if v, ok := properties[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
 }
 
-// parse collections to backup
+// parse collections selected by the request
 // For backward compatibility：
 //
-//	1，parse dbCollections first,
-//	2，if dbCollections not set, use collectionNames
-func (b *BackupContext) parseBackupCollections(request *backuppb.CreateBackupRequest) ([]collectionStruct, error) {
+//	1，parse collectionIds first,
+//	2，then dbCollections,
+//	3，if both not set, use collectionNames
+func (b *BackupContext) parseRequestCollections(request *backuppb.CreateBackupRequest) ([]collectionStruct, error) {
 	log.Debug("Request collection names",
 		zap.Strings("request_collection_names", request.GetCollectionNames()),
 		zap.String("request_db_collections", utils.GetCreateDBCollections(request)),
+		zap.Int64s("request_collection_ids", request.GetCollectionIds()),
 		zap.Int("length", len(request.GetCollectionNames())))
 	var toBackupCollections []collectionStruct
 
+	if len(request.GetCollectionIds()) > 0 {
+		resolved, err := b.resolveCollectionIDs(b.ctx, request.GetCollectionIds())
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range lo.Uniq(request.GetCollectionIds()) {
+			toBackupCollections = append(toBackupCollections, resolved[id])
+		}
+		log.Debug("Parsed backup collections from request.collection_ids", zap.Int("length", len(toBackupCollections)))
+		return toBackupCollections, nil
+	}
+
 	dbCollectionsStr := utils.GetCreateDBCollections(request)
 	// first priority: dbCollections
 	if dbCollectionsStr != "" {
-		var dbCollections DbCollections
+		var dbCollections BackupDbCollections
 		err := jsoniter.UnmarshalFromString(dbCollectionsStr, &dbCollections)
 		if err != nil {
 			log.Error("fail in unmarshal dbCollections in CreateBackupRequest", zap.String("dbCollections", dbCollectionsStr), zap.Error(err))
@@ -157,11 +464,11 @@ func (b *BackupContext) parseBackupCollections(request *backuppb.CreateBackupReq
 				}
 				for _, coll := range collections {
 					log.Debug("Add collection to toBackupCollections", zap.String("db", db), zap.String("collection", coll.Name))
-					toBackupCollections = append(toBackupCollections, collectionStruct{db, coll.Name})
+					toBackupCollections = append(toBackupCollections, collectionStruct{db, coll.Name, false, 0})
 				}
 			} else {
 				for _, coll := range collections {
-					toBackupCollections = append(toBackupCollections, collectionStruct{db, coll})
+					toBackupCollections = append(toBackupCollections, collectionStruct{db, coll.Name, coll.Force, 0})
 				}
 			}
 		}
@@ -181,7 +488,7 @@ func (b *BackupContext) parseBackupCollections(request *backuppb.CreateBackupReq
 					return nil, err
 				}
 				for _, coll := range collections {
-					toBackupCollections = append(toBackupCollections, collectionStruct{"default", coll.Name})
+					toBackupCollections = append(toBackupCollections, collectionStruct{"default", coll.Name, false, 0})
 				}
 			} else {
 				log.Error("fail in ListDatabases", zap.Error(err))
@@ -195,19 +502,16 @@ func (b *BackupContext) parseBackupCollections(request *backuppb.CreateBackupReq
 					return nil, err
 				}
 				for _, coll := range collections {
-					toBackupCollections = append(toBackupCollections, collectionStruct{db.Name, coll.Name})
+					toBackupCollections = append(toBackupCollections, collectionStruct{db.Name, coll.Name, false, 0})
 				}
 			}
 		}
 		log.Debug(fmt.Sprintf("List %v collections", len(toBackupCollections)))
 	} else {
 		for _, collectionName := range request.GetCollectionNames() {
-			var dbName = "default"
-			if strings.Contains(collectionName, ".") {
-				splits := strings.Split(collectionName, ".")
-				dbName = splits[0]
-				collectionName = splits[1]
-			}
+			splits := strings.Split(FullCollectionName(collectionName, b.params.BackupCfg.DefaultDatabase), ".")
+			dbName := splits[0]
+			collectionName = splits[1]
 
 			exist, err := b.getMilvusClient().HasCollection(b.ctx, dbName, collectionName)
 			if err != nil {
@@ -219,20 +523,59 @@ func (b *BackupContext) parseBackupCollections(request *backuppb.CreateBackupReq
 				log.Error(errMsg)
 				return nil, errors.New(errMsg)
 			}
-			toBackupCollections = append(toBackupCollections, collectionStruct{dbName, collectionName})
+			toBackupCollections = append(toBackupCollections, collectionStruct{dbName, collectionName, false, 0})
 		}
 	}
 
 	return toBackupCollections, nil
 }
 
-func (b *BackupContext) backupCollectionPrepare(ctx context.Context, backupInfo *backuppb.BackupInfo, collection collectionStruct, force bool) error {
-	log.Info("start backup collection", zap.String("db", collection.db), zap.String("collection", collection.collectionName))
+// flushCollection calls FlushV2 with at most backup.flushParallelism collections flushing at the same time,
+// independent of parallelism.backupCollection as the collections are prepared by getBackupPrepareWorkerPool.
+// In batch flush mode the collection shares a flush call with the other collections of the database
+func (b *BackupContext) flushCollection(ctx context.Context, db, collectionName string) ([]int64, []int64, int64, map[string]msgpb.MsgPosition, error) {
+	if b.params.BackupCfg.FlushMode == paramtable.FlushModeBatch {
+		result, err := b.flushCollectionInBatch(ctx, db, collectionName)
+		return result.NewSealedSegmentIDs, result.FlushedSegmentIDs, result.TimeOfSeal, result.ChannelCPs, err
+	}
+	select {
+	case b.flushSemaphore <- struct{}{}:
+	case <-ctx.Done():
+		return nil, nil, 0, nil, ctx.Err()
+	}
+	defer func() { <-b.flushSemaphore }()
+	start := time.Now()
+	newSealedSegmentIDs, flushedSegmentIDs, timeOfSeal, channelCPs, err := b.getMilvusClient().FlushV2(ctx, db, collectionName, false)
+	log.Debug("flush collection done",
+		zap.String("databaseName", db),
+		zap.String("collectionName", collectionName),
+		zap.Duration("cost", time.Since(start)))
+	return newSealedSegmentIDs, flushedSegmentIDs, timeOfSeal, channelCPs, err
+}
+
+// describeCollectionBackup builds the collection backup info with schema, indexes and properties, without partitions and segments
+func (b *BackupContext) describeCollectionBackup(ctx context.Context, backupInfo *backuppb.BackupInfo, collection collectionStruct) (*backuppb.CollectionBackupInfo, error) {
 	// list collection result is not complete
 	completeCollection, err := b.getMilvusClient().DescribeCollection(b.ctx, collection.db, collection.collectionName)
+	// the collection selected by id is renamed or the name is taken by another collection since the selection
+	if collection.id != 0 && (err != nil || completeCollection.ID != collection.id) {
+		log.Warn("collection selected by id is not found by name, resolve the name again",
+			zap.Int64("collectionID", collection.id),
+			zap.String("db", collection.db),
+			zap.String("collectionName", collection.collectionName))
+		resolved, resolveErr := b.resolveCollectionIDs(ctx, []int64{collection.id})
+		if resolveErr != nil {
+			return nil, resolveErr
+		}
+		collection = resolved[collection.id]
+		completeCollection, err = b.getMilvusClient().DescribeCollection(b.ctx, collection.db, collection.collectionName)
+		if err == nil && completeCollection.ID != collection.id {
+			err = fmt.Errorf("collection %d is renamed during the backup", collection.id)
+		}
+	}
 	if err != nil {
 		log.Error("fail in DescribeCollection", zap.Error(err))
-		return err
+		return nil, err
 	}
 	fields := make([]*backuppb.FieldSchema, 0)
 	for _, field := range completeCollection.Schema.Fields {
@@ -277,7 +620,7 @@ func (b *BackupContext) backupCollectionPrepare(ctx context.Context, backupInfo
 				continue
 			} else {
 				log.Error("fail in DescribeIndex", zap.Error(err))
-				return err
+				return nil, err
 			}
 		}
 		log.Info("field index",
@@ -312,14 +655,174 @@ func (b *BackupContext) backupCollectionPrepare(ctx context.Context, backupInfo
 		ConsistencyLevel: backuppb.ConsistencyLevel(completeCollection.ConsistencyLevel),
 		HasIndex:         len(indexInfos) > 0,
 		IndexInfos:       indexInfos,
+		Properties:       completeCollection.Properties,
 	}
-	b.meta.AddCollection(collectionBackup)
+	// partition key collections are created with num_partitions, the partitions may be less if some are dropped
+	if lo.ContainsBy(fields, func(field *backuppb.FieldSchema) bool { return field.GetIsPartitionKey() }) {
+		numPartitions, err := b.getMilvusClient().GetNumPartitions(b.ctx, collection.db, completeCollection.Name)
+		if err != nil {
+			// restore falls back to the number of the partitions
+			log.Warn("fail to get num partitions of the partition key collection",
+				zap.String("databaseName", collection.db),
+				zap.String("collectionName", completeCollection.Name),
+				zap.Error(err))
+		}
+		collectionBackup.NumPartitions = numPartitions
+	}
+	aliases, err := b.getMilvusClient().GetAliases(b.ctx, collection.db, completeCollection.Name)
+	if err != nil {
+		// the collection is still backed up, only restore_aliases misses its aliases
+		log.Warn("fail to get aliases of the collection",
+			zap.String("databaseName", collection.db),
+			zap.String("collectionName", completeCollection.Name),
+			zap.Error(err))
+	}
+	collectionBackup.Aliases = aliases
+	functions, err := b.getMilvusClient().GetFunctions(b.ctx, collection.db, completeCollection.Name)
+	if err != nil {
+		// restore can't tell the collection had functions, it is created without them
+		log.Warn("fail to get functions of the collection",
+			zap.String("databaseName", collection.db),
+			zap.String("collectionName", completeCollection.Name),
+			zap.Error(err))
+	}
+	schema.Functions = functions
+	outputFields := lo.FlatMap(functions, func(function *backuppb.FunctionSchema, _ int) []string {
+		return function.GetOutputFieldNames()
+	})
+	for _, field := range schema.GetFields() {
+		field.IsFunctionOutput = lo.Contains(outputFields, field.GetName())
+	}
+	if b.params.BackupCfg.CaptureShardChannels {
+		collectionBackup.ShardChannels = shardChannels(completeCollection.VirtualChannels, completeCollection.PhysicalChannels)
+	}
+	// expired rows are hidden by milvus by their timestamps and only removed by compaction,
+	// binlogs are backed up as they are, filtering the rows needs to decode them
+	if ttl := CollectionTTLSeconds(completeCollection.Properties); ttl > 0 {
+		log.Warn("collection has TTL, expired rows not compacted yet are included in the backup",
+			zap.String("databaseName", collection.db),
+			zap.String("collectionName", completeCollection.Name),
+			zap.Int64("ttlSeconds", ttl))
+	}
+	return collectionBackup, nil
+}
 
+// backupCollectionTemplate only records the schema, indexes and partitions of a collection.
+// No flush is triggered and no segment is recorded, restoring it creates an empty collection.
+func (b *BackupContext) backupCollectionTemplate(ctx context.Context, backupInfo *backuppb.BackupInfo, collection collectionStruct) error {
+	log.Info("start backup collection template", zap.String("db", collection.db), zap.String("collection", collection.collectionName))
+	collectionBackup, err := b.describeCollectionBackup(ctx, backupInfo, collection)
+	if err != nil {
+		return err
+	}
 	partitions, err := b.getMilvusClient().ShowPartitions(b.ctx, collectionBackup.GetDbName(), collectionBackup.GetCollectionName())
 	if err != nil {
 		log.Error("fail to ShowPartitions", zap.Error(err))
 		return err
 	}
+	collectionBackup.StateCode = backuppb.BackupTaskStateCode_BACKUP_SUCCESS
+	collectionBackup.EndTime = time.Now().Unix()
+	b.meta.AddCollection(collectionBackup)
+
+	for _, partition := range partitions {
+		b.meta.AddPartition(&backuppb.PartitionBackupInfo{
+			PartitionId:   partition.ID,
+			PartitionName: partition.Name,
+			CollectionId:  collectionBackup.GetCollectionId(),
+		})
+	}
+	log.Info("finish backup collection template",
+		zap.String("db", collection.db),
+		zap.String("collection", collection.collectionName),
+		zap.Int("partitionNum", len(partitions)))
+	return nil
+}
+
+// getPartitionLoadStates detects the load state of each partition of a partially loaded collection, at most
+// backup.parallelism.loadState partitions at the same time. Collections with more than backup.maxPartitionsForLoadState
+// partitions skip the detection, all the partitions are taken as loading, so that restore reloads the whole collection.
+func (b *BackupContext) getPartitionLoadStates(ctx context.Context, db, collectionName string, partitions []*entity.Partition) (map[string]string, error) {
+	partitionLoadStates := make(map[string]string, len(partitions))
+	maxPartitions := b.params.BackupCfg.MaxPartitionsForLoadState
+	if maxPartitions > 0 && len(partitions) > maxPartitions {
+		log.Warn("too many partitions to detect the load states, take all the partitions as loading",
+			zap.String("databaseName", db),
+			zap.String("collectionName", collectionName),
+			zap.Int("partitionNum", len(partitions)),
+			zap.Int("maxPartitionsForLoadState", maxPartitions))
+		for _, partition := range partitions {
+			partitionLoadStates[partition.Name] = LoadState_Loading
+		}
+		return partitionLoadStates, nil
+	}
+
+	var mu sync.Mutex
+	var firstErr error
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, b.params.BackupCfg.LoadStateParallelism)
+	for _, partition := range partitions {
+		partitionName := partition.Name
+		semaphore <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			loadProgress, err := b.getMilvusClient().GetLoadingProgress(ctx, db, collectionName, []string{partitionName})
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				log.Error("fail to GetLoadingProgress of partition", zap.String("partitionName", partitionName), zap.Error(err))
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			if loadProgress == 0 {
+				partitionLoadStates[partitionName] = LoadState_NotLoad
+			} else if loadProgress == 100 {
+				partitionLoadStates[partitionName] = LoadState_Loaded
+			} else {
+				partitionLoadStates[partitionName] = LoadState_Loading
+			}
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return partitionLoadStates, nil
+}
+
+func (b *BackupContext) backupCollectionPrepare(ctx context.Context, backupInfo *backuppb.BackupInfo, collection collectionStruct, force bool, partitionScope string) error {
+	log.Info("start backup collection", zap.String("db", collection.db), zap.String("collection", collection.collectionName))
+	collectionBackup, err := b.describeCollectionBackup(ctx, backupInfo, collection)
+	if err != nil {
+		return err
+	}
+	b.meta.AddCollection(collectionBackup)
+
+	allPartitions, err := b.getMilvusClient().ShowPartitions(b.ctx, collectionBackup.GetDbName(), collectionBackup.GetCollectionName())
+	if err != nil {
+		log.Error("fail to ShowPartitions", zap.Error(err))
+		return err
+	}
+	hasPartitionKey := lo.ContainsBy(collectionBackup.GetSchema().GetFields(), func(field *backuppb.FieldSchema) bool { return field.GetIsPartitionKey() })
+	partitions, err := filterBackupPartitions(allPartitions, partitionScope, hasPartitionKey)
+	if err != nil {
+		return retry.Unrecoverable(err)
+	}
+	// segments of the other partitions are not backed up, l0 segments of the collection have partition id -1
+	isBackupPartition := func(partitionID int64) bool {
+		return partitionID == -1 || lo.ContainsBy(partitions, func(partition *entity.Partition) bool { return partition.ID == partitionID })
+	}
+	if len(partitions) != len(allPartitions) {
+		log.Info("backup partitions in scope",
+			zap.String("collectionName", collectionBackup.GetCollectionName()),
+			zap.String("partitionScope", partitionScope),
+			zap.Strings("partitions", lo.Map(partitions, func(partition *entity.Partition, _ int) string { return partition.Name })))
+	}
 
 	// use GetLoadingProgress currently, GetLoadState is a new interface @20230104  milvus pr#21515
 	collectionLoadProgress, err := b.getMilvusClient().GetLoadingProgress(ctx, collectionBackup.GetDbName(), collectionBackup.GetCollectionName(), []string{})
@@ -342,19 +845,9 @@ func (b *BackupContext) backupCollectionPrepare(ctx context.Context, backupInfo
 		}
 	} else {
 		collectionLoadState = LoadState_Loading
-		for _, partition := range partitions {
-			loadProgress, err := b.getMilvusClient().GetLoadingProgress(ctx, collectionBackup.GetDbName(), collectionBackup.GetCollectionName(), []string{partition.Name})
-			if err != nil {
-				log.Error("fail to GetLoadingProgress of partition", zap.Error(err))
-				return err
-			}
-			if loadProgress == 0 {
-				partitionLoadStates[partition.Name] = LoadState_NotLoad
-			} else if loadProgress == 100 {
-				partitionLoadStates[partition.Name] = LoadState_Loaded
-			} else {
-				partitionLoadStates[partition.Name] = LoadState_Loading
-			}
+		partitionLoadStates, err = b.getPartitionLoadStates(ctx, collectionBackup.GetDbName(), collectionBackup.GetCollectionName(), partitions)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -370,7 +863,7 @@ func (b *BackupContext) backupCollectionPrepare(ctx context.Context, backupInfo
 			zap.String("databaseName", collectionBackup.GetDbName()),
 			zap.String("collectionName", collectionBackup.GetCollectionName()),
 			zap.Int("segmentNumBeforeFlush", len(segmentEntitiesBeforeFlush)))
-		newSealedSegmentIDs, flushedSegmentIDs, timeOfSeal, channelCPs, err := b.getMilvusClient().FlushV2(ctx, collectionBackup.GetDbName(), collectionBackup.GetCollectionName(), false)
+		newSealedSegmentIDs, flushedSegmentIDs, timeOfSeal, channelCPs, err := b.flushCollection(ctx, collectionBackup.GetDbName(), collectionBackup.GetCollectionName())
 		if err != nil {
 			log.Error("fail to flush the collection",
 				zap.String("databaseName", collectionBackup.GetDbName()),
@@ -444,11 +937,28 @@ func (b *BackupContext) backupCollectionPrepare(ctx context.Context, backupInfo
 			}
 		}
 		unfilledSegmentIDs = append(unfilledSegmentIDs, newL0SegmentsIDs...)
+		excludedSegmentIDs := lo.FilterMap(segmentEntities, func(seg *entity.Segment, _ int) (int64, bool) { return seg.ID, !isBackupPartition(seg.ParititionID) })
+		if snapshot, ok := b.snapshotSegments.Load(backupInfo.GetId()); ok {
+			snapshot.(*sync.Map).Store(collectionBackup.GetCollectionId(), lo.Without(lo.Uniq(append(append([]int64{}, segmentIDsBeforeFlush...), flushSegmentIDs...)), excludedSegmentIDs...))
+		}
 		for _, seg := range segmentEntities {
-			if lo.Contains(unfilledSegmentIDs, seg.ID) {
+			if lo.Contains(unfilledSegmentIDs, seg.ID) && isBackupPartition(seg.ParititionID) {
 				unfilledSegments = append(unfilledSegments, seg)
 			}
 		}
+		// segments returned by flush but not listed, e.g. compacted right after flush, their data may be missing in the backup
+		segmentIDs := lo.Map(segmentEntities, func(segment *entity.Segment, _ int) int64 { return segment.ID })
+		unlocatedSegmentIDs := lo.Without(unfilledSegmentIDs, segmentIDs...)
+		if len(unlocatedSegmentIDs) > 0 {
+			log.Warn("segments returned by flush not found in the collection",
+				zap.String("databaseName", collectionBackup.GetDbName()),
+				zap.String("collectionName", collectionBackup.GetCollectionName()),
+				zap.Int64s("unlocatedSegmentIDs", unlocatedSegmentIDs))
+			if b.params.BackupCfg.StrictSegmentCheck {
+				return retry.Unrecoverable(fmt.Errorf("segments %v of %s.%s returned by flush not found", unlocatedSegmentIDs, collectionBackup.GetDbName(), collectionBackup.GetCollectionName()))
+			}
+			b.meta.UpdateBackup(backupInfo.Id, addUnlocatedSegmentIDs(unlocatedSegmentIDs))
+		}
 	} else {
 		// Flush
 		segmentEntitiesBeforeFlush, err := b.getMilvusClient().GetPersistentSegmentInfo(ctx, collectionBackup.GetDbName(), collectionBackup.GetCollectionName())
@@ -461,7 +971,9 @@ func (b *BackupContext) backupCollectionPrepare(ctx context.Context, backupInfo
 			zap.String("collectionName", collectionBackup.GetCollectionName()),
 			zap.Int("segmentNum", len(segmentEntitiesBeforeFlush)))
 		for _, seg := range segmentEntitiesBeforeFlush {
-			unfilledSegments = append(unfilledSegments, seg)
+			if isBackupPartition(seg.ParititionID) {
+				unfilledSegments = append(unfilledSegments, seg)
+			}
 		}
 	}
 
@@ -469,6 +981,26 @@ func (b *BackupContext) backupCollectionPrepare(ctx context.Context, backupInfo
 		return err
 	}
 
+	// row count after flush is the ground truth to verify the restored collection
+	collectionStats, err := b.getMilvusClient().GetCollectionStatistics(ctx, collectionBackup.GetDbName(), collectionBackup.GetCollectionName())
+	if err != nil {
+		log.Error("fail to GetCollectionStatistics",
+			zap.String("databaseName", collectionBackup.GetDbName()),
+			zap.String("collectionName", collectionBackup.GetCollectionName()),
+			zap.Error(err))
+		return err
+	}
+	rowCount, err := strconv.ParseInt(collectionStats["row_count"], 10, 64)
+	if err != nil {
+		log.Error("fail to parse row_count of collection statistics", zap.Any("stats", collectionStats), zap.Error(err))
+		return err
+	}
+	b.meta.UpdateCollection(collectionBackup.Id, collectionBackup.CollectionId, setCollectionRowCount(rowCount))
+	log.Info("collection statistics",
+		zap.String("databaseName", collectionBackup.GetDbName()),
+		zap.String("collectionName", collectionBackup.GetCollectionName()),
+		zap.Int64("rowCount", rowCount))
+
 	newSegIDs := lo.Map(unfilledSegments, func(segment *entity.Segment, _ int) int64 { return segment.ID })
 	log.Info("Finished fill segment",
 		zap.String("databaseName", collectionBackup.GetDbName()),
@@ -525,7 +1057,24 @@ func (b *BackupContext) backupCollectionPrepare(ctx context.Context, backupInfo
 	return nil
 }
 
+var errCollectionCopyTimeout = errors.New("copy data of collection timeout")
+
+// backupCollectionExecute copies the data of a collection, in backup.collectionCopyTimeoutSeconds if set
 func (b *BackupContext) backupCollectionExecute(ctx context.Context, collectionBackup *backuppb.CollectionBackupInfo) error {
+	timeout := time.Duration(b.params.BackupCfg.CollectionCopyTimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		return b.copyCollectionData(ctx, collectionBackup)
+	}
+	copyCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	err := b.copyCollectionData(copyCtx, collectionBackup)
+	if err != nil && errors.Is(copyCtx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %s.%s after %s", errCollectionCopyTimeout, collectionBackup.GetDbName(), collectionBackup.GetCollectionName(), timeout)
+	}
+	return err
+}
+
+func (b *BackupContext) copyCollectionData(ctx context.Context, collectionBackup *backuppb.CollectionBackupInfo) error {
 	log.Info("backupCollectionExecute", zap.Any("collectionMeta", collectionBackup.String()))
 	backupInfo := b.meta.GetBackupByCollectionID(collectionBackup.GetCollectionId())
 	backupBinlogPath := BackupBinlogDirPath(b.backupRootPath, backupInfo.GetName())
@@ -537,13 +1086,21 @@ func (b *BackupContext) backupCollectionExecute(ctx context.Context, collectionB
 		//var currentL0Size int64 = 0
 		//var l0GroupID int64 = 1
 		segments := b.meta.GetSegments(partition.GetPartitionId())
+		err := b.fillSegmentsBackupInfo(ctx, lo.Values(segments))
+		if err != nil {
+			log.Error("Fail to fill segment backup info", zap.Error(err))
+			return err
+		}
+		if b.baseSegments != nil {
+			reused := b.markBaseSegments(lo.Values(segments))
+			log.Info("reuse the segments of the base backup",
+				zap.String("baseBackupName", backupInfo.GetBaseBackupName()),
+				zap.Int64("partitionID", partition.GetPartitionId()),
+				zap.Int("reused", reused),
+				zap.Int("segments", len(segments)))
+		}
 		for _, v := range segments {
 			segment := v
-			err := b.fillSegmentBackupInfo(ctx, segment)
-			if err != nil {
-				log.Error("Fail to fill segment backup info", zap.Error(err))
-				return err
-			}
 			if !segment.IsL0 {
 				if currentSize > BackupSegmentGroupMaxSizeInMB*1024*1024 { // 256MB
 					groupID++
@@ -574,26 +1131,29 @@ func (b *BackupContext) backupCollectionExecute(ctx context.Context, collectionB
 		segmentIDs := lo.Map(segmentBackupInfos, func(segment *backuppb.SegmentBackupInfo, _ int) int64 {
 			return segment.GetSegmentId()
 		})
-		err := b.copySegments(ctx, backupBinlogPath, segmentIDs)
+		err = b.copySegments(ctx, backupBinlogPath, segmentIDs)
 		if err != nil {
 			return err
 		}
+		b.meta.AddEvent(collectionBackup.Id, EVENT_PROGRESS,
+			fmt.Sprintf("copied %d segments of partition %s", len(segmentIDs), partition.GetPartitionName()),
+			withEventCollection(collectionBackup.GetDbName(), collectionBackup.GetCollectionName()))
 	}
 
 	l0Segments := collectionBackup.GetL0Segments()
 	segmentBackupInfos := make([]*backuppb.SegmentBackupInfo, 0)
 	segmentIDs := make([]int64, 0)
+	err := b.fillSegmentsBackupInfo(ctx, l0Segments)
+	if err != nil {
+		log.Error("Fail to fill segment backup info", zap.Error(err))
+		return err
+	}
 	for _, v := range l0Segments {
 		segment := v
-		err := b.fillSegmentBackupInfo(ctx, segment)
-		if err != nil {
-			log.Error("Fail to fill segment backup info", zap.Error(err))
-			return err
-		}
 		segmentIDs = append(segmentIDs, segment.GetSegmentId())
 		segmentBackupInfos = append(segmentBackupInfos, b.meta.GetSegment(segment.GetSegmentId()))
 	}
-	err := b.copySegments(ctx, backupBinlogPath, segmentIDs)
+	err = b.copySegments(ctx, backupBinlogPath, segmentIDs)
 	if err != nil {
 		log.Error("Fail to fill segment backup info", zap.Error(err))
 		return err
@@ -607,6 +1167,33 @@ func (b *BackupContext) backupCollectionExecute(ctx context.Context, collectionB
 	return nil
 }
 
+// discoverMilvusGCAddress derives the management address from milvus address, milvus serves
+// the management API on the same port as healthz. It is only used without backup.gcPause.address,
+// and falls back to DefaultMilvusGCAddress if the probe fails.
+func (b *BackupContext) discoverMilvusGCAddress(ctx context.Context) string {
+	discovered := fmt.Sprintf("http://%s:%d", b.params.MilvusCfg.Address, DefaultMilvusManagementPort)
+	probeCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(probeCtx, http.MethodGet, discovered+"/healthz", nil)
+	if err == nil {
+		var response *http.Response
+		response, err = http.DefaultClient.Do(req)
+		if err == nil {
+			response.Body.Close()
+			if response.StatusCode == http.StatusOK {
+				log.Info("discovered milvus management address", zap.String("address", discovered))
+				return discovered
+			}
+			err = fmt.Errorf("unexpected status %d", response.StatusCode)
+		}
+	}
+	log.Info("fail to discover milvus management address, use the default one",
+		zap.String("discovered", discovered),
+		zap.String("default", DefaultMilvusGCAddress),
+		zap.Error(err))
+	return DefaultMilvusGCAddress
+}
+
 func (b *BackupContext) pauseMilvusGC(ctx context.Context, gcAddress string, pauseSeconds int) {
 	pauseAPI := "/management/datacoord/garbage_collection/pause"
 	params := url.Values{}
@@ -645,37 +1232,133 @@ func (b *BackupContext) resumeMilvusGC(ctx context.Context, gcAddress string) {
 	log.Info("Resume Milvus GC response", zap.String("response", string(body)), zap.String("address", gcAddress))
 }
 
-func (b *BackupContext) executeCreateBackup(ctx context.Context, request *backuppb.CreateBackupRequest, backupInfo *backuppb.BackupInfo) error {
-	b.mu.Lock()
-	defer b.mu.Unlock()
-
-	// set backup state
-	b.meta.UpdateBackup(backupInfo.Id, setStateCode(backuppb.BackupTaskStateCode_BACKUP_EXECUTING))
+// clockSkew is the difference of the physical time of a milvus timestamp to the local clock, taking the middle of
+// the local times before and after allocating the timestamp. positive means the milvus clock is ahead
+func clockSkew(milvusTS uint64, before, after time.Time) time.Duration {
+	milvusTime, _ := utils.ParseTS(milvusTS)
+	return milvusTime.Sub(before.Add(after.Sub(before) / 2))
+}
 
-	// pause GC
-	if request.GetGcPauseEnable() || b.params.BackupCfg.GcPauseEnable {
-		var pause = 0
-		if request.GetGcPauseSeconds() == 0 {
+// checkClockSkew warns if the clock of milvus differs from the local clock by more than backup.maxClockSkewSeconds,
+// which affects the backup time reasoning of the snapshot spread and the duration of the GC pause
+func (b *BackupContext) checkClockSkew(ctx context.Context) {
+	before := time.Now()
+	ts, err := b.getMilvusClient().AllocTimestamp(ctx)
+	after := time.Now()
+	if err != nil {
+		log.Warn("fail to alloc timestamp from milvus, skip the clock skew check", zap.Error(err))
+		return
+	}
+	skew := clockSkew(ts, before, after)
+	threshold := time.Duration(b.params.BackupCfg.MaxClockSkewSeconds) * time.Second
+	if skew > threshold || skew < -threshold {
+		log.Warn("clock skew between milvus and the backup tool exceeds backup.maxClockSkewSeconds",
+			zap.Duration("skew", skew),
+			zap.Duration("threshold", threshold),
+			zap.Duration("roundTrip", after.Sub(before)))
+		return
+	}
+	log.Info("clock skew between milvus and the backup tool", zap.Duration("skew", skew))
+}
+
+// recoverBackupPanic fails the backup on a panic of its async execution instead of crashing the process and the other
+// operations of the server. The GC pause and the backup lock are released by the defers of executeCreateBackup.
+// A panic of a job in the worker pools is recovered by the pool and fails the backup as the error of the job.
+func (b *BackupContext) recoverBackupPanic(backupID string) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	errMsg := fmt.Sprintf("backup panic: %v\n%s", r, debug.Stack())
+	log.Error("backup panic", zap.String("backupId", backupID), zap.String("error", errMsg))
+	b.meta.UpdateBackup(backupID, setStateCode(backuppb.BackupTaskStateCode_BACKUP_FAIL), setErrorMessage(errMsg))
+	b.meta.AddEvent(backupID, EVENT_STATE, stateEventMessage(backuppb.BackupTaskStateCode_BACKUP_FAIL.String(), errMsg))
+}
+
+func (b *BackupContext) executeCreateBackup(ctx context.Context, request *backuppb.CreateBackupRequest, backupInfo *backuppb.BackupInfo) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// the segments at the flush of the collections are only kept to verify the backup
+	if request.GetVerify() && !request.GetSchemaTemplateOnly() {
+		b.snapshotSegments.Store(backupInfo.GetId(), &sync.Map{})
+	}
+	defer b.snapshotSegments.Delete(backupInfo.GetId())
+
+	// set backup state
+	b.meta.UpdateBackup(backupInfo.Id, setStateCode(backuppb.BackupTaskStateCode_BACKUP_EXECUTING))
+	b.meta.AddEvent(backupInfo.Id, EVENT_STATE, backuppb.BackupTaskStateCode_BACKUP_EXECUTING.String())
+	defer func() {
+		backup := b.meta.GetBackup(backupInfo.Id)
+		b.meta.AddEvent(backupInfo.Id, EVENT_STATE, stateEventMessage(backup.GetStateCode().String(), backup.GetErrorMessage()))
+	}()
+
+	if b.params.BackupCfg.MaxClockSkewSeconds > 0 {
+		b.checkClockSkew(ctx)
+	}
+
+	b.retryBudget = newRetryBudget(time.Duration(b.params.BackupCfg.TotalRetryBudgetSeconds) * time.Second)
+	defer func() {
+		b.retryBudget = nil
+	}()
+
+	b.milvusIndexFiles = &milvusIndexFiles{}
+	defer func() {
+		b.milvusIndexFiles = nil
+	}()
+
+	if backupInfo.GetBaseBackupName() != "" {
+		baseSegments, err := b.loadBaseSegments(ctx, backupInfo.GetBaseBackupName())
+		if err != nil {
+			log.Error("fail to load the segments of the base backup", zap.Error(err))
+			b.meta.UpdateBackup(backupInfo.Id, setStateCode(backuppb.BackupTaskStateCode_BACKUP_FAIL), setErrorMessage(err.Error()))
+			return err
+		}
+		b.baseSegments = baseSegments
+		defer func() {
+			b.baseSegments = nil
+		}()
+	}
+
+	// pause GC, a schema template has no data to protect
+	if !request.GetSchemaTemplateOnly() && (request.GetGcPauseEnable() || b.params.BackupCfg.GcPauseEnable) {
+		var pause = 0
+		if request.GetGcPauseSeconds() == 0 {
 			pause = b.params.BackupCfg.GcPauseSeconds
 		} else {
 			pause = int(request.GetGcPauseSeconds())
 		}
-		var gcAddress string = ""
-		if request.GetGcPauseAddress() == "" {
+		// an explicit address of the request or backup.gcPause.address is used as it is
+		gcAddress := request.GetGcPauseAddress()
+		if gcAddress == "" {
 			gcAddress = b.params.BackupCfg.GcPauseAddress
-		} else {
-			gcAddress = request.GetGcPauseAddress()
+		}
+		if gcAddress == "" {
+			gcAddress = b.discoverMilvusGCAddress(ctx)
 		}
 		b.pauseMilvusGC(ctx, gcAddress, pause)
 		defer b.resumeMilvusGC(ctx, gcAddress)
 	}
 
-	// 1, get collection level meta
-	toBackupCollections, err := b.parseBackupCollections(request)
-	if err != nil {
-		log.Error("parse backup collections from request failed", zap.Error(err))
-		b.meta.UpdateBackup(backupInfo.Id, setStateCode(backuppb.BackupTaskStateCode_BACKUP_FAIL), setErrorMessage(err.Error()))
-		return err
+	// 1, get collection level meta, the collections prepared by the interrupted backup are not prepared again
+	prepared := false
+	var err error
+	if backupInfo.GetResumed() {
+		prepared, err = b.loadPreparedMeta(ctx, backupInfo)
+		if err != nil {
+			log.Error("fail to load the prepared meta of the interrupted backup", zap.Error(err))
+			b.meta.UpdateBackup(backupInfo.Id, setStateCode(backuppb.BackupTaskStateCode_BACKUP_FAIL), setErrorMessage(err.Error()))
+			return err
+		}
+	}
+	var toBackupCollections []collectionStruct
+	if !prepared {
+		toBackupCollections, err = b.parseBackupCollections(request)
+		if err != nil {
+			log.Error("parse backup collections from request failed", zap.Error(err))
+			b.meta.UpdateBackup(backupInfo.Id, setStateCode(backuppb.BackupTaskStateCode_BACKUP_FAIL), setErrorMessage(err.Error()))
+			return err
+		}
 	}
 	collectionNames := make([]string, len(toBackupCollections))
 	for i, coll := range toBackupCollections {
@@ -683,31 +1366,125 @@ func (b *BackupContext) executeCreateBackup(ctx context.Context, request *backup
 	}
 	log.Info("collections to backup", zap.Strings("collections", collectionNames))
 
+	if request.GetBackupDatabases() && !prepared {
+		databases, err := b.backupDatabases(ctx)
+		if err != nil {
+			log.Error("fail to backup databases", zap.Error(err))
+			b.meta.UpdateBackup(backupInfo.Id, setStateCode(backuppb.BackupTaskStateCode_BACKUP_FAIL), setErrorMessage(err.Error()))
+			return err
+		}
+		b.meta.UpdateBackup(backupInfo.Id, setDatabaseBackups(databases))
+	}
+
 	jobIds := make([]int64, 0)
 	for _, collection := range toBackupCollections {
 		collectionClone := collection
 		job := func(ctx context.Context) error {
-			err := retry.Do(ctx, func() error {
-				return b.backupCollectionPrepare(ctx, backupInfo, collectionClone, request.GetForce())
-			}, retry.Sleep(120*time.Second), retry.Attempts(128))
+			b.meta.AddEvent(backupInfo.Id, EVENT_COLLECTION_START, "prepare collection meta", withEventCollection(collectionClone.db, collectionClone.collectionName))
+			var droppedErr error
+			retryCtx, cancel := b.retryBudget.context(ctx)
+			defer cancel()
+			err := retry.Do(retryCtx, b.retryBudget.wrap(collectionClone.db+"."+collectionClone.collectionName, func() error {
+				// check again right before prepare, the collection may be dropped since parseBackupCollections
+				droppedErr = b.checkCollectionDropped(ctx, collectionClone)
+				if droppedErr != nil {
+					return retry.Unrecoverable(droppedErr)
+				}
+				var err error
+				if request.GetSchemaTemplateOnly() {
+					err = b.backupCollectionTemplate(ctx, backupInfo, collectionClone)
+				} else {
+					err = b.backupCollectionPrepare(ctx, backupInfo, collectionClone, request.GetForce() || collectionClone.force, request.GetPartitionScope())
+				}
+				if err != nil {
+					// tell a collection dropped during prepare from the other failures
+					if droppedErr = b.checkCollectionDropped(ctx, collectionClone); droppedErr != nil {
+						return retry.Unrecoverable(droppedErr)
+					}
+				}
+				return err
+			}), retry.Sleep(120*time.Second), retry.Attempts(128), retry.Jitter(b.params.BackupCfg.RetryJitter))
+			if droppedErr != nil && request.GetContinueOnError() {
+				log.Warn("skip the collection dropped during backup",
+					zap.String("db", collectionClone.db),
+					zap.String("collection", collectionClone.collectionName))
+				b.removeDroppedCollection(backupInfo.GetId(), collectionClone)
+				b.meta.AddEvent(backupInfo.Id, EVENT_COLLECTION_SKIP, droppedErr.Error(), withEventCollection(collectionClone.db, collectionClone.collectionName))
+				return nil
+			}
+			if droppedErr != nil {
+				err = droppedErr
+			}
+			if err != nil {
+				b.meta.AddEvent(backupInfo.Id, EVENT_COLLECTION_FAIL, err.Error(), withEventCollection(collectionClone.db, collectionClone.collectionName))
+			}
 			return err
 		}
-		jobId := b.getBackupCollectionWorkerPool().SubmitWithId(job)
+		jobId := b.getBackupPrepareWorkerPool().SubmitWithId(job)
 		jobIds = append(jobIds, jobId)
 	}
-	err = b.getBackupCollectionWorkerPool().WaitJobs(jobIds)
+	err = b.getBackupPrepareWorkerPool().WaitJobs(jobIds)
+	if b.retryBudget.isExhausted() {
+		err = b.retryBudget.err()
+	}
 	if err != nil {
 		b.meta.UpdateBackup(backupInfo.Id, setStateCode(backuppb.BackupTaskStateCode_BACKUP_FAIL), setErrorMessage(err.Error()))
 		return err
 	}
 	log.Info("Finish prepare all collections meta")
 
-	if !request.GetMetaOnly() {
-		for collectionID, collection := range b.meta.GetCollections(backupInfo.GetId()) {
+	err = b.checkSnapshotSpread(request, backupInfo)
+	if err != nil {
+		b.meta.UpdateBackup(backupInfo.Id, setStateCode(backuppb.BackupTaskStateCode_BACKUP_FAIL), setErrorMessage(err.Error()))
+		return err
+	}
+
+	if !prepared && !request.GetSchemaTemplateOnly() && !request.GetMetaOnly() {
+		if err := b.writePreparedMeta(ctx, backupInfo.GetId()); err != nil {
+			// only a resume of the backup needs it
+			log.Warn("fail to write the prepared meta of the backup", zap.Error(err))
+		}
+	}
+
+	if request.GetSchemaTemplateOnly() {
+		log.Info("skip copy data because it is a schemaTemplateOnly backup request")
+	} else if !request.GetMetaOnly() {
+		if b.params.BackupCfg.BackupCopyDataParallelismAuto {
+			segments := 0
+			for collectionID, collection := range b.meta.GetCollections(backupInfo.GetId()) {
+				segments += len(collection.GetL0Segments())
+				for _, partition := range b.meta.GetPartitions(collectionID) {
+					segments += len(partition.GetSegmentBackups())
+				}
+			}
+			parallelism := autoCopyDataParallelism(segments, b.params.BackupCfg.BackupCopyDataMinParallelism, b.params.BackupCfg.BackupCopyDataMaxParallelism)
+			log.Info("auto copy data parallelism", zap.Int("segments", segments), zap.Int("parallelism", parallelism))
+			b.resizeCopyDataWorkerPool(parallelism)
+		}
+		b.copyStats = newCopyStats(b.params.MinioCfg.StorageType + "->" + b.params.MinioCfg.BackupStorageType)
+		statsCtx, stopStatsLog := context.WithCancel(ctx)
+		go b.copyStats.logPeriodically(statsCtx, backupInfo.GetName())
+		// the collections timeout may be removed from the meta by the jobs
+		for _, collection := range lo.Values(b.meta.GetCollections(backupInfo.GetId())) {
 			collectionClone := collection
-			log.Info("before backupCollectionExecute", zap.Int64("collectionID", collectionID), zap.String("collection", collection.CollectionName))
+			log.Info("before backupCollectionExecute", zap.Int64("collectionID", collection.GetCollectionId()), zap.String("collection", collection.CollectionName))
 			job := func(ctx context.Context) error {
 				err := b.backupCollectionExecute(ctx, collectionClone)
+				if errors.Is(err, errCollectionCopyTimeout) && request.GetContinueOnError() {
+					log.Warn("skip the collection timeout during copy data", zap.Error(err))
+					b.removeDroppedCollection(backupInfo.GetId(), collectionStruct{
+						db:             collectionClone.GetDbName(),
+						collectionName: collectionClone.GetCollectionName(),
+						id:             collectionClone.GetCollectionId(),
+					})
+					b.meta.AddEvent(backupInfo.Id, EVENT_COLLECTION_SKIP, err.Error(), withEventCollection(collectionClone.GetDbName(), collectionClone.GetCollectionName()))
+					return nil
+				}
+				if err != nil {
+					b.meta.AddEvent(backupInfo.Id, EVENT_COLLECTION_FAIL, err.Error(), withEventCollection(collectionClone.GetDbName(), collectionClone.GetCollectionName()))
+				} else {
+					b.meta.AddEvent(backupInfo.Id, EVENT_COLLECTION_FINISH, "finish copy data", withEventCollection(collectionClone.GetDbName(), collectionClone.GetCollectionName()))
+				}
 				return err
 			}
 			jobId := b.getBackupCollectionWorkerPool().SubmitWithId(job)
@@ -715,6 +1492,14 @@ func (b *BackupContext) executeCreateBackup(ctx context.Context, request *backup
 		}
 
 		err = b.getBackupCollectionWorkerPool().WaitJobs(jobIds)
+		if b.retryBudget.isExhausted() {
+			err = b.retryBudget.err()
+		}
+		stopStatsLog()
+		copyStats := b.copyStats.toProto()
+		b.copyStats = nil
+		log.Info("copy throughput of backup", append([]zap.Field{zap.String("backupName", backupInfo.GetName())}, copyStatsFields(copyStats)...)...)
+		b.meta.UpdateBackup(backupInfo.Id, setCopyStats(copyStats))
 		if err != nil {
 			b.meta.UpdateBackup(backupInfo.Id, setStateCode(backuppb.BackupTaskStateCode_BACKUP_FAIL), setErrorMessage(err.Error()))
 			return err
@@ -722,16 +1507,33 @@ func (b *BackupContext) executeCreateBackup(ctx context.Context, request *backup
 	} else {
 		log.Info("skip copy data because it is a metaOnly backup request")
 	}
-	backupInfo.StateCode = backuppb.BackupTaskStateCode_BACKUP_SUCCESS
-	b.meta.UpdateBackup(backupInfo.Id, setStateCode(backuppb.BackupTaskStateCode_BACKUP_SUCCESS), setEndTime(time.Now().UnixNano()/int64(time.Millisecond)))
+	if request.GetVerify() && !request.GetSchemaTemplateOnly() {
+		err = b.verifyBackupSegments(ctx, backupInfo.GetId())
+		if err == nil && !request.GetMetaOnly() {
+			err = b.verifyBackupObjects(ctx, backupInfo.GetId())
+		}
+		if err != nil {
+			// keep the backup for investigation, but mark it failed in the meta
+			b.meta.UpdateBackup(backupInfo.Id, setStateCode(backuppb.BackupTaskStateCode_BACKUP_FAIL), setErrorMessage(err.Error()))
+			if writeErr := b.writeBackupInfoMeta(ctx, backupInfo.GetId()); writeErr != nil {
+				log.Error("fail to write backup meta of the failed verify", zap.Error(writeErr))
+			}
+			return err
+		}
+	}
 
-	// 7, write meta data
-	err = b.writeBackupInfoMeta(ctx, backupInfo.GetId())
+	// 7, write meta data. The backup is successful only once the meta is written, WatchBackup stops at success,
+	// so the meta file is written with the final state before the state in memory is updated
+	endTime := time.Now().UnixNano() / int64(time.Millisecond)
+	err = b.writeBackupInfoMeta(ctx, backupInfo.GetId(), setStateCode(backuppb.BackupTaskStateCode_BACKUP_SUCCESS), setEndTime(endTime))
 	if err != nil {
-		backupInfo.StateCode = backuppb.BackupTaskStateCode_BACKUP_FAIL
-		backupInfo.ErrorMessage = err.Error()
+		b.meta.UpdateBackup(backupInfo.Id, setStateCode(backuppb.BackupTaskStateCode_BACKUP_FAIL), setErrorMessage(err.Error()))
 		return err
 	}
+	b.meta.UpdateBackup(backupInfo.Id, setStateCode(backuppb.BackupTaskStateCode_BACKUP_SUCCESS), setEndTime(endTime))
+	if !request.GetSchemaTemplateOnly() && !request.GetMetaOnly() {
+		b.removePreparedMeta(ctx, backupInfo.GetName())
+	}
 	log.Info("finish executeCreateBackup",
 		zap.String("requestId", request.GetRequestId()),
 		zap.String("backupName", request.GetBackupName()),
@@ -740,21 +1542,231 @@ func (b *BackupContext) executeCreateBackup(ctx context.Context, request *backup
 	return nil
 }
 
-func (b *BackupContext) writeBackupInfoMeta(ctx context.Context, id string) error {
+// backupDatabases lists all databases of the cluster with their properties and collections
+func (b *BackupContext) backupDatabases(ctx context.Context) ([]*backuppb.DatabaseBackupInfo, error) {
+	dbs, err := b.getMilvusClient().ListDatabases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to list databases, err: %w", err)
+	}
+	databases := make([]*backuppb.DatabaseBackupInfo, 0, len(dbs))
+	for _, db := range dbs {
+		dbDesc, err := b.getMilvusClient().DescribeDatabase(ctx, db.Name)
+		if err != nil {
+			return nil, fmt.Errorf("fail to describe database %s, err: %w", db.Name, err)
+		}
+		collections, err := b.getMilvusClient().ListCollections(ctx, db.Name)
+		if err != nil {
+			return nil, fmt.Errorf("fail to list collections of database %s, err: %w", db.Name, err)
+		}
+		properties := make(map[string]string, len(dbDesc.Properties))
+		for _, kv := range dbDesc.Properties {
+			properties[kv.GetKey()] = kv.GetValue()
+		}
+		databases = append(databases, &backuppb.DatabaseBackupInfo{
+			DbName:          db.Name,
+			Properties:      properties,
+			CollectionNames: lo.Map(collections, func(coll *entity.Collection, _ int) string { return coll.Name }),
+		})
+	}
+	log.Info("backup databases", zap.Strings("databases", lo.Map(databases, func(db *backuppb.DatabaseBackupInfo, _ int) string { return db.GetDbName() })))
+	return databases, nil
+}
+
+// verifyBackupSegments checks all the segments existing at the flush of the collections are in the backup,
+// a segment compacted during the flush may be replaced by a segment created after the flush, which is not backed up.
+func (b *BackupContext) verifyBackupSegments(ctx context.Context, backupID string) error {
+	snapshot, ok := b.snapshotSegments.Load(backupID)
+	if !ok {
+		return nil
+	}
+	problems := make([]string, 0)
+	for collectionID, collection := range b.meta.GetCollections(backupID) {
+		value, ok := snapshot.(*sync.Map).Load(collectionID)
+		if !ok {
+			// force backup without flush, all the listed segments are backed up
+			continue
+		}
+		expected := value.([]int64)
+		backedUp := lo.Map(collection.GetL0Segments(), func(segment *backuppb.SegmentBackupInfo, _ int) int64 { return segment.GetSegmentId() })
+		for _, partition := range b.meta.GetPartitions(collectionID) {
+			for _, segment := range partition.GetSegmentBackups() {
+				backedUp = append(backedUp, segment.GetSegmentId())
+			}
+		}
+		missing := lo.Without(expected, backedUp...)
+		if len(missing) == 0 {
+			continue
+		}
+
+		current, err := b.getMilvusClient().GetPersistentSegmentInfo(ctx, collection.GetDbName(), collection.GetCollectionName())
+		if err != nil {
+			return fmt.Errorf("fail to get segments of %s.%s to verify backup, err: %w", collection.GetDbName(), collection.GetCollectionName(), err)
+		}
+		currentIDs := lo.Map(current, func(segment *entity.Segment, _ int) int64 { return segment.ID })
+		stillExist := lo.Intersect(missing, currentIDs)
+		compacted := lo.Without(missing, stillExist...)
+		log.Error("segments expected but not backed up",
+			zap.String("databaseName", collection.GetDbName()),
+			zap.String("collectionName", collection.GetCollectionName()),
+			zap.Int64s("stillExist", stillExist),
+			zap.Int64s("compacted", compacted))
+		problems = append(problems, fmt.Sprintf("%s.%s missing segments %v, compacted during backup %v",
+			collection.GetDbName(), collection.GetCollectionName(), stillExist, compacted))
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("verify backup failed, %s", strings.Join(problems, "; "))
+	}
+	log.Info("verify backup segments success", zap.String("backupId", backupID))
+	return nil
+}
+
+// max failed objects listed in the error of verify, all of them are logged
+const verifyMaxReportedFailures = 100
+
+// verifyBackupObjects reads back the copied binlogs of the backup, checks they exist with the sizes in the segment meta.
+// Objects are checked by a pool of backup.parallelism.verify workers, all the failures are reported instead of the first one.
+func (b *BackupContext) verifyBackupObjects(ctx context.Context, backupID string) error {
+	backupInfo := b.meta.GetBackup(backupID)
+	backupPath := BackupPath(b.backupRootPath, backupInfo.GetName())
+	binlogCipher, err := b.backupCipher(backupInfo)
+	if err != nil {
+		return err
+	}
+
+	wp, err := common.NewWorkerPool(ctx, b.params.BackupCfg.BackupVerifyParallelism, RPS)
+	if err != nil {
+		return err
+	}
+	wp.Start()
+
+	var mu sync.Mutex
+	var verified int
+	failures := make([]string, 0)
+	verifyObject := func(targetPath string, size int64) common.Job {
+		return func(ctx context.Context) error {
+			// list gets the size of the object in the same request as the existence
+			keys, sizes, err := b.getStorageClient().ListWithPrefix(ctx, b.backupBucketName, targetPath, false)
+			failure := ""
+			if err != nil {
+				failure = fmt.Sprintf("%s: fail to check, err: %s", targetPath, err)
+			} else if idx := lo.IndexOf(keys, targetPath); idx < 0 {
+				failure = fmt.Sprintf("%s: not exist", targetPath)
+			} else if size > 0 && sizes[idx] != size {
+				failure = fmt.Sprintf("%s: size %d, expected %d", targetPath, sizes[idx], size)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if failure == "" {
+				verified++
+				return nil
+			}
+			log.Error("verify backup object failed", zap.String("backupId", backupID), zap.String("failure", failure))
+			failures = append(failures, failure)
+			return nil
+		}
+	}
+
+	total := 0
+	for collectionID, collection := range b.meta.GetCollections(backupID) {
+		segments := append([]*backuppb.SegmentBackupInfo{}, collection.GetL0Segments()...)
+		// the segments with their binlogs are kept by partition in meta, like in GetFullMeta
+		for _, partition := range b.meta.GetPartitions(collectionID) {
+			segments = append(segments, lo.Values(b.meta.GetSegments(partition.GetPartitionId()))...)
+		}
+		for _, segment := range segments {
+			for _, fieldBinlogs := range [][]*backuppb.FieldBinlog{segment.GetBinlogs(), segment.GetDeltalogs(), segment.GetStatslogs(), indexFieldBinlogs(segment)} {
+				for _, binlogs := range fieldBinlogs {
+					for _, binlog := range binlogs.GetBinlogs() {
+						segmentBinlogPath := SegmentBackupPath(backupPath, backupInfo.GetName(), segment) + SEPERATOR + BINGLOG_DIR
+						targetPath := BackupSegmentBinlogPath(binlog.GetLogPath(), b.milvusRootPath, segmentBinlogPath, segment.GetPartitionId(), segment.GetGroupId())
+						size := binlog.GetLogSize()
+						if binlogCipher != nil && size > 0 {
+							size = binlogCipher.encryptedSize(size)
+						}
+						wp.Submit(verifyObject(targetPath, size))
+						total++
+					}
+				}
+			}
+		}
+	}
+	wp.Done()
+	if err := wp.Wait(); err != nil {
+		return err
+	}
+
+	log.Info("verify backup objects finished",
+		zap.String("backupId", backupID),
+		zap.Int("total", total),
+		zap.Int("verified", verified),
+		zap.Int("failed", len(failures)))
+	if len(failures) > 0 {
+		sort.Strings(failures)
+		reported := failures
+		if len(reported) > verifyMaxReportedFailures {
+			reported = reported[:verifyMaxReportedFailures]
+		}
+		return fmt.Errorf("verify backup failed, %d of %d objects verified, %d failed: %s",
+			verified, total, len(failures), strings.Join(reported, "; "))
+	}
+	return nil
+}
+
+// checkSnapshotSpread records how far apart the collections are flushed and the latest backup timestamp as the one of the backup,
+// and fails if the spread exceeds the max spread
+func (b *BackupContext) checkSnapshotSpread(request *backuppb.CreateBackupRequest, backupInfo *backuppb.BackupInfo) error {
+	collections := lo.Values(b.meta.GetCollections(backupInfo.GetId()))
+	spread, earliest, latest := SnapshotSpread(collections)
+	if earliest == nil {
+		return nil
+	}
+	b.meta.UpdateBackup(backupInfo.Id, setSnapshotSpreadMs(spread.Milliseconds()), setBackupTimestamp(latest.GetBackupTimestamp()))
+	log.Info("snapshot spread of the collections",
+		zap.String("backupName", backupInfo.GetName()),
+		zap.Uint64("backupTimestamp", latest.GetBackupTimestamp()),
+		zap.String("backupTime", FormatBackupTimestamp(latest.GetBackupTimestamp())),
+		zap.Duration("spread", spread),
+		zap.String("earliest", earliest.GetDbName()+"."+earliest.GetCollectionName()),
+		zap.String("latest", latest.GetDbName()+"."+latest.GetCollectionName()))
+
+	maxSpreadSeconds := request.GetMaxSnapshotSpreadSeconds()
+	if maxSpreadSeconds == 0 {
+		maxSpreadSeconds = int64(b.params.BackupCfg.MaxSnapshotSpreadSeconds)
+	}
+	if maxSpreadSeconds > 0 && spread > time.Duration(maxSpreadSeconds)*time.Second {
+		return fmt.Errorf("backup timestamps of the collections spread %s, exceeds max snapshot spread %ds, earliest: %s.%s, latest: %s.%s",
+			spread, maxSpreadSeconds, earliest.GetDbName(), earliest.GetCollectionName(), latest.GetDbName(), latest.GetCollectionName())
+	}
+	return nil
+}
+
+func (b *BackupContext) writeBackupInfoMeta(ctx context.Context, id string, opts ...BackupOpt) error {
 	backupInfo := b.meta.GetFullMeta(id)
+	// the options only change the written meta, e.g. the final state, not the backup in memory
+	for _, opt := range opts {
+		opt(backupInfo)
+	}
 	log.Info("Final backupInfo", zap.String("backupInfo", backupInfo.String()))
-	output, _ := serialize(backupInfo)
+	output, err := serializeWithSegmentShards(backupInfo, b.params.BackupCfg.MaxSegmentsPerMetaFile)
+	if err != nil {
+		return err
+	}
 	log.Debug("backup meta", zap.String("value", string(output.BackupMetaBytes)))
 	log.Info("collection meta", zap.String("value", string(output.CollectionMetaBytes)))
 	log.Debug("partition meta", zap.String("value", string(output.PartitionMetaBytes)))
-	log.Debug("segment meta", zap.String("value", string(output.SegmentMetaBytes)))
+	log.Debug("segment meta", zap.String("value", string(output.SegmentMetaBytes)), zap.Int("shards", len(output.SegmentMetaShards)))
 
 	collectionBackups := backupInfo.GetCollectionBackups()
 	collectionPositions := make(map[string][]*backuppb.ChannelPosition, 0)
 	for _, collectionBackup := range collectionBackups {
 		collectionCPs := make([]*backuppb.ChannelPosition, 0)
 		for vCh, position := range collectionBackup.GetChannelCheckpoints() {
-			pCh := strings.Split(vCh, "_")[0] + "_" + strings.Split(vCh, "_")[1]
+			pCh, ok := vChannelToPChannel(vCh)
+			if !ok {
+				log.Warn("unexpected virtual channel name, record it as the physical channel",
+					zap.String("collectionName", collectionBackup.GetCollectionName()),
+					zap.String("vChannel", vCh))
+			}
 			collectionCPs = append(collectionCPs, &backuppb.ChannelPosition{
 				Name:     pCh,
 				Position: position,
@@ -767,13 +1779,22 @@ func (b *BackupContext) writeBackupInfoMeta(ctx context.Context, id string) erro
 		return err
 	}
 	log.Debug("channel cp meta", zap.String("value", string(channelCPsBytes)))
+	summaryBytes, err := backupSummaryBytes(backupInfo)
+	if err != nil {
+		return err
+	}
 
-	b.getStorageClient().Write(ctx, b.backupBucketName, BackupMetaPath(b.backupRootPath, backupInfo.GetName()), output.BackupMetaBytes)
-	b.getStorageClient().Write(ctx, b.backupBucketName, CollectionMetaPath(b.backupRootPath, backupInfo.GetName()), output.CollectionMetaBytes)
-	b.getStorageClient().Write(ctx, b.backupBucketName, PartitionMetaPath(b.backupRootPath, backupInfo.GetName()), output.PartitionMetaBytes)
-	b.getStorageClient().Write(ctx, b.backupBucketName, SegmentMetaPath(b.backupRootPath, backupInfo.GetName()), output.SegmentMetaBytes)
-	b.getStorageClient().Write(ctx, b.backupBucketName, FullMetaPath(b.backupRootPath, backupInfo.GetName()), output.FullMetaBytes)
-	b.getStorageClient().Write(ctx, b.backupBucketName, ChannelCPMetaPath(b.backupRootPath, backupInfo.GetName()), channelCPsBytes)
+	// a backup is readable only if the backup meta file exists, so it is written last after all the other meta files
+	metaFiles := append([]backupMetaFile{
+		{ChannelCPMetaPath(b.backupRootPath, backupInfo.GetName()), channelCPsBytes},
+		{SummaryPath(b.backupRootPath, backupInfo.GetName()), summaryBytes},
+	}, backupMetaFiles(b.backupRootPath, backupInfo.GetName(), output)...)
+	if err := b.writeBackupMetaFiles(ctx, metaFiles[:len(metaFiles)-1]); err != nil {
+		return err
+	}
+	if err := b.writeBackupMetaFile(ctx, metaFiles[len(metaFiles)-1]); err != nil {
+		return err
+	}
 
 	log.Info("finish writeBackupInfoMeta",
 		zap.String("path", BackupDirPath(b.backupRootPath, backupInfo.GetName())),
@@ -782,19 +1803,97 @@ func (b *BackupContext) writeBackupInfoMeta(ctx context.Context, id string) erro
 	return nil
 }
 
+func (b *BackupContext) writeBackupMetaFile(ctx context.Context, metaFile backupMetaFile) error {
+	err := retry.Do(ctx, func() error {
+		return b.getStorageClient().Write(ctx, b.backupBucketName, metaFile.path, metaFile.content)
+	}, retry.Attempts(uint(b.params.BackupCfg.MetaWriteRetryAttempts)), retry.Sleep(time.Second), retry.Jitter(b.params.BackupCfg.RetryJitter))
+	if err != nil {
+		log.Error("fail to write backup meta file", zap.String("path", metaFile.path), zap.Error(err))
+		return fmt.Errorf("fail to write backup meta file %s, err: %w", metaFile.path, err)
+	}
+	return nil
+}
+
+// writeBackupMetaFiles writes the meta files one by one, or by a pool of backup.parallelism.writeMeta workers,
+// e.g. for many segment meta shards. readBackup reads the shards by their index, so the writing order doesn't matter.
+func (b *BackupContext) writeBackupMetaFiles(ctx context.Context, metaFiles []backupMetaFile) error {
+	if b.params.BackupCfg.WriteMetaParallelism <= 1 {
+		for _, metaFile := range metaFiles {
+			if err := b.writeBackupMetaFile(ctx, metaFile); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	wp, err := common.NewWorkerPool(ctx, b.params.BackupCfg.WriteMetaParallelism, RPS)
+	if err != nil {
+		return err
+	}
+	wp.Start()
+	for _, metaFile := range metaFiles {
+		metaFile := metaFile
+		wp.Submit(func(ctx context.Context) error {
+			return b.writeBackupMetaFile(ctx, metaFile)
+		})
+	}
+	wp.Done()
+	return wp.Wait()
+}
+
+// withCancelOf returns a context of ctx which is also cancelled when other is done
+func withCancelOf(ctx context.Context, other context.Context) (context.Context, context.CancelFunc) {
+	merged, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-other.Done():
+			cancel()
+		case <-merged.Done():
+		}
+	}()
+	return merged, cancel
+}
+
+// copySegments copies segments of one collection in the shared copy data pool.
+// backup.parallelism.copydataPerCollection caps the in-flight segments of the collection,
+// the submitter waits for a slot so that pool workers are never blocked by the cap.
 func (b *BackupContext) copySegments(ctx context.Context, backupBinlogPath string, segmentIDs []int64) error {
+	var semaphore chan struct{}
+	if b.params.BackupCfg.BackupCopyDataPerCollectionParallelism > 0 {
+		semaphore = make(chan struct{}, b.params.BackupCfg.BackupCopyDataPerCollectionParallelism)
+	}
 	jobIds := make([]int64, 0)
 	for _, v := range segmentIDs {
 		segmentID := v
 		segment := b.meta.GetSegment(segmentID)
-		job := func(ctx context.Context) error {
-			return b.copySegment(ctx, backupBinlogPath, segment)
+		if semaphore != nil {
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		job := func(poolCtx context.Context) error {
+			if semaphore != nil {
+				defer func() { <-semaphore }()
+			}
+			jobCtx, cancel := withCancelOf(poolCtx, ctx)
+			defer cancel()
+			err := b.copySegment(jobCtx, backupBinlogPath, segment)
+			if err != nil && ctx.Err() != nil {
+				// the collection is cancelled or timeout, don't fail the shared pool of the other collections
+				return nil
+			}
+			return err
 		}
 		jobId := b.getCopyDataWorkerPool().SubmitWithId(job)
 		jobIds = append(jobIds, jobId)
 	}
 
 	err := b.getCopyDataWorkerPool().WaitJobs(jobIds)
+	if err == nil {
+		// the jobs cancelled with the collection return nil
+		err = ctx.Err()
+	}
 	return err
 }
 
@@ -807,76 +1906,78 @@ func (b *BackupContext) copySegment(ctx context.Context, backupBinlogPath string
 	// generate target path
 	// milvus_rootpath/insert_log/collection_id/partition_id/segment_id/ =>
 	// backup_rootpath/backup_name/binlog/insert_log/collection_id/partition_id/group_id/segment_id
-	backupPathFunc := func(binlogPath, rootPath, backupBinlogPath string) string {
-		if rootPath == "" {
-			return backupBinlogPath + SEPERATOR + binlogPath
-		} else {
-			return strings.Replace(binlogPath, rootPath, backupBinlogPath, 1)
-		}
-	}
 	// insert log
 	for _, binlogs := range segment.GetBinlogs() {
-		for _, binlog := range binlogs.GetBinlogs() {
-			targetPath := backupPathFunc(binlog.GetLogPath(), b.milvusRootPath, backupBinlogPath)
+		if len(binlogs.GetBinlogs()) > 0 {
 			// use segmentID as group id
 			segment.GroupId = segment.SegmentId
-			if segment.GetGroupId() != 0 {
-				targetPath = strings.Replace(targetPath,
-					strconv.FormatInt(segment.GetPartitionId(), 10),
-					strconv.FormatInt(segment.GetPartitionId(), 10)+"/"+strconv.FormatInt(segment.GetGroupId(), 10),
-					1)
-			}
-			if targetPath == binlog.GetLogPath() {
-				return errors.New(fmt.Sprintf("copy src path and dst path can not be the same, src: %s dst: %s", binlog.GetLogPath(), targetPath))
-			}
-
-			//binlog := binlog
-			exist, err := b.getStorageClient().Exist(ctx, b.milvusBucketName, binlog.GetLogPath())
-			if err != nil {
-				log.Info("Fail to check file exist",
-					zap.Error(err),
-					zap.String("file", binlog.GetLogPath()))
-				return err
-			}
-			if !exist {
-				log.Error("Binlog file not exist",
-					zap.Error(err),
-					zap.String("file", binlog.GetLogPath()))
-				return err
-			}
-
-			err = retry.Do(ctx, func() error {
-				return b.getStorageClient().Copy(ctx, b.milvusBucketName, b.backupBucketName, binlog.GetLogPath(), targetPath)
-			}, retry.Sleep(2*time.Second), retry.Attempts(5))
-			if err != nil {
-				log.Info("Fail to copy file after retry",
-					zap.Error(err),
-					zap.String("from", binlog.GetLogPath()),
-					zap.String("to", targetPath))
-				return err
-			} else {
-				log.Debug("Successfully copy file",
-					zap.String("from", binlog.GetLogPath()),
-					zap.String("to", targetPath))
-			}
 		}
 	}
+	if segment.GetBaseBackupName() != "" {
+		// unchanged since the base backup of the incremental backup, restored from the base
+		log.Info("skip copy of the segment reused from the base backup", zap.String("baseBackupName", segment.GetBaseBackupName()))
+		b.meta.UpdateSegment(segment.GetPartitionId(), segment.GetSegmentId(), setSegmentBackuped(true))
+		return nil
+	}
+	if err := b.copyFieldBinlogs(ctx, backupBinlogPath, segment, segment.GetBinlogs()); err != nil {
+		return err
+	}
 	// delta log
-	for _, binlogs := range segment.GetDeltalogs() {
+	if err := b.copyFieldBinlogs(ctx, backupBinlogPath, segment, segment.GetDeltalogs()); err != nil {
+		return err
+	}
+	// stats log, only listed when stats is in binlog types of the backup
+	if err := b.copyFieldBinlogs(ctx, backupBinlogPath, segment, segment.GetStatslogs()); err != nil {
+		return err
+	}
+	// index files, only listed when index is in binlog types of the backup
+	if err := b.copyFieldBinlogs(ctx, backupBinlogPath, segment, indexFieldBinlogs(segment)); err != nil {
+		return err
+	}
+	b.meta.UpdateSegment(segment.GetPartitionId(), segment.GetSegmentId(), setSegmentBackuped(true))
+	return nil
+}
+
+func (b *BackupContext) copyFieldBinlogs(ctx context.Context, backupBinlogPath string, segment *backuppb.SegmentBackupInfo, fieldBinlogs []*backuppb.FieldBinlog) error {
+	log := log.With(zap.Int64("collection_id", segment.GetCollectionId()),
+		zap.Int64("partition_id", segment.GetPartitionId()),
+		zap.Int64("segment_id", segment.GetSegmentId()),
+		zap.Int64("group_id", segment.GetGroupId()))
+	backupInfo := b.meta.GetBackupByCollectionID(segment.GetCollectionId())
+	binlogCipher, err := b.backupCipher(backupInfo)
+	if err != nil {
+		return err
+	}
+	collection := b.meta.GetCollections(backupInfo.GetId())[segment.GetCollectionId()]
+	collectionName := collection.GetDbName() + "." + collection.GetCollectionName()
+	for _, binlogs := range fieldBinlogs {
 		for _, binlog := range binlogs.GetBinlogs() {
-			targetPath := backupPathFunc(binlog.GetLogPath(), b.milvusRootPath, backupBinlogPath)
-			if segment.GetGroupId() != 0 {
-				targetPath = strings.Replace(targetPath,
-					strconv.FormatInt(segment.GetPartitionId(), 10),
-					strconv.FormatInt(segment.GetPartitionId(), 10)+"/"+strconv.FormatInt(segment.GetGroupId(), 10),
-					1)
-			}
+			targetPath := BackupSegmentBinlogPath(binlog.GetLogPath(), b.milvusRootPath, backupBinlogPath, segment.GetPartitionId(), segment.GetGroupId())
 			if targetPath == binlog.GetLogPath() {
 				return errors.New(fmt.Sprintf("copy src path and dst path can not be the same, src: %s dst: %s", binlog.GetLogPath(), targetPath))
 			}
 
+			if backupInfo.GetResumed() {
+				copiedSize := binlog.GetLogSize()
+				if binlogCipher != nil {
+					copiedSize = binlogCipher.encryptedSize(copiedSize)
+				}
+				copied, err := b.isBinlogCopied(ctx, targetPath, copiedSize)
+				if err != nil {
+					return err
+				}
+				if copied {
+					if err := b.verifyCopiedBinlog(ctx, binlogCipher, binlog, targetPath); err != nil {
+						log.Error("Fail to verify file copied before the resume", zap.Error(err))
+						return err
+					}
+					log.Debug("Skip file copied before the resume", zap.String("to", targetPath))
+					continue
+				}
+			}
+
 			//binlog := binlog
-			exist, err := b.getStorageClient().Exist(ctx, b.milvusBucketName, binlog.GetLogPath())
+			size, exist, err := b.listedObjectSize(ctx, b.milvusBucketName, binlog.GetLogPath())
 			if err != nil {
 				log.Info("Fail to check file exist",
 					zap.Error(err),
@@ -889,9 +1990,27 @@ func (b *BackupContext) copySegment(ctx context.Context, backupBinlogPath string
 					zap.String("file", binlog.GetLogPath()))
 				return errors.New("Binlog file not exist " + binlog.GetLogPath())
 			}
-			err = retry.Do(ctx, func() error {
-				return b.getStorageClient().Copy(ctx, b.milvusBucketName, b.backupBucketName, binlog.GetLogPath(), targetPath)
-			}, retry.Sleep(2*time.Second), retry.Attempts(5))
+			// the object in the bucket is copied, whatever size the segment meta records
+			if err := b.checkObjectSize(binlog.GetLogPath(), size); err != nil {
+				log.Error("binlog exceeds max object size", zap.Error(err))
+				return err
+			}
+			err = retry.Do(ctx, b.retryBudget.wrap(collectionName, func() error {
+				if err := b.waitCopyBandwidth(ctx, binlog.GetLogSize()); err != nil {
+					return err
+				}
+				copyStart := time.Now()
+				var err error
+				if binlogCipher != nil {
+					err = b.copyEncryptedBinlog(ctx, binlogCipher, binlog.GetLogPath(), targetPath)
+				} else {
+					err = b.getStorageClient().Copy(ctx, b.milvusBucketName, b.backupBucketName, binlog.GetLogPath(), targetPath)
+				}
+				if err == nil {
+					b.recordCopy(binlog.GetLogSize(), time.Since(copyStart))
+				}
+				return err
+			}), retry.Sleep(2*time.Second), retry.Attempts(5), retry.Jitter(b.params.BackupCfg.RetryJitter))
 			if err != nil {
 				log.Info("Fail to copy file after retry",
 					zap.Error(err),
@@ -903,15 +2022,196 @@ func (b *BackupContext) copySegment(ctx context.Context, backupBinlogPath string
 					zap.String("from", binlog.GetLogPath()),
 					zap.String("to", targetPath))
 			}
+			if err := b.verifyCopiedBinlog(ctx, binlogCipher, binlog, targetPath); err != nil {
+				log.Error("Fail to verify copied file", zap.Error(err))
+				return err
+			}
 		}
 	}
-	b.meta.UpdateSegment(segment.GetPartitionId(), segment.GetSegmentId(), setSegmentBackuped(true))
 	return nil
 }
 
-func (b *BackupContext) fillSegmentBackupInfo(ctx context.Context, segmentBackupInfo *backuppb.SegmentBackupInfo) error {
+// binlogChecksum is the hex sha256 of the binlog data
+func binlogChecksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// objectChecksum is the hex sha256 of the object, hashed while it's read, or of its plain data if the cipher isn't nil
+func (b *BackupContext) objectChecksum(ctx context.Context, bucketName, filePath string, binlogCipher *binlogCipher) (string, error) {
+	reader, err := b.getStorageClient().Reader(ctx, bucketName, filePath)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	var data io.Reader = reader
+	if binlogCipher != nil {
+		data = binlogCipher.decryptReader(reader)
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(hash, data); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// fillBinlogChecksums computes the checksums of the source binlogs if backup.verifyChecksum is enabled,
+// they are stored in the segment meta and compared with the copied binlogs
+func (b *BackupContext) fillBinlogChecksums(ctx context.Context, fieldBinlogs ...[]*backuppb.FieldBinlog) error {
+	if !b.params.BackupCfg.VerifyChecksum {
+		return nil
+	}
+	for _, logs := range fieldBinlogs {
+		for _, fieldBinlog := range logs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				checksum, err := b.objectChecksum(ctx, b.milvusBucketName, binlog.GetLogPath(), nil)
+				if err != nil {
+					return fmt.Errorf("fail to compute checksum of binlog %s, err: %w", binlog.GetLogPath(), err)
+				}
+				binlog.Sha256 = checksum
+			}
+		}
+	}
+	return nil
+}
+
+// verifyCopiedBinlog compares the checksum of the copied binlog with the one of the source binlog,
+// the binlogs of backups made without backup.verifyChecksum have no checksum and are not verified.
+// An encrypted binlog is decrypted to compare the checksum of the data.
+func (b *BackupContext) verifyCopiedBinlog(ctx context.Context, binlogCipher *binlogCipher, binlog *backuppb.Binlog, targetPath string) error {
+	if !b.params.BackupCfg.VerifyChecksum || binlog.GetSha256() == "" {
+		return nil
+	}
+	checksum, err := b.objectChecksum(ctx, b.backupBucketName, targetPath, binlogCipher)
+	if err != nil {
+		return fmt.Errorf("fail to compute checksum of copied binlog %s, err: %w", targetPath, err)
+	}
+	if checksum != binlog.GetSha256() {
+		return fmt.Errorf("checksum mismatch of copied binlog, src: %s sha256: %s, dst: %s sha256: %s", binlog.GetLogPath(), binlog.GetSha256(), targetPath, checksum)
+	}
+	return nil
+}
+
+// listedObjectSize returns the size of the object listed in the bucket, exist is false if it is not listed
+func (b *BackupContext) listedObjectSize(ctx context.Context, bucketName, objectPath string) (size int64, exist bool, err error) {
+	paths, sizes, err := b.getStorageClient().ListWithPrefix(ctx, bucketName, objectPath, false)
+	if err != nil {
+		return 0, false, err
+	}
+	for i, path := range paths {
+		if path == objectPath {
+			return sizes[i], true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// checkObjectSize guards against copying unexpected huge objects, it only warns if backup.maxObjectSizeAction is warn
+func (b *BackupContext) checkObjectSize(path string, size int64) error {
+	maxSize := b.params.BackupCfg.MaxObjectSize
+	if maxSize <= 0 || size <= maxSize {
+		return nil
+	}
+	if b.params.BackupCfg.MaxObjectSizeAction == paramtable.MaxObjectSizeActionWarn {
+		log.Warn("object exceeds max object size, check the bucket and segment meta",
+			zap.String("path", path), zap.Int64("size", size), zap.Int64("maxObjectSize", maxSize))
+		return nil
+	}
+	return fmt.Errorf("object %s size %d exceeds max object size %d, check the bucket and segment meta, or set backup.maxObjectSize", path, size, maxSize)
+}
+
+// isBinlogFile returns whether the last element of the path is a log id, the name of the binlogs of milvus
+func isBinlogFile(binlogPath string) bool {
+	name := path.Base(binlogPath)
+	if strings.HasSuffix(binlogPath, SEPERATOR) || name == "" {
+		return false
+	}
+	_, err := strconv.ParseInt(name, 10, 64)
+	return err == nil
+}
+
+// filterBinlogFiles removes the files not named as binlogs listed in a binlog dir, or fails if backup.unexpectedBinlogFileAction is fail
+func (b *BackupContext) filterBinlogFiles(binlogPaths []string, sizes []int64) ([]string, []int64, error) {
+	filteredPaths := make([]string, 0, len(binlogPaths))
+	filteredSizes := make([]int64, 0, len(sizes))
+	for index, binlogPath := range binlogPaths {
+		if isBinlogFile(binlogPath) {
+			filteredPaths = append(filteredPaths, binlogPath)
+			filteredSizes = append(filteredSizes, sizes[index])
+			continue
+		}
+		if b.params.BackupCfg.UnexpectedBinlogFileAction == paramtable.UnexpectedBinlogFileActionFail {
+			return nil, nil, fmt.Errorf("unexpected file %s in binlog dir, the source data may be corrupted or in progress, or set backup.unexpectedBinlogFileAction", binlogPath)
+		}
+		log.Warn("skip unexpected file in binlog dir", zap.String("path", binlogPath), zap.Int64("size", sizes[index]))
+	}
+	return filteredPaths, filteredSizes, nil
+}
+
+// fillSegmentsBackupInfo lists binlogs of the segments in the list meta pool, which is separated from copy data pool
+func (b *BackupContext) fillSegmentsBackupInfo(ctx context.Context, segments []*backuppb.SegmentBackupInfo) error {
+	indexFiles := make(map[int64][]*backuppb.SegmentIndexFiles)
+	if len(segments) > 0 && hasBinlogType(b.meta.GetBackupBySegmentID(segments[0].GetSegmentId()).GetBinlogTypes(), BINLOG_TYPE_INDEX) {
+		var err error
+		if indexFiles, err = b.listSegmentIndexFiles(ctx, segments); err != nil {
+			return err
+		}
+	}
+	jobIds := make([]int64, 0)
+	for _, v := range segments {
+		segment := v
+		job := func(ctx context.Context) error {
+			return b.fillSegmentBackupInfo(ctx, segment, indexFiles[segment.GetSegmentId()])
+		}
+		jobId := b.getListMetaWorkerPool().SubmitWithId(job)
+		jobIds = append(jobIds, jobId)
+	}
+	return b.getListMetaWorkerPool().WaitJobs(jobIds)
+}
+
+// milvusIndexFiles is the listing of index_files/ of milvus, shared by the collections of a backup
+type milvusIndexFiles struct {
+	once  sync.Once
+	paths []string
+	sizes []int64
+	err   error
+}
+
+// listSegmentIndexFiles lists the index files of the segments, the index files of milvus are located by the build id
+// which is not known by the segment, so all the index files are listed once per backup and grouped by the segments
+func (b *BackupContext) listSegmentIndexFiles(ctx context.Context, segments []*backuppb.SegmentBackupInfo) (map[int64][]*backuppb.SegmentIndexFiles, error) {
+	listing := b.milvusIndexFiles
+	if listing == nil {
+		listing = &milvusIndexFiles{}
+	}
+	listing.once.Do(func() {
+		indexPath := INDEX_FILE_DIR + SEPERATOR
+		if b.milvusRootPath != "" {
+			indexPath = b.milvusRootPath + SEPERATOR + indexPath
+		}
+		listing.paths, listing.sizes, listing.err = b.getStorageClient().ListWithPrefix(ctx, b.milvusBucketName, indexPath, true)
+		if listing.err != nil {
+			log.Error("Fail to list index files", zap.String("indexPath", indexPath), zap.Error(listing.err))
+			return
+		}
+		log.Info("list index files of milvus", zap.String("indexPath", indexPath), zap.Int("files", len(listing.paths)))
+	})
+	if listing.err != nil {
+		return nil, listing.err
+	}
+	segmentPartitions := make(map[int64]int64, len(segments))
+	for _, segment := range segments {
+		segmentPartitions[segment.GetSegmentId()] = segment.GetPartitionId()
+	}
+	indexFiles := SegmentIndexFiles(listing.paths, listing.sizes, b.milvusRootPath, segmentPartitions)
+	log.Info("list index files of segments", zap.Int("segments", len(segments)), zap.Int("indexedSegments", len(indexFiles)))
+	return indexFiles, nil
+}
+
+func (b *BackupContext) fillSegmentBackupInfo(ctx context.Context, segmentBackupInfo *backuppb.SegmentBackupInfo, indexFiles []*backuppb.SegmentIndexFiles) error {
 	var size int64 = 0
 	var rootPath string
+	binlogTypes := b.meta.GetBackupBySegmentID(segmentBackupInfo.GetSegmentId()).GetBinlogTypes()
 
 	if b.params.MinioCfg.RootPath != "" {
 		rootPath = fmt.Sprintf("%s/", b.params.MinioCfg.RootPath)
@@ -933,10 +2233,17 @@ func (b *BackupContext) fillSegmentBackupInfo(ctx context.Context, segmentBackup
 	}
 	log.Debug("fieldsLogDir", zap.String("bucket", b.milvusBucketName), zap.Any("fieldsLogDir", fieldsLogDir))
 	insertLogs := make([]*backuppb.FieldBinlog, 0)
+	// the binlogs of the system fields _row_id (0) and _timestamp (1) are copied too, they are not regenerated by
+	// the binlog import of restore: the rows are filtered by end_ts from the timestamps in the _timestamp binlogs,
+	// and milvus 2.4 reads the binlogs of every field of the schema including the system fields
 	for _, fieldLogDir := range fieldsLogDir {
 		binlogPaths, sizes, _ := b.getStorageClient().ListWithPrefix(ctx, b.milvusBucketName, fieldLogDir, false)
 		fieldIdStr := strings.Replace(strings.Replace(fieldLogDir, insertPath, "", 1), SEPERATOR, "", -1)
 		fieldId, _ := strconv.ParseInt(fieldIdStr, 10, 64)
+		binlogPaths, sizes, err := b.filterBinlogFiles(binlogPaths, sizes)
+		if err != nil {
+			return err
+		}
 		binlogs := make([]*backuppb.Binlog, 0)
 		for index, binlogPath := range binlogPaths {
 			binlogs = append(binlogs, &backuppb.Binlog{
@@ -951,25 +2258,31 @@ func (b *BackupContext) fillSegmentBackupInfo(ctx context.Context, segmentBackup
 		})
 	}
 
-	deltaLogPath := fmt.Sprintf("%s%s/%v/%v/%v/", rootPath, "delta_log", segmentBackupInfo.GetCollectionId(), segmentBackupInfo.GetPartitionId(), segmentBackupInfo.GetSegmentId())
-	deltaFieldsLogDir, _, _ := b.getStorageClient().ListWithPrefix(ctx, b.milvusBucketName, deltaLogPath, false)
 	deltaLogs := make([]*backuppb.FieldBinlog, 0)
-	for _, deltaFieldLogDir := range deltaFieldsLogDir {
-		binlogPaths, sizes, _ := b.getStorageClient().ListWithPrefix(ctx, b.milvusBucketName, deltaFieldLogDir, false)
-		fieldIdStr := strings.Replace(strings.Replace(deltaFieldLogDir, deltaLogPath, "", 1), SEPERATOR, "", -1)
-		fieldId, _ := strconv.ParseInt(fieldIdStr, 10, 64)
-		binlogs := make([]*backuppb.Binlog, 0)
-		for index, binlogPath := range binlogPaths {
-			binlogs = append(binlogs, &backuppb.Binlog{
-				LogPath: binlogPath,
-				LogSize: sizes[index],
+	if hasBinlogType(binlogTypes, BINLOG_TYPE_DELTA) {
+		deltaLogPath := fmt.Sprintf("%s%s/%v/%v/%v/", rootPath, "delta_log", segmentBackupInfo.GetCollectionId(), segmentBackupInfo.GetPartitionId(), segmentBackupInfo.GetSegmentId())
+		deltaFieldsLogDir, _, _ := b.getStorageClient().ListWithPrefix(ctx, b.milvusBucketName, deltaLogPath, false)
+		for _, deltaFieldLogDir := range deltaFieldsLogDir {
+			binlogPaths, sizes, _ := b.getStorageClient().ListWithPrefix(ctx, b.milvusBucketName, deltaFieldLogDir, false)
+			fieldIdStr := strings.Replace(strings.Replace(deltaFieldLogDir, deltaLogPath, "", 1), SEPERATOR, "", -1)
+			fieldId, _ := strconv.ParseInt(fieldIdStr, 10, 64)
+			binlogPaths, sizes, err := b.filterBinlogFiles(binlogPaths, sizes)
+			if err != nil {
+				return err
+			}
+			binlogs := make([]*backuppb.Binlog, 0)
+			for index, binlogPath := range binlogPaths {
+				binlogs = append(binlogs, &backuppb.Binlog{
+					LogPath: binlogPath,
+					LogSize: sizes[index],
+				})
+				size += sizes[index]
+			}
+			deltaLogs = append(deltaLogs, &backuppb.FieldBinlog{
+				FieldID: fieldId,
+				Binlogs: binlogs,
 			})
-			size += sizes[index]
 		}
-		deltaLogs = append(deltaLogs, &backuppb.FieldBinlog{
-			FieldID: fieldId,
-			Binlogs: binlogs,
-		})
 	}
 	if len(deltaLogs) == 0 {
 		deltaLogs = append(deltaLogs, &backuppb.FieldBinlog{
@@ -977,31 +2290,248 @@ func (b *BackupContext) fillSegmentBackupInfo(ctx context.Context, segmentBackup
 		})
 	}
 
-	//statsLogPath := fmt.Sprintf("%s/%s/%v/%v/%v/", b.params.MinioCfg.RootPath, "stats_log", collectionID, partitionID, segmentID)
-	//statsFieldsLogDir, _, _ := b.storageClient.ListWithPrefix(ctx, b.milvusBucketName, statsLogPath, false)
-	//statsLogs := make([]*backuppb.FieldBinlog, 0)
-	//for _, statsFieldLogDir := range statsFieldsLogDir {
-	//	binlogPaths, sizes, _ := b.storageClient.ListWithPrefix(ctx, b.milvusBucketName, statsFieldLogDir, false)
-	//	fieldIdStr := strings.Replace(strings.Replace(statsFieldLogDir, statsLogPath, "", 1), SEPERATOR, "", -1)
-	//	fieldId, _ := strconv.ParseInt(fieldIdStr, 10, 64)
-	//	binlogs := make([]*backuppb.Binlog, 0)
-	//	for index, binlogPath := range binlogPaths {
-	//		binlogs = append(binlogs, &backuppb.Binlog{
-	//			LogPath: binlogPath,
-	//			LogSize: sizes[index],
-	//		})
-	//	}
-	//	statsLogs = append(statsLogs, &backuppb.FieldBinlog{
-	//		FieldID: fieldId,
-	//		Binlogs: binlogs,
-	//	})
-	//}
-
-	//segmentBackupInfo.Binlogs = insertLogs
-	//segmentBackupInfo.Deltalogs = deltaLogs
+	// the stats logs are copied but not restored, so they are not counted in the size of the segment to restore
+	statsLogs := make([]*backuppb.FieldBinlog, 0)
+	if hasBinlogType(binlogTypes, BINLOG_TYPE_STATS) {
+		statsLogPath := fmt.Sprintf("%s%s/%v/%v/%v/", rootPath, STATS_LOG_DIR, segmentBackupInfo.GetCollectionId(), segmentBackupInfo.GetPartitionId(), segmentBackupInfo.GetSegmentId())
+		statsFieldsLogDir, _, _ := b.getStorageClient().ListWithPrefix(ctx, b.milvusBucketName, statsLogPath, false)
+		for _, statsFieldLogDir := range statsFieldsLogDir {
+			binlogPaths, sizes, _ := b.getStorageClient().ListWithPrefix(ctx, b.milvusBucketName, statsFieldLogDir, false)
+			fieldIdStr := strings.Replace(strings.Replace(statsFieldLogDir, statsLogPath, "", 1), SEPERATOR, "", -1)
+			fieldId, _ := strconv.ParseInt(fieldIdStr, 10, 64)
+			binlogPaths, sizes, err := b.filterBinlogFiles(binlogPaths, sizes)
+			if err != nil {
+				return err
+			}
+			binlogs := make([]*backuppb.Binlog, 0)
+			for index, binlogPath := range binlogPaths {
+				binlogs = append(binlogs, &backuppb.Binlog{
+					LogPath: binlogPath,
+					LogSize: sizes[index],
+				})
+			}
+			statsLogs = append(statsLogs, &backuppb.FieldBinlog{
+				FieldID: fieldId,
+				Binlogs: binlogs,
+			})
+		}
+	}
+
+	for _, build := range indexFiles {
+		for _, file := range build.GetFiles() {
+			size += file.GetLogSize()
+		}
+	}
+
+	segmentBackupInfo.IndexFiles = indexFiles
+	if err := b.fillBinlogChecksums(ctx, insertLogs, deltaLogs, statsLogs, indexFieldBinlogs(segmentBackupInfo)); err != nil {
+		return err
+	}
+
 	segmentBackupInfo.Size = size
 	segmentBackupInfo.IsL0 = isL0
-	b.meta.UpdateSegment(segmentBackupInfo.GetPartitionId(), segmentBackupInfo.GetSegmentId(), setSegmentBinlogs(insertLogs), setSegmentDeltaBinlogs(deltaLogs), setSegmentSize(size), setSegmentL0(isL0))
+	b.meta.UpdateSegment(segmentBackupInfo.GetPartitionId(), segmentBackupInfo.GetSegmentId(), setSegmentBinlogs(insertLogs), setSegmentDeltaBinlogs(deltaLogs), setSegmentStatsBinlogs(statsLogs), setSegmentIndexFiles(indexFiles), setSegmentSize(size), setSegmentL0(isL0))
 	log.Debug("fill segment info", zap.Int64("segId", segmentBackupInfo.GetSegmentId()), zap.Int64("size", size))
 	return nil
 }
+
+// DefaultBinlogTypes are the binlog types copied when not specified, stats can be rebuilt by milvus
+var DefaultBinlogTypes = []string{BINLOG_TYPE_INSERT, BINLOG_TYPE_DELTA}
+
+// ParseBinlogTypes validates the binlog types to copy, empty means DefaultBinlogTypes.
+// insert log is required because restore imports data from it.
+func ParseBinlogTypes(binlogTypes []string) ([]string, error) {
+	if len(binlogTypes) == 0 {
+		return DefaultBinlogTypes, nil
+	}
+	res := make([]string, 0, len(binlogTypes))
+	for _, binlogType := range binlogTypes {
+		binlogType = strings.ToLower(strings.TrimSpace(binlogType))
+		switch binlogType {
+		case BINLOG_TYPE_INSERT, BINLOG_TYPE_DELTA, BINLOG_TYPE_STATS, BINLOG_TYPE_INDEX:
+		default:
+			return nil, fmt.Errorf("unknown binlog type %s, support %s, %s, %s and %s", binlogType, BINLOG_TYPE_INSERT, BINLOG_TYPE_DELTA, BINLOG_TYPE_STATS, BINLOG_TYPE_INDEX)
+		}
+		if !lo.Contains(res, binlogType) {
+			res = append(res, binlogType)
+		}
+	}
+	if !lo.Contains(res, BINLOG_TYPE_INSERT) {
+		return nil, fmt.Errorf("binlog type %s is required", BINLOG_TYPE_INSERT)
+	}
+	return res, nil
+}
+
+// hasBinlogType treats empty binlogTypes as DefaultBinlogTypes, for backups created before the option
+func hasBinlogType(binlogTypes []string, binlogType string) bool {
+	if len(binlogTypes) == 0 {
+		binlogTypes = DefaultBinlogTypes
+	}
+	return lo.Contains(binlogTypes, binlogType)
+}
+
+// FormatBackupTimestamp renders the physical time of a hybrid timestamp in UTC, empty for 0
+func FormatBackupTimestamp(ts uint64) string {
+	if ts == 0 {
+		return ""
+	}
+	physicalTime, _ := utils.ParseTS(ts)
+	return physicalTime.UTC().Format("2006-01-02T15:04:05.000Z07:00")
+}
+
+// shardChannels pairs the virtual channels of a collection with the physical channels. DescribeCollection returns
+// them in the shard order, if the numbers differ the physical channels are derived from the virtual channel names
+func shardChannels(vChannels, pChannels []string) []*backuppb.ShardChannel {
+	channels := make([]*backuppb.ShardChannel, 0, len(vChannels))
+	for i, vChannel := range vChannels {
+		pChannel := ""
+		if len(pChannels) == len(vChannels) {
+			pChannel = pChannels[i]
+		} else if derived, ok := vChannelToPChannel(vChannel); ok {
+			pChannel = derived
+		}
+		channels = append(channels, &backuppb.ShardChannel{
+			Shard:           int32(i),
+			VirtualChannel:  vChannel,
+			PhysicalChannel: pChannel,
+		})
+	}
+	return channels
+}
+
+// vChannelToPChannel returns the physical channel of a virtual channel of milvus, named
+// <physical channel>_<collection id>v<shard index>, e.g. by-dev-rootcoord-dml_0_449000000000000001v0.
+// the physical channel can contain '_', so only the last element is removed. A name not matching the format is
+// returned as it is with false
+func vChannelToPChannel(vChannel string) (string, bool) {
+	idx := strings.LastIndex(vChannel, "_")
+	if idx <= 0 {
+		return vChannel, false
+	}
+	suffix := strings.SplitN(vChannel[idx+1:], "v", 2)
+	if len(suffix) != 2 {
+		return vChannel, false
+	}
+	for _, part := range suffix {
+		if _, err := strconv.ParseInt(part, 10, 64); err != nil {
+			return vChannel, false
+		}
+	}
+	return vChannel[:idx], true
+}
+
+// SegmentIndexFiles groups the index files listed under index_files/ of milvus by the segments, partitionID by segmentID,
+// index_files/build_id/index_version/partition_id/segment_id/file. Only the latest index version of a build is kept,
+// the older versions are left by the index rebuild and will be recycled by milvus.
+func SegmentIndexFiles(paths []string, sizes []int64, rootPath string, segmentPartitions map[int64]int64) map[int64][]*backuppb.SegmentIndexFiles {
+	indexDir := INDEX_FILE_DIR + SEPERATOR
+	if rootPath != "" {
+		indexDir = strings.TrimSuffix(rootPath, SEPERATOR) + SEPERATOR + indexDir
+	}
+	// segmentID -> buildID -> files
+	builds := make(map[int64]map[int64]*backuppb.SegmentIndexFiles)
+	for i, filePath := range paths {
+		if !strings.HasPrefix(filePath, indexDir) {
+			continue
+		}
+		parts := strings.SplitN(strings.TrimPrefix(filePath, indexDir), SEPERATOR, 5)
+		if len(parts) < 5 || parts[4] == "" {
+			continue
+		}
+		ids := make([]int64, 4)
+		var err error
+		for j := range ids {
+			if ids[j], err = strconv.ParseInt(parts[j], 10, 64); err != nil {
+				break
+			}
+		}
+		if err != nil {
+			continue
+		}
+		buildID, indexVersion, partitionID, segmentID := ids[0], ids[1], ids[2], ids[3]
+		if expected, ok := segmentPartitions[segmentID]; !ok || expected != partitionID {
+			continue
+		}
+		if _, ok := builds[segmentID]; !ok {
+			builds[segmentID] = make(map[int64]*backuppb.SegmentIndexFiles)
+		}
+		build, ok := builds[segmentID][buildID]
+		if !ok || build.GetIndexVersion() < indexVersion {
+			build = &backuppb.SegmentIndexFiles{BuildId: buildID, IndexVersion: indexVersion}
+			builds[segmentID][buildID] = build
+		} else if build.GetIndexVersion() > indexVersion {
+			continue
+		}
+		build.Files = append(build.Files, &backuppb.Binlog{LogPath: filePath, LogSize: sizes[i]})
+	}
+
+	res := make(map[int64][]*backuppb.SegmentIndexFiles, len(builds))
+	for segmentID, segmentBuilds := range builds {
+		indexFiles := lo.Values(segmentBuilds)
+		sort.Slice(indexFiles, func(i, j int) bool { return indexFiles[i].GetBuildId() < indexFiles[j].GetBuildId() })
+		res[segmentID] = indexFiles
+	}
+	return res
+}
+
+// indexFieldBinlogs returns the index files of the segment as field binlogs, to copy and verify them like the binlogs
+func indexFieldBinlogs(segment *backuppb.SegmentBackupInfo) []*backuppb.FieldBinlog {
+	fieldBinlogs := make([]*backuppb.FieldBinlog, 0, len(segment.GetIndexFiles()))
+	for _, indexFiles := range segment.GetIndexFiles() {
+		fieldBinlogs = append(fieldBinlogs, &backuppb.FieldBinlog{Binlogs: indexFiles.GetFiles()})
+	}
+	return fieldBinlogs
+}
+
+// CollectionTTLProperty is the collection property of milvus to expire the rows after the seconds
+const CollectionTTLProperty = "collection.ttl.seconds"
+
+// CollectionTTLSeconds returns the TTL of a collection by its properties, 0 if no TTL or illegal
+func CollectionTTLSeconds(properties map[string]string) int64 {
+	ttl, err := strconv.ParseInt(properties[CollectionTTLProperty], 10, 64)
+	if err != nil || ttl < 0 {
+		return 0
+	}
+	return ttl
+}
+
+// SnapshotSpread returns the max difference of the backup timestamps of the collections,
+// and the collections with the earliest and the latest one. Collections without backup timestamp are ignored.
+func SnapshotSpread(collections []*backuppb.CollectionBackupInfo) (time.Duration, *backuppb.CollectionBackupInfo, *backuppb.CollectionBackupInfo) {
+	var earliest, latest *backuppb.CollectionBackupInfo
+	for _, collection := range collections {
+		if collection.GetBackupTimestamp() == 0 {
+			continue
+		}
+		if earliest == nil || collection.GetBackupTimestamp() < earliest.GetBackupTimestamp() {
+			earliest = collection
+		}
+		if latest == nil || collection.GetBackupTimestamp() > latest.GetBackupTimestamp() {
+			latest = collection
+		}
+	}
+	if earliest == nil {
+		return 0, nil, nil
+	}
+	earliestTime, _ := utils.ParseTS(earliest.GetBackupTimestamp())
+	latestTime, _ := utils.ParseTS(latest.GetBackupTimestamp())
+	return latestTime.Sub(earliestTime), earliest, latest
+}
+
+// filterBackupPartitions returns the partitions to backup in the scope, an empty scope means all partitions.
+// Partitions of a partition key collection are managed by milvus, so they can only be backed up all together.
+func filterBackupPartitions(partitions []*entity.Partition, scope string, hasPartitionKey bool) ([]*entity.Partition, error) {
+	switch scope {
+	case "", PartitionScopeAll:
+		return partitions, nil
+	case PartitionScopeDefaultOnly, PartitionScopeExcludeDefault:
+		if hasPartitionKey {
+			return nil, fmt.Errorf("partition scope %s is not supported by partition key collections", scope)
+		}
+		return lo.Filter(partitions, func(partition *entity.Partition, _ int) bool {
+			return (partition.Name == DefaultPartitionName) == (scope == PartitionScopeDefaultOnly)
+		}), nil
+	default:
+		return nil, fmt.Errorf("illegal partition scope %s, should be %s, %s or %s", scope, PartitionScopeAll, PartitionScopeDefaultOnly, PartitionScopeExcludeDefault)
+	}
+}
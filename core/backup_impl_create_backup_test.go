@@ -0,0 +1,420 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	gomilvus "github.com/milvus-io/milvus-sdk-go/v2/client"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+	"github.com/zilliztech/milvus-backup/core/utils"
+)
+
+func TestVerifyBackupObjects(t *testing.T) {
+	ctx := context.Background()
+	b := newLocalBackupContext(t)
+	b.params.BackupCfg.BackupVerifyParallelism = 2
+	b.meta.AddBackup(&backuppb.BackupInfo{Id: "backup-id", Name: "b1"})
+	b.meta.AddCollection(&backuppb.CollectionBackupInfo{Id: "backup-id", CollectionId: 1})
+	b.meta.AddPartition(&backuppb.PartitionBackupInfo{CollectionId: 1, PartitionId: 2})
+	// the segment of the partition in meta is filled with its binlogs, unlike the one prepared in the partition
+	binlogs := []*backuppb.Binlog{
+		{LogPath: b.milvusRootPath + "/insert_log/1/2/3/100/1", LogSize: 5},
+		{LogPath: b.milvusRootPath + "/insert_log/1/2/3/101/1", LogSize: 3},
+	}
+	b.meta.AddSegment(&backuppb.SegmentBackupInfo{
+		CollectionId: 1,
+		PartitionId:  2,
+		SegmentId:    3,
+		GroupId:      3,
+		Binlogs:      []*backuppb.FieldBinlog{{FieldID: 100, Binlogs: binlogs}},
+	})
+	backupBinlogPath := BackupBinlogDirPath(b.backupRootPath, "b1")
+	targetPath := func(binlog *backuppb.Binlog) string {
+		return BackupSegmentBinlogPath(binlog.GetLogPath(), b.milvusRootPath, backupBinlogPath, 2, 3)
+	}
+
+	storageClient := b.getStorageClient()
+	assert.NoError(t, storageClient.Write(ctx, b.backupBucketName, targetPath(binlogs[0]), []byte("12345")))
+	assert.NoError(t, storageClient.Write(ctx, b.backupBucketName, targetPath(binlogs[1]), []byte("123")))
+	assert.NoError(t, b.verifyBackupObjects(ctx, "backup-id"))
+
+	// a binlog with the wrong size
+	assert.NoError(t, storageClient.Write(ctx, b.backupBucketName, targetPath(binlogs[1]), []byte("1234")))
+	err := b.verifyBackupObjects(ctx, "backup-id")
+	assert.ErrorContains(t, err, "1 of 2 objects verified")
+	assert.ErrorContains(t, err, "size 4, expected 3")
+
+	// a missing binlog
+	assert.NoError(t, storageClient.Remove(ctx, b.backupBucketName, targetPath(binlogs[0])))
+	err = b.verifyBackupObjects(ctx, "backup-id")
+	assert.ErrorContains(t, err, "0 of 2 objects verified")
+	assert.ErrorContains(t, err, "not exist")
+}
+
+func TestGenerateBackupName(t *testing.T) {
+	ctx := context.Background()
+	b := newLocalBackupContext(t)
+
+	b.params.BackupCfg.NameTemplate = "{date}_backup"
+	_, err := b.generateBackupName(ctx)
+	assert.ErrorContains(t, err, "invalid backup name")
+
+	// backup_10 doesn't take backup_1
+	b.params.BackupCfg.NameTemplate = "backup_{seq}"
+	assert.NoError(t, b.getStorageClient().Write(ctx, b.backupBucketName, BackupMetaPath(b.backupRootPath, "backup_10"), []byte("{}")))
+	name, err := b.generateBackupName(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "backup_1", name)
+	assert.NoError(t, b.getStorageClient().Write(ctx, b.backupBucketName, BackupMetaPath(b.backupRootPath, "backup_1"), []byte("{}")))
+	name, err = b.generateBackupName(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "backup_2", name)
+}
+
+func TestListSegmentIndexFiles(t *testing.T) {
+	ctx := context.Background()
+	b := newLocalBackupContext(t)
+	b.milvusIndexFiles = &milvusIndexFiles{}
+	indexDir := b.milvusRootPath + SEPERATOR + INDEX_FILE_DIR + SEPERATOR
+	assert.NoError(t, b.getStorageClient().Write(ctx, b.milvusBucketName, indexDir+"10/1/2/3/index", []byte("index")))
+	assert.NoError(t, b.getStorageClient().Write(ctx, b.milvusBucketName, indexDir+"11/1/5/6/index", []byte("index")))
+
+	indexFiles, err := b.listSegmentIndexFiles(ctx, []*backuppb.SegmentBackupInfo{{PartitionId: 2, SegmentId: 3}})
+	assert.NoError(t, err)
+	assert.Len(t, indexFiles, 1)
+	assert.Equal(t, int64(10), indexFiles[3][0].GetBuildId())
+	assert.Equal(t, indexDir+"10/1/2/3/index", indexFiles[3][0].GetFiles()[0].GetLogPath())
+
+	// the listing is reused by the other collections of the backup
+	assert.NoError(t, b.getStorageClient().Write(ctx, b.milvusBucketName, indexDir+"12/1/5/6/index", []byte("index")))
+	indexFiles, err = b.listSegmentIndexFiles(ctx, []*backuppb.SegmentBackupInfo{{PartitionId: 5, SegmentId: 6}})
+	assert.NoError(t, err)
+	assert.Len(t, indexFiles[6], 1)
+	assert.Equal(t, int64(11), indexFiles[6][0].GetBuildId())
+}
+
+func TestVerifyBackupSegments(t *testing.T) {
+	ctx := context.Background()
+	b := newLocalBackupContext(t)
+	b.meta.AddBackup(&backuppb.BackupInfo{Id: "backup-id", Name: "b1"})
+	b.meta.AddCollection(&backuppb.CollectionBackupInfo{Id: "backup-id", CollectionId: 1})
+	b.meta.AddPartition(&backuppb.PartitionBackupInfo{
+		CollectionId:   1,
+		PartitionId:    2,
+		SegmentBackups: []*backuppb.SegmentBackupInfo{{CollectionId: 1, PartitionId: 2, SegmentId: 3}},
+	})
+
+	// the segments are only kept for the backups to verify
+	assert.NoError(t, b.verifyBackupSegments(ctx, "backup-id"))
+
+	snapshot := &sync.Map{}
+	snapshot.Store(int64(1), []int64{3})
+	b.snapshotSegments.Store("backup-id", snapshot)
+	assert.NoError(t, b.verifyBackupSegments(ctx, "backup-id"))
+}
+
+func TestListedObjectSize(t *testing.T) {
+	ctx := context.Background()
+	b := newLocalBackupContext(t)
+	binlogPath := b.milvusRootPath + "/insert_log/1/2/3/100/1"
+	assert.NoError(t, b.getStorageClient().Write(ctx, b.milvusBucketName, binlogPath, []byte("12345")))
+	assert.NoError(t, b.getStorageClient().Write(ctx, b.milvusBucketName, binlogPath+"0", []byte("123")))
+
+	size, exist, err := b.listedObjectSize(ctx, b.milvusBucketName, binlogPath)
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	assert.Equal(t, int64(5), size)
+	_, exist, err = b.listedObjectSize(ctx, b.milvusBucketName, b.milvusRootPath+"/insert_log/1/2/3/100/2")
+	assert.NoError(t, err)
+	assert.False(t, exist)
+
+	// the listed size is checked, not the size in the segment meta
+	b.params.BackupCfg.MaxObjectSize = 4
+	assert.ErrorContains(t, b.checkObjectSize(binlogPath, size), "exceeds max object size 4")
+}
+
+func TestParseBinlogTypes(t *testing.T) {
+	binlogTypes, err := ParseBinlogTypes(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultBinlogTypes, binlogTypes)
+
+	binlogTypes, err = ParseBinlogTypes([]string{"insert", " Stats", "insert"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{BINLOG_TYPE_INSERT, BINLOG_TYPE_STATS}, binlogTypes)
+	assert.False(t, hasBinlogType(binlogTypes, BINLOG_TYPE_DELTA))
+
+	_, err = ParseBinlogTypes([]string{"delta"})
+	assert.Error(t, err)
+	binlogTypes, err = ParseBinlogTypes([]string{"insert", "index"})
+	assert.NoError(t, err)
+	assert.True(t, hasBinlogType(binlogTypes, BINLOG_TYPE_INDEX))
+	_, err = ParseBinlogTypes([]string{"insert", "unknown"})
+	assert.Error(t, err)
+
+	assert.True(t, hasBinlogType(nil, BINLOG_TYPE_DELTA))
+}
+
+func TestSnapshotSpread(t *testing.T) {
+	spread, earliest, latest := SnapshotSpread(nil)
+	assert.Equal(t, time.Duration(0), spread)
+	assert.Nil(t, earliest)
+	assert.Nil(t, latest)
+
+	now := time.Now().UnixMilli()
+	collections := []*backuppb.CollectionBackupInfo{
+		{CollectionName: "c1", BackupTimestamp: utils.ComposeTS(now+3000, 0)},
+		{CollectionName: "c2", BackupTimestamp: utils.ComposeTS(now, 1)},
+		{CollectionName: "template"},
+		{CollectionName: "c3", BackupTimestamp: utils.ComposeTS(now+1500, 0)},
+	}
+	spread, earliest, latest = SnapshotSpread(collections)
+	assert.Equal(t, 3*time.Second, spread)
+	assert.Equal(t, "c2", earliest.GetCollectionName())
+	assert.Equal(t, "c1", latest.GetCollectionName())
+}
+
+func TestRecoverBackupPanic(t *testing.T) {
+	meta := newMetaManager()
+	meta.AddBackup(&backuppb.BackupInfo{Id: "backup", StateCode: backuppb.BackupTaskStateCode_BACKUP_EXECUTING})
+	b := &BackupContext{meta: meta}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer b.recoverBackupPanic("backup")
+		panic("injected panic")
+	}()
+	<-done
+
+	backup := meta.GetBackup("backup")
+	assert.Equal(t, backuppb.BackupTaskStateCode_BACKUP_FAIL, backup.GetStateCode())
+	assert.Contains(t, backup.GetErrorMessage(), "injected panic")
+	assert.Contains(t, backup.GetErrorMessage(), "TestRecoverBackupPanic")
+	events, _, _ := meta.GetEvents("backup", 0)
+	assert.NotEmpty(t, events)
+
+	// no panic, nothing changes
+	meta.AddBackup(&backuppb.BackupInfo{Id: "backup2", StateCode: backuppb.BackupTaskStateCode_BACKUP_SUCCESS})
+	func() {
+		defer b.recoverBackupPanic("backup2")
+	}()
+	assert.Equal(t, backuppb.BackupTaskStateCode_BACKUP_SUCCESS, meta.GetBackup("backup2").GetStateCode())
+}
+
+// panicClient is a milvus client whose DescribeCollection panics, like a nil response of a broken milvus
+type panicClient struct {
+	gomilvus.Client
+}
+
+func (c *panicClient) UsingDatabase(ctx context.Context, dbName string) error {
+	return nil
+}
+
+func (c *panicClient) HasCollection(ctx context.Context, collName string) (bool, error) {
+	return true, nil
+}
+
+func (c *panicClient) DescribeCollection(ctx context.Context, collName string) (*entity.Collection, error) {
+	panic("injected panic")
+}
+
+func TestExecuteCreateBackupJobPanic(t *testing.T) {
+	var mu sync.Mutex
+	var gcRequests []string
+	gcServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		gcRequests = append(gcRequests, r.URL.Path)
+	}))
+	defer gcServer.Close()
+
+	b := newLocalBackupContext(t)
+	b.milvusClient = &MilvusClient{client: &panicClient{}}
+	b.params.BackupCfg.GcPauseEnable = true
+	b.params.BackupCfg.GcPauseAddress = gcServer.URL
+	backup := &backuppb.BackupInfo{Id: "backup-id", Name: "b1"}
+	b.meta.AddBackup(backup)
+	request := &backuppb.CreateBackupRequest{
+		BackupName:    "b1",
+		DbCollections: utils.WrapDBCollections(`{"db1": ["coll"]}`),
+		Verify:        true,
+	}
+
+	// the panic of the prepare job in the worker pool fails the backup instead of crashing the process
+	err := b.executeCreateBackup(b.ctx, request, backup)
+	assert.ErrorContains(t, err, "injected panic")
+	backup = b.meta.GetBackup("backup-id")
+	assert.Equal(t, backuppb.BackupTaskStateCode_BACKUP_FAIL, backup.GetStateCode())
+	assert.Contains(t, backup.GetErrorMessage(), "panicClient")
+	assert.Equal(t, int32(0), b.getBackupPrepareWorkerPool().JobNum())
+
+	// the defers of executeCreateBackup ran
+	assert.Equal(t, []string{"/management/datacoord/garbage_collection/pause", "/management/datacoord/garbage_collection/resume"}, gcRequests)
+	_, ok := b.snapshotSegments.Load("backup-id")
+	assert.False(t, ok)
+	assert.True(t, b.mu.TryLock())
+	b.mu.Unlock()
+}
+
+func TestRemoveDroppedCollection(t *testing.T) {
+	meta := newMetaManager()
+	meta.AddBackup(&backuppb.BackupInfo{Id: "backup"})
+	meta.AddCollection(&backuppb.CollectionBackupInfo{Id: "backup", CollectionId: 1, DbName: "default", CollectionName: "c1"})
+	meta.AddCollection(&backuppb.CollectionBackupInfo{Id: "backup", CollectionId: 2, DbName: "default", CollectionName: "c2"})
+	meta.AddPartition(&backuppb.PartitionBackupInfo{CollectionId: 1, PartitionId: 10})
+	meta.AddSegment(&backuppb.SegmentBackupInfo{CollectionId: 1, PartitionId: 10, SegmentId: 100})
+
+	b := &BackupContext{meta: meta}
+	b.removeDroppedCollection("backup", collectionStruct{"default", "c1", false, 0})
+	assert.Len(t, meta.GetCollections("backup"), 1)
+	assert.Empty(t, meta.GetPartitions(1))
+	assert.Nil(t, meta.GetSegment(100))
+	assert.Nil(t, meta.GetBackupByCollectionID(1))
+
+	backup := meta.GetFullMeta("backup")
+	assert.Equal(t, []string{"default.c1"}, backup.GetSkippedCollections())
+	assert.Equal(t, "c2", backup.GetCollectionBackups()[0].GetCollectionName())
+}
+
+func TestFilterBackupPartitions(t *testing.T) {
+	partitions := []*entity.Partition{{ID: 1, Name: DefaultPartitionName}, {ID: 2, Name: "p1"}, {ID: 3, Name: "p2"}}
+	filtered, err := filterBackupPartitions(partitions, "", false)
+	assert.NoError(t, err)
+	assert.Len(t, filtered, 3)
+
+	filtered, err = filterBackupPartitions(partitions, PartitionScopeDefaultOnly, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []*entity.Partition{partitions[0]}, filtered)
+
+	filtered, err = filterBackupPartitions(partitions, PartitionScopeExcludeDefault, false)
+	assert.NoError(t, err)
+	assert.Equal(t, partitions[1:], filtered)
+
+	_, err = filterBackupPartitions(partitions, PartitionScopeDefaultOnly, true)
+	assert.Error(t, err)
+	filtered, err = filterBackupPartitions(partitions, PartitionScopeAll, true)
+	assert.NoError(t, err)
+	assert.Len(t, filtered, 3)
+
+	_, err = filterBackupPartitions(partitions, "default", false)
+	assert.Error(t, err)
+}
+
+func TestVChannelToPChannel(t *testing.T) {
+	pChannel, ok := vChannelToPChannel("by-dev-rootcoord-dml_0_449000000000000001v0")
+	assert.True(t, ok)
+	assert.Equal(t, "by-dev-rootcoord-dml_0", pChannel)
+	// '_' in the channel prefix
+	pChannel, ok = vChannelToPChannel("my_cluster-rootcoord-dml_12_449000000000000001v3")
+	assert.True(t, ok)
+	assert.Equal(t, "my_cluster-rootcoord-dml_12", pChannel)
+
+	for _, vChannel := range []string{"", "dml", "_449v0", "by-dev-rootcoord-dml_0", "dml_0_abcv0", "dml_0_449v", "dml_0_449"} {
+		pChannel, ok = vChannelToPChannel(vChannel)
+		assert.False(t, ok, vChannel)
+		assert.Equal(t, vChannel, pChannel)
+	}
+}
+
+func TestShardChannels(t *testing.T) {
+	vChannels := []string{"by-dev-rootcoord-dml_0_449000000000000001v0", "by-dev-rootcoord-dml_1_449000000000000001v1"}
+	channels := shardChannels(vChannels, []string{"by-dev-rootcoord-dml_0", "by-dev-rootcoord-dml_1"})
+	assert.Equal(t, 2, len(channels))
+	assert.Equal(t, int32(1), channels[1].GetShard())
+	assert.Equal(t, vChannels[1], channels[1].GetVirtualChannel())
+	assert.Equal(t, "by-dev-rootcoord-dml_1", channels[1].GetPhysicalChannel())
+
+	// derived from the virtual channel names
+	channels = shardChannels(append(vChannels, "unknown"), nil)
+	assert.Equal(t, "by-dev-rootcoord-dml_0", channels[0].GetPhysicalChannel())
+	assert.Equal(t, "", channels[2].GetPhysicalChannel())
+
+	assert.Equal(t, 0, len(shardChannels(nil, nil)))
+}
+
+func TestClockSkew(t *testing.T) {
+	before := time.UnixMilli(1700000000000)
+	after := before.Add(200 * time.Millisecond)
+	assert.Equal(t, time.Duration(0), clockSkew(utils.ComposeTS(1700000000100, 3), before, after))
+	assert.Equal(t, 5*time.Second, clockSkew(utils.ComposeTS(1700000005100, 0), before, after))
+	assert.Equal(t, -2*time.Second, clockSkew(utils.ComposeTS(1699999998100, 0), before, after))
+}
+
+func TestMatchProperties(t *testing.T) {
+	properties := map[string]string{"tier": "gold", "env": "prod"}
+	assert.True(t, matchProperties(properties, nil))
+	assert.True(t, matchProperties(properties, map[string]string{"tier": "gold"}))
+	assert.True(t, matchProperties(properties, map[string]string{"tier": "gold", "env": "prod"}))
+	assert.False(t, matchProperties(properties, map[string]string{"tier": "silver"}))
+	assert.False(t, matchProperties(properties, map[string]string{"tier": "gold", "owner": "ml"}))
+	assert.False(t, matchProperties(nil, map[string]string{"tier": "gold"}))
+}
+
+func TestFormatBackupTimestamp(t *testing.T) {
+	assert.Equal(t, "", FormatBackupTimestamp(0))
+	physical := time.Date(2024, 5, 6, 7, 8, 9, 123*int(time.Millisecond), time.UTC).UnixMilli()
+	assert.Equal(t, "2024-05-06T07:08:09.123Z", FormatBackupTimestamp(utils.ComposeTS(physical, 5)))
+}
+
+func TestCollectionTTLSeconds(t *testing.T) {
+	assert.Equal(t, int64(0), CollectionTTLSeconds(nil))
+	assert.Equal(t, int64(3600), CollectionTTLSeconds(map[string]string{CollectionTTLProperty: "3600"}))
+	assert.Equal(t, int64(0), CollectionTTLSeconds(map[string]string{CollectionTTLProperty: "abc"}))
+	assert.Equal(t, int64(0), CollectionTTLSeconds(map[string]string{CollectionTTLProperty: "-1"}))
+}
+
+func TestIsBinlogFile(t *testing.T) {
+	assert.True(t, isBinlogFile("files/insert_log/1/2/3/100/448291"))
+	assert.True(t, isBinlogFile("files/stats_log/1/2/3/100/1"))
+	assert.False(t, isBinlogFile("files/insert_log/1/2/3/100/448291.tmp"))
+	assert.False(t, isBinlogFile("files/insert_log/1/2/3/100/sub/"))
+	assert.False(t, isBinlogFile("files/insert_log/1/2/3/100/.part"))
+}
+
+func TestBinlogChecksum(t *testing.T) {
+	assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", binlogChecksum(nil))
+	assert.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", binlogChecksum([]byte("abc")))
+	assert.NotEqual(t, binlogChecksum([]byte("abc")), binlogChecksum([]byte("abd")))
+}
+
+func TestSegmentIndexFiles(t *testing.T) {
+	paths := []string{
+		"files/index_files/10/1/2/100/HNSW_1",
+		"files/index_files/10/2/2/100/HNSW_1",
+		"files/index_files/10/2/2/100/HNSW_2",
+		"files/index_files/11/1/2/100/INVERTED",
+		"files/index_files/12/1/2/101/HNSW_1",
+		// not a segment of the backup, or in another partition
+		"files/index_files/13/1/2/102/HNSW_1",
+		"files/index_files/14/1/3/101/HNSW_1",
+		"files/index_files/sub/1/2/100/HNSW_1",
+		"files/insert_log/1/2/100/101/1",
+	}
+	sizes := []int64{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	indexFiles := SegmentIndexFiles(paths, sizes, "files", map[int64]int64{100: 2, 101: 2})
+	assert.Len(t, indexFiles, 2)
+
+	// the latest version of a build
+	assert.Len(t, indexFiles[100], 2)
+	assert.Equal(t, int64(10), indexFiles[100][0].GetBuildId())
+	assert.Equal(t, int64(2), indexFiles[100][0].GetIndexVersion())
+	assert.Equal(t, []*backuppb.Binlog{
+		{LogPath: "files/index_files/10/2/2/100/HNSW_1", LogSize: 2},
+		{LogPath: "files/index_files/10/2/2/100/HNSW_2", LogSize: 3},
+	}, indexFiles[100][0].GetFiles())
+	assert.Equal(t, int64(11), indexFiles[100][1].GetBuildId())
+	assert.Equal(t, "files/index_files/12/1/2/101/HNSW_1", indexFiles[101][0].GetFiles()[0].GetLogPath())
+
+	fieldBinlogs := indexFieldBinlogs(&backuppb.SegmentBackupInfo{IndexFiles: indexFiles[100]})
+	assert.Len(t, fieldBinlogs, 2)
+	assert.Len(t, fieldBinlogs[0].GetBinlogs(), 2)
+
+	assert.Len(t, SegmentIndexFiles([]string{"index_files/12/1/2/101/HNSW_1"}, []int64{1}, "", map[int64]int64{101: 2}), 1)
+}
@@ -0,0 +1,331 @@
+package core
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+	"github.com/zilliztech/milvus-backup/core/utils"
+	"github.com/zilliztech/milvus-backup/internal/log"
+)
+
+// ExportBackup streams all objects of a backup into a tar written to w, gzipped if compress is true.
+// Entry names are the object keys relative to the dir holding the backup, so the backup keeps its layout after import.
+// A backup in a subdirectory of the nested layout is imported into the backup root path by the last element of its name.
+// Meta files are written last, an interrupted export or import has no backup meta and is taken as an orphan.
+// An incremental backup is refused, the segments it reuses are in the base backups and not in the tar.
+func (b *BackupContext) ExportBackup(ctx context.Context, backupName string, w io.Writer, compress bool) error {
+	log.Info("receive ExportBackup", zap.String("backupName", backupName), zap.Bool("compress", compress))
+	if !b.started {
+		err := b.Start()
+		if err != nil {
+			return err
+		}
+	}
+	if backupName == "" {
+		return errors.New("empty backup name")
+	}
+
+	backupDir, name := b.backupParentOf(backupName)
+	exist, err := b.getStorageClient().Exist(ctx, b.backupBucketName, BackupMetaPath(backupDir, name))
+	if err != nil {
+		return fmt.Errorf("fail to check backup %s exist, err: %w", backupName, err)
+	}
+	if !exist {
+		return fmt.Errorf("backup %s not exist or not complete", backupName)
+	}
+	backupInfo, err := b.readBackup(ctx, b.backupBucketName, BackupPath(backupDir, name))
+	if err != nil {
+		return fmt.Errorf("fail to read backup %s, err: %w", backupName, err)
+	}
+	if err := checkNotIncremental(backupInfo, "export"); err != nil {
+		return err
+	}
+
+	keys, sizes, err := b.getStorageClient().ListWithPrefix(ctx, b.backupBucketName, BackupDirPath(backupDir, name), true)
+	if err != nil {
+		return fmt.Errorf("fail to list objects of backup %s, err: %w", backupName, err)
+	}
+	objectSizes := make(map[string]int64, len(keys))
+	for i, key := range keys {
+		objectSizes[key] = sizes[i]
+	}
+	metaDir := BackupMetaDirPath(backupDir, name) + SEPERATOR
+	sort.SliceStable(keys, func(i, j int) bool {
+		iMeta, jMeta := strings.HasPrefix(keys[i], metaDir), strings.HasPrefix(keys[j], metaDir)
+		if iMeta != jMeta {
+			return jMeta
+		}
+		return keys[i] < keys[j]
+	})
+
+	var gw *gzip.Writer
+	if compress {
+		gw = gzip.NewWriter(w)
+		w = gw
+	}
+	tw := tar.NewWriter(w)
+	now := time.Now()
+	var size int64
+	for _, key := range keys {
+		header := &tar.Header{
+			Name:    strings.TrimPrefix(key, backupDir+SEPERATOR),
+			Mode:    0644,
+			Size:    objectSizes[key],
+			ModTime: now,
+		}
+		if err := b.exportObject(ctx, tw, key, header); err != nil {
+			return err
+		}
+		size += header.Size
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if gw != nil {
+		if err := gw.Close(); err != nil {
+			return err
+		}
+	}
+	log.Info("finish ExportBackup", zap.String("backupName", backupName), zap.Int("objects", len(keys)), zap.Int64("size", size))
+	return nil
+}
+
+// exportObject streams an object into the tar with the listed size, the tar fails if the object has another size
+func (b *BackupContext) exportObject(ctx context.Context, tw *tar.Writer, key string, header *tar.Header) error {
+	reader, err := b.getStorageClient().Reader(ctx, b.backupBucketName, key)
+	if err != nil {
+		return fmt.Errorf("fail to read %s, err: %w", key, err)
+	}
+	defer reader.Close()
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	if _, err := io.Copy(tw, reader); err != nil {
+		return fmt.Errorf("fail to export %s, err: %w", key, err)
+	}
+	return nil
+}
+
+// ImportBackup unpacks a tar made by ExportBackup into the backup root path in bucketName, the backup bucket if empty,
+// gzip is detected automatically. It returns the name of the imported backup, which must not exist in the backup root
+// path yet. The objects of a failed import are removed.
+func (b *BackupContext) ImportBackup(ctx context.Context, r io.Reader, bucketName string) (backupName string, err error) {
+	log.Info("receive ImportBackup", zap.String("bucketName", bucketName))
+	if !b.started {
+		err := b.Start()
+		if err != nil {
+			return "", err
+		}
+	}
+	if b.params.BackupCfg.ReadOnly {
+		return "", ErrReadOnly
+	}
+	if bucketName == "" {
+		bucketName = b.backupBucketName
+	}
+
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(2)
+	if err != nil {
+		return "", fmt.Errorf("fail to read backup tar, err: %w", err)
+	}
+	var reader io.Reader = br
+	if magic[0] == 0x1f && magic[1] == 0x8b {
+		gr, err := gzip.NewReader(br)
+		if err != nil {
+			return "", err
+		}
+		defer gr.Close()
+		reader = gr
+	}
+
+	tr := tar.NewReader(reader)
+	created := false
+	defer func() {
+		if err != nil && created {
+			if removeErr := b.getStorageClient().RemoveWithPrefix(ctx, bucketName, BackupDirPath(b.backupRootPath, backupName)); removeErr != nil {
+				log.Warn("fail to remove the partially imported backup", zap.String("backupName", backupName), zap.Error(removeErr))
+			}
+		}
+	}()
+	var objects int
+	var size int64
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return backupName, fmt.Errorf("fail to read backup tar, err: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := header.Name
+		if path.IsAbs(name) || path.Clean(name) != name || strings.HasPrefix(name, "..") {
+			return backupName, fmt.Errorf("illegal entry %s in backup tar", name)
+		}
+		entryBackupName := strings.SplitN(name, SEPERATOR, 2)[0]
+		if backupName == "" {
+			if err := utils.ValidateType(entryBackupName, BACKUP_NAME); err != nil {
+				return "", err
+			}
+			// any object under the name is taken as a backup, even a partial one
+			keys, _, err := b.getStorageClient().ListWithPrefix(ctx, bucketName, BackupDirPath(b.backupRootPath, entryBackupName), true)
+			if err != nil {
+				return "", fmt.Errorf("fail to check backup %s exist, err: %w", entryBackupName, err)
+			}
+			if len(keys) > 0 {
+				return "", fmt.Errorf("backup already exist with the name: %s", entryBackupName)
+			}
+			backupName = entryBackupName
+			created = true
+		} else if entryBackupName != backupName {
+			return backupName, fmt.Errorf("backup tar contains more than one backup: %s, %s", backupName, entryBackupName)
+		}
+
+		err = b.getStorageClient().WriteStream(ctx, bucketName, b.backupRootPath+SEPERATOR+name, tr, header.Size)
+		if err != nil {
+			return backupName, fmt.Errorf("fail to write %s, err: %w", name, err)
+		}
+		objects++
+		size += header.Size
+	}
+	if backupName == "" {
+		return "", errors.New("empty backup tar")
+	}
+	log.Info("finish ImportBackup", zap.String("backupName", backupName), zap.Int("objects", objects), zap.Int64("size", size))
+	return backupName, nil
+}
+
+// ExportCollectionData downloads the insert binlogs of one collection in a backup into the local dir, for offline analysis.
+// Files keep their layout under the binlog dir of the backup, e.g. dir/insert_log/collection_id/partition_id/segment_id/field_id/log_id,
+// and the collection schema is written to dir/schema.json to map the field ids.
+func (b *BackupContext) ExportCollectionData(ctx context.Context, backupName, dbName, collectionName, dir string) error {
+	log.Info("receive ExportCollectionData",
+		zap.String("backupName", backupName),
+		zap.String("databaseName", dbName),
+		zap.String("collectionName", collectionName),
+		zap.String("dir", dir))
+	if !b.started {
+		err := b.Start()
+		if err != nil {
+			return err
+		}
+	}
+	if dbName == "" {
+		dbName = "default"
+	}
+
+	getResp := b.GetBackup(ctx, &backuppb.GetBackupRequest{BackupName: backupName})
+	if getResp.GetCode() != backuppb.ResponseCode_Success {
+		return errors.New(getResp.GetMsg())
+	}
+	if getResp.GetData() == nil {
+		return fmt.Errorf("backup %s not exist", backupName)
+	}
+	collection, ok := lo.Find(getResp.GetData().GetCollectionBackups(), func(coll *backuppb.CollectionBackupInfo) bool {
+		collDbName := coll.GetDbName()
+		if collDbName == "" {
+			collDbName = "default"
+		}
+		return collDbName == dbName && coll.GetCollectionName() == collectionName
+	})
+	if !ok {
+		return fmt.Errorf("collection %s.%s not exist in backup %s", dbName, collectionName, backupName)
+	}
+	binlogCipher, err := b.backupCipher(getResp.GetData())
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	schema, err := jsoniter.MarshalIndent(collection.GetSchema(), "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "schema.json"), schema, 0644); err != nil {
+		return err
+	}
+
+	backupPath := b.backupPathOf(backupName)
+	var objects int
+	var size int64
+	for _, partition := range collection.GetPartitionBackups() {
+		for _, segment := range partition.GetSegmentBackups() {
+			// a segment reused by an incremental backup is read from the backup holding it
+			segmentBinlogDir := SegmentBackupPath(backupPath, backupName, segment) + SEPERATOR + BINGLOG_DIR
+			segmentDir := fmt.Sprintf("%s/%s/%v/%v/", segmentBinlogDir, INSERT_LOG_DIR, segment.GetCollectionId(), segment.GetPartitionId())
+			if segment.GetGroupId() != 0 {
+				segmentDir = fmt.Sprintf("%s%v/", segmentDir, segment.GetGroupId())
+			}
+			segmentDir = fmt.Sprintf("%s%v/", segmentDir, segment.GetSegmentId())
+			keys, _, err := b.getStorageClient().ListWithPrefix(ctx, b.backupBucketName, segmentDir, true)
+			if err != nil {
+				return fmt.Errorf("fail to list insert logs of segment %d, err: %w", segment.GetSegmentId(), err)
+			}
+			for _, key := range keys {
+				localPath := filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(key, segmentBinlogDir+SEPERATOR)))
+				written, err := b.downloadBinlog(ctx, binlogCipher, key, localPath)
+				if err != nil {
+					return err
+				}
+				objects++
+				size += written
+			}
+		}
+	}
+	log.Info("finish ExportCollectionData",
+		zap.String("backupName", backupName),
+		zap.String("databaseName", dbName),
+		zap.String("collectionName", collectionName),
+		zap.Int("objects", objects),
+		zap.Int64("size", size))
+	return nil
+}
+
+// downloadBinlog streams a binlog of the backup, decrypted if the cipher isn't nil, into the local file
+func (b *BackupContext) downloadBinlog(ctx context.Context, binlogCipher *binlogCipher, key, localPath string) (int64, error) {
+	reader, err := b.getStorageClient().Reader(ctx, b.backupBucketName, key)
+	if err != nil {
+		return 0, fmt.Errorf("fail to read %s, err: %w", key, err)
+	}
+	defer reader.Close()
+	var content io.Reader = reader
+	if binlogCipher != nil {
+		content = binlogCipher.decryptReader(reader)
+	}
+	if err := os.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
+		return 0, err
+	}
+	file, err := os.Create(localPath)
+	if err != nil {
+		return 0, err
+	}
+	written, err := io.Copy(file, content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, fmt.Errorf("fail to download %s, err: %w", key, err)
+	}
+	return written, nil
+}
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+func TestExportImportBackup(t *testing.T) {
+	ctx := context.Background()
+	b := newLocalBackupContext(t)
+	b.started = true
+	writeLocalBackup(t, b, &backuppb.BackupInfo{Id: "backup-id", Name: "b1", StateCode: backuppb.BackupTaskStateCode_BACKUP_SUCCESS})
+	backupPath := BackupPath(b.backupRootPath, "b1")
+	binlogs := map[string]string{
+		"binlogs/insert_log/1/2/3/4/5": "binlog",
+		"binlogs/delta_log/1/2/3/4/6":  string(bytes.Repeat([]byte("delta"), 10000)),
+	}
+	for file, content := range binlogs {
+		assert.NoError(t, b.getStorageClient().Write(ctx, b.backupBucketName, backupPath+SEPERATOR+file, []byte(content)))
+	}
+	readObjects := func() map[string]string {
+		keys, _, err := b.getStorageClient().ListWithPrefix(ctx, b.backupBucketName, backupPath+SEPERATOR, true)
+		assert.NoError(t, err)
+		objects := make(map[string]string)
+		for _, key := range keys {
+			content, err := b.getStorageClient().Read(ctx, b.backupBucketName, key)
+			assert.NoError(t, err)
+			objects[key] = string(content)
+		}
+		return objects
+	}
+	objects := readObjects()
+	assert.Len(t, objects, len(binlogs)+5)
+
+	for _, compress := range []bool{false, true} {
+		var tarball bytes.Buffer
+		assert.NoError(t, b.ExportBackup(ctx, "b1", &tarball, compress))
+		_, err := b.ImportBackup(ctx, bytes.NewReader(tarball.Bytes()), "")
+		assert.ErrorContains(t, err, "already exist")
+		assert.Equal(t, objects, readObjects())
+
+		assert.NoError(t, b.getStorageClient().RemoveWithPrefix(ctx, b.backupBucketName, backupPath+SEPERATOR))
+		name, err := b.ImportBackup(ctx, bytes.NewReader(tarball.Bytes()), b.backupBucketName)
+		assert.NoError(t, err)
+		assert.Equal(t, "b1", name)
+		assert.Equal(t, objects, readObjects())
+
+		// a truncated tar leaves nothing behind
+		assert.NoError(t, b.getStorageClient().RemoveWithPrefix(ctx, b.backupBucketName, backupPath+SEPERATOR))
+		_, err = b.ImportBackup(ctx, bytes.NewReader(tarball.Bytes()[:tarball.Len()/2]), "")
+		assert.Error(t, err)
+		assert.Len(t, readObjects(), 0)
+
+		_, err = b.ImportBackup(ctx, bytes.NewReader(tarball.Bytes()), "")
+		assert.NoError(t, err)
+	}
+}
@@ -0,0 +1,178 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+	"github.com/zilliztech/milvus-backup/internal/log"
+	"github.com/zilliztech/milvus-backup/internal/util/retry"
+)
+
+// number of the relocated binlog paths checked for existence before the meta is rewritten
+const relocateCheckLimit = 10
+
+// relocatePrefix replaces oldPrefix with newPrefix in a path, a prefix matches whole path elements only
+func relocatePrefix(path, oldPrefix, newPrefix string) (string, bool) {
+	oldPrefix, newPrefix = strings.TrimSuffix(oldPrefix, SEPERATOR), strings.TrimSuffix(newPrefix, SEPERATOR)
+	if path != oldPrefix && !strings.HasPrefix(path, oldPrefix+SEPERATOR) {
+		return path, false
+	}
+	if newPrefix == "" {
+		return strings.TrimPrefix(strings.TrimPrefix(path, oldPrefix), SEPERATOR), true
+	}
+	return newPrefix + strings.TrimPrefix(path, oldPrefix), true
+}
+
+// relocateBinlogPaths replaces oldPrefix with newPrefix in the binlog paths of all the segments of the backup at
+// backupPath, including the milvus root path, and returns the backup objects of the rewritten binlogs resolved
+// from the new paths
+func relocateBinlogPaths(backup *backuppb.BackupInfo, oldPrefix, newPrefix, backupPath string) []string {
+	if milvusRootPath, ok := relocatePrefix(backup.GetMilvusRootPath(), oldPrefix, newPrefix); ok {
+		backup.MilvusRootPath = milvusRootPath
+	}
+	relocated := make([]string, 0)
+	relocateSegments := func(segments []*backuppb.SegmentBackupInfo) {
+		for _, segment := range segments {
+			binlogDir := SegmentBackupPath(backupPath, backup.GetName(), segment) + SEPERATOR + BINGLOG_DIR
+			for _, fieldBinlogs := range [][]*backuppb.FieldBinlog{segment.GetBinlogs(), segment.GetDeltalogs(), segment.GetStatslogs(), indexFieldBinlogs(segment)} {
+				for _, fieldBinlog := range fieldBinlogs {
+					for _, binlog := range fieldBinlog.GetBinlogs() {
+						logPath, ok := relocatePrefix(binlog.GetLogPath(), oldPrefix, newPrefix)
+						if !ok {
+							continue
+						}
+						binlog.LogPath = logPath
+						relocated = append(relocated, BackupSegmentBinlogPath(logPath, backup.GetMilvusRootPath(), binlogDir,
+							segment.GetPartitionId(), segment.GetGroupId()))
+					}
+				}
+			}
+		}
+	}
+	for _, collection := range backup.GetCollectionBackups() {
+		for _, partition := range collection.GetPartitionBackups() {
+			relocateSegments(partition.GetSegmentBackups())
+		}
+		relocateSegments(collection.GetL0Segments())
+	}
+	return relocated
+}
+
+// RelocateBackup rewrites the binlog paths in the segment meta of a backup from oldPrefix to newPrefix,
+// after the storage of milvus is migrated to another bucket or root path. The backup objects of the binlogs are
+// resolved from the rewritten paths, at least one of them must exist in the backup bucket. The backup objects
+// are not moved. The segment meta files of the old layout not rewritten are removed after the backup meta file.
+// An incremental backup is refused, the segments it reuses are in the meta of the base backups.
+func (b *BackupContext) RelocateBackup(ctx context.Context, name, oldPrefix, newPrefix string) error {
+	log.Info("receive RelocateBackup", zap.String("name", name), zap.String("oldPrefix", oldPrefix), zap.String("newPrefix", newPrefix))
+	if !b.started {
+		err := b.Start()
+		if err != nil {
+			return err
+		}
+	}
+	if b.params.BackupCfg.ReadOnly {
+		return ErrReadOnly
+	}
+	if name == "" {
+		return errors.New("empty backup name")
+	}
+	if oldPrefix == "" {
+		return errors.New("empty old prefix")
+	}
+	if oldPrefix == newPrefix {
+		return fmt.Errorf("old prefix and new prefix are the same: %s", oldPrefix)
+	}
+	if b.meta.IsBackupInProgress(name) {
+		return fmt.Errorf("backup %s is in progress", name)
+	}
+
+	// the meta of a backup in a subdirectory of the nested layout is resolved in its dir by the last element of its name
+	backupDir, baseName := b.backupParentOf(name)
+	exist, err := b.getStorageClient().Exist(ctx, b.backupBucketName, BackupMetaPath(backupDir, baseName))
+	if err != nil {
+		return fmt.Errorf("fail to check backup %s exist, err: %w", name, err)
+	}
+	if !exist {
+		return fmt.Errorf("backup %s not exist or not complete", name)
+	}
+	backupInfo, err := b.readBackup(ctx, b.backupBucketName, BackupPath(backupDir, baseName))
+	if err != nil {
+		return fmt.Errorf("fail to read backup %s, err: %w", name, err)
+	}
+	if err := checkNotIncremental(backupInfo, "relocate"); err != nil {
+		return err
+	}
+
+	relocated := relocateBinlogPaths(backupInfo, oldPrefix, newPrefix, BackupPath(backupDir, baseName))
+	if len(relocated) == 0 {
+		return fmt.Errorf("no binlog path of backup %s has the prefix %s", name, oldPrefix)
+	}
+	found := false
+	for i := 0; i < len(relocated) && i < relocateCheckLimit; i++ {
+		exist, err := b.getStorageClient().Exist(ctx, b.backupBucketName, relocated[i])
+		if err != nil {
+			return fmt.Errorf("fail to check %s exist, err: %w", relocated[i], err)
+		}
+		if exist {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("none of the relocated binlogs exists in bucket %s, e.g. %s", b.backupBucketName, relocated[0])
+	}
+
+	output, err := serializeWithSegmentShards(backupInfo, b.params.BackupCfg.MaxSegmentsPerMetaFile)
+	if err != nil {
+		return err
+	}
+	summaryBytes, err := backupSummaryBytes(backupInfo)
+	if err != nil {
+		return err
+	}
+	metaFiles := append([]backupMetaFile{{SummaryPath(backupDir, baseName), summaryBytes}},
+		backupMetaFiles(backupDir, baseName, output)...)
+	for _, metaFile := range metaFiles {
+		err := retry.Do(ctx, func() error {
+			return b.getStorageClient().Write(ctx, b.backupBucketName, metaFile.path, metaFile.content)
+		}, retry.Attempts(uint(b.params.BackupCfg.MetaWriteRetryAttempts)), retry.Sleep(time.Second), retry.Jitter(b.params.BackupCfg.RetryJitter))
+		if err != nil {
+			return fmt.Errorf("fail to write backup meta file %s, err: %w", metaFile.path, err)
+		}
+	}
+	if err := b.removeStaleMetaFiles(ctx, BackupMetaDirPath(backupDir, baseName), metaFiles); err != nil {
+		log.Warn("backup is relocated but fail to remove the stale segment meta files", zap.String("name", name), zap.Error(err))
+	}
+	log.Info("finish RelocateBackup", zap.String("name", name), zap.Int("relocatedBinlogs", len(relocated)),
+		zap.String("milvusRootPath", backupInfo.GetMilvusRootPath()))
+	return nil
+}
+
+// removeStaleMetaFiles removes the serialized meta files in the meta dir of a backup not in the written ones, e.g. the
+// segment meta shards left by a layout with more shards. They are never read, as the backup meta file tells the shards to read.
+func (b *BackupContext) removeStaleMetaFiles(ctx context.Context, metaDir string, written []backupMetaFile) error {
+	keys, _, err := b.getStorageClient().ListWithPrefix(ctx, b.backupBucketName, metaDir+SEPERATOR, true)
+	if err != nil {
+		return err
+	}
+	writtenNames := lo.SliceToMap(written, func(metaFile backupMetaFile) (string, bool) {
+		return path.Base(metaFile.path), true
+	})
+	for _, key := range keys {
+		if isSerializedMetaFile(path.Base(key)) && !writtenNames[path.Base(key)] {
+			if err := b.getStorageClient().Remove(ctx, b.backupBucketName, key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+func TestRelocateBackup(t *testing.T) {
+	ctx := context.Background()
+	b := newLocalBackupContext(t)
+	b.started = true
+	segment := func(id int64) *backuppb.SegmentBackupInfo {
+		return &backuppb.SegmentBackupInfo{CollectionId: 1, PartitionId: 2, SegmentId: id,
+			Binlogs: []*backuppb.FieldBinlog{{Binlogs: []*backuppb.Binlog{{LogPath: fmt.Sprintf("files/insert_log/1/2/%d/100/1", id)}}}}}
+	}
+	backupInfo := &backuppb.BackupInfo{
+		Id:             "backup-id",
+		Name:           "b1",
+		StateCode:      backuppb.BackupTaskStateCode_BACKUP_SUCCESS,
+		MilvusRootPath: "files",
+		CollectionBackups: []*backuppb.CollectionBackupInfo{{
+			Id:           "backup-id",
+			CollectionId: 1,
+			PartitionBackups: []*backuppb.PartitionBackupInfo{{
+				CollectionId:   1,
+				PartitionId:    2,
+				SegmentBackups: []*backuppb.SegmentBackupInfo{segment(3), segment(4)},
+			}},
+		}},
+	}
+	// written in shards of one segment
+	output, err := serializeWithSegmentShards(backupInfo, 1)
+	assert.NoError(t, err)
+	for _, metaFile := range backupMetaFiles(b.backupRootPath, "b1", output) {
+		assert.NoError(t, b.getStorageClient().Write(ctx, b.backupBucketName, metaFile.path, metaFile.content))
+	}
+
+	// the backup objects of the relocated binlogs must exist
+	assert.ErrorContains(t, b.RelocateBackup(ctx, "b1", "files", "data/files"), "none of the relocated binlogs exists")
+	assert.ErrorContains(t, b.RelocateBackup(ctx, "b1", "file", "data/files"), "no binlog path")
+	binlogPath := BackupBinlogDirPath(b.backupRootPath, "b1") + "/insert_log/1/2/3/100/1"
+	assert.NoError(t, b.getStorageClient().Write(ctx, b.backupBucketName, binlogPath, []byte("binlog")))
+	assert.NoError(t, b.RelocateBackup(ctx, "b1", "files", "data/files"))
+
+	relocated, err := b.readBackup(ctx, b.backupBucketName, BackupPath(b.backupRootPath, "b1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "data/files", relocated.GetMilvusRootPath())
+	segments := relocated.GetCollectionBackups()[0].GetPartitionBackups()[0].GetSegmentBackups()
+	assert.Len(t, segments, 2)
+	assert.Equal(t, "data/files/insert_log/1/2/3/100/1", segments[0].GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+	// rewritten without shards, the old shards are removed
+	for i := 0; i < 2; i++ {
+		exist, err := b.getStorageClient().Exist(ctx, b.backupBucketName, SegmentMetaShardPath(b.backupRootPath, "b1", i))
+		assert.NoError(t, err)
+		assert.False(t, exist)
+	}
+}
+
+func TestRelocateBinlogPaths(t *testing.T) {
+	backup := &backuppb.BackupInfo{
+		MilvusRootPath: "files",
+		CollectionBackups: []*backuppb.CollectionBackupInfo{{
+			PartitionBackups: []*backuppb.PartitionBackupInfo{{
+				SegmentBackups: []*backuppb.SegmentBackupInfo{{
+					Binlogs:   []*backuppb.FieldBinlog{{Binlogs: []*backuppb.Binlog{{LogPath: "files/insert_log/1/2/3/100/1"}}}},
+					Deltalogs: []*backuppb.FieldBinlog{{Binlogs: []*backuppb.Binlog{{LogPath: "files2/delta_log/1/2/3/100/1"}}}},
+				}},
+			}},
+			L0Segments: []*backuppb.SegmentBackupInfo{{
+				Deltalogs: []*backuppb.FieldBinlog{{Binlogs: []*backuppb.Binlog{{LogPath: "files/delta_log/1/-1/4/100/1"}}}},
+			}},
+		}},
+	}
+	relocated := relocateBinlogPaths(backup, "files/", "data/files", "backup/b1")
+	assert.Equal(t, []string{"backup/b1/binlogs/insert_log/1/2/3/100/1", "backup/b1/binlogs/delta_log/1/-1/4/100/1"}, relocated)
+	assert.Equal(t, "data/files", backup.GetMilvusRootPath())
+	segment := backup.GetCollectionBackups()[0].GetPartitionBackups()[0].GetSegmentBackups()[0]
+	assert.Equal(t, "data/files/insert_log/1/2/3/100/1", segment.GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+	// only whole path elements are replaced
+	assert.Equal(t, "files2/delta_log/1/2/3/100/1", segment.GetDeltalogs()[0].GetBinlogs()[0].GetLogPath())
+
+	relocated = relocateBinlogPaths(backup, "data/files", "", "backup/b1")
+	assert.Equal(t, []string{"backup/b1/binlogs/insert_log/1/2/3/100/1", "backup/b1/binlogs/delta_log/1/-1/4/100/1"}, relocated)
+	assert.Equal(t, "", backup.GetMilvusRootPath())
+	assert.Equal(t, "insert_log/1/2/3/100/1", segment.GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+}
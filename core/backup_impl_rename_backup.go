@@ -0,0 +1,136 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zilliztech/milvus-backup/core/utils"
+	"github.com/zilliztech/milvus-backup/internal/log"
+	"github.com/zilliztech/milvus-backup/internal/util/retry"
+)
+
+// RenameBackup moves a backup to a new name in the backup root path.
+// All the objects but the meta are copied first and the meta is rewritten with the new name, the backup meta file last,
+// so the new backup is only readable once complete. The old backup is removed after that,
+// a crash in between leaves either an orphan new backup or both backups, never none.
+func (b *BackupContext) RenameBackup(ctx context.Context, oldName, newName string) error {
+	log.Info("receive RenameBackup", zap.String("oldName", oldName), zap.String("newName", newName))
+	if !b.started {
+		err := b.Start()
+		if err != nil {
+			return err
+		}
+	}
+	if b.params.BackupCfg.ReadOnly {
+		return ErrReadOnly
+	}
+	if oldName == "" {
+		return errors.New("empty backup name")
+	}
+	// the new name would have to choose between the dir of the old backup and the backup root path
+	if b.nestedLayout() && strings.Contains(oldName, SEPERATOR) {
+		return fmt.Errorf("rename of backup %s in a subdirectory is not supported, move its dir instead", oldName)
+	}
+	if err := utils.ValidateType(newName, BACKUP_NAME); err != nil {
+		return err
+	}
+	if oldName == newName {
+		return fmt.Errorf("backup is already named %s", newName)
+	}
+	if b.meta.IsBackupInProgress(oldName) {
+		return fmt.Errorf("backup %s is in progress", oldName)
+	}
+	// the segments reused by incremental backups are found by the name of the base
+	if err := b.checkNoIncrementalDependents(ctx, oldName); err != nil {
+		return err
+	}
+
+	exist, err := b.getStorageClient().Exist(ctx, b.backupBucketName, BackupMetaPath(b.backupRootPath, oldName))
+	if err != nil {
+		return fmt.Errorf("fail to check backup %s exist, err: %w", oldName, err)
+	}
+	if !exist {
+		return fmt.Errorf("backup %s not exist or not complete", oldName)
+	}
+	// any object under the new name is taken as a backup, even a partial one
+	newKeys, _, err := b.getStorageClient().ListWithPrefix(ctx, b.backupBucketName, BackupDirPath(b.backupRootPath, newName), true)
+	if err != nil {
+		return fmt.Errorf("fail to check backup %s exist, err: %w", newName, err)
+	}
+	if len(newKeys) > 0 {
+		return fmt.Errorf("backup already exist with the name: %s", newName)
+	}
+
+	backupInfo, err := b.readBackup(ctx, b.backupBucketName, BackupPath(b.backupRootPath, oldName))
+	if err != nil {
+		return fmt.Errorf("fail to read backup %s, err: %w", oldName, err)
+	}
+	backupInfo.Name = newName
+	output, err := serializeWithSegmentShards(backupInfo, b.params.BackupCfg.MaxSegmentsPerMetaFile)
+	if err != nil {
+		return err
+	}
+
+	// binlog paths in the meta are the ones of milvus, the backup binlog dir is resolved by the backup name.
+	// All the objects of the backup are copied but the meta files rewritten with the new name
+	oldPath, newPath := BackupPath(b.backupRootPath, oldName), BackupPath(b.backupRootPath, newName)
+	if err := b.copyBackupObjects(ctx, oldPath, newPath, false); err != nil {
+		return fmt.Errorf("fail to copy backup %s, err: %w", oldName, err)
+	}
+	metaKeys, _, err := b.getStorageClient().ListWithPrefix(ctx, b.backupBucketName, BackupMetaDirPath(b.backupRootPath, oldName)+SEPERATOR, true)
+	if err != nil {
+		return fmt.Errorf("fail to list meta of backup %s, err: %w", oldName, err)
+	}
+	for _, key := range metaKeys {
+		if isSerializedMetaFile(path.Base(key)) {
+			continue
+		}
+		err := b.getStorageClient().Copy(ctx, b.backupBucketName, b.backupBucketName, key, strings.Replace(key, oldPath, newPath, 1))
+		if err != nil {
+			return fmt.Errorf("fail to copy %s, err: %w", key, err)
+		}
+	}
+
+	summaryBytes, err := backupSummaryBytes(backupInfo)
+	if err != nil {
+		return err
+	}
+
+	metaFiles := append([]backupMetaFile{{SummaryPath(b.backupRootPath, newName), summaryBytes}},
+		backupMetaFiles(b.backupRootPath, newName, output)...)
+	for _, metaFile := range metaFiles {
+		err := retry.Do(ctx, func() error {
+			return b.getStorageClient().Write(ctx, b.backupBucketName, metaFile.path, metaFile.content)
+		}, retry.Attempts(uint(b.params.BackupCfg.MetaWriteRetryAttempts)), retry.Sleep(time.Second), retry.Jitter(b.params.BackupCfg.RetryJitter))
+		if err != nil {
+			return fmt.Errorf("fail to write backup meta file %s, err: %w", metaFile.path, err)
+		}
+	}
+
+	if err := b.getStorageClient().RemoveWithPrefix(ctx, b.backupBucketName, oldPath+SEPERATOR); err != nil {
+		log.Warn("backup is renamed but fail to remove the old one, please delete it manually",
+			zap.String("oldName", oldName), zap.String("newName", newName), zap.Error(err))
+		return fmt.Errorf("backup is renamed to %s but fail to remove %s, err: %w", newName, oldName, err)
+	}
+	b.meta.RenameBackup(oldName, newName)
+	log.Info("finish RenameBackup", zap.String("oldName", oldName), zap.String("newName", newName))
+	return nil
+}
+
+// isSerializedMetaFile returns whether a file in the meta dir is written from the serialized backup info,
+// the other meta files like the channel checkpoints are kept as they are by a rename
+func isSerializedMetaFile(name string) bool {
+	switch name {
+	case BACKUP_META_FILE, COLLECTION_META_FILE, PARTITION_META_FILE, SEGMENT_META_FILE, FULL_META_FILE, SUMMARY_FILE:
+		return true
+	}
+	var shard int
+	_, err := fmt.Sscanf(name, SEGMENT_META_SHARD_FILE, &shard)
+	return err == nil
+}
@@ -0,0 +1,68 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+func TestRenameBackup(t *testing.T) {
+	ctx := context.Background()
+	b := newLocalBackupContext(t)
+	b.started = true
+	backupInfo := &backuppb.BackupInfo{
+		Id:        "backup-id",
+		Name:      "b1",
+		StateCode: backuppb.BackupTaskStateCode_BACKUP_SUCCESS,
+		CollectionBackups: []*backuppb.CollectionBackupInfo{{
+			Id:             "backup-id",
+			CollectionId:   1,
+			CollectionName: "coll",
+			PartitionBackups: []*backuppb.PartitionBackupInfo{{
+				CollectionId:   1,
+				PartitionId:    2,
+				SegmentBackups: []*backuppb.SegmentBackupInfo{{CollectionId: 1, PartitionId: 2, SegmentId: 3}},
+			}},
+		}},
+	}
+	writeLocalBackup(t, b, backupInfo)
+	files := map[string]string{
+		"binlogs/insert_log/1/2/3/4/5": "binlog",
+		"channel_position/cp.json":     "cp",
+		"meta/" + CP_META_FILE:         "channel cp",
+		"summary.json":                 "summary",
+	}
+	for file, content := range files {
+		assert.NoError(t, b.getStorageClient().Write(ctx, b.backupBucketName, BackupPath(b.backupRootPath, "b1")+SEPERATOR+file, []byte(content)))
+	}
+
+	assert.ErrorContains(t, b.RenameBackup(ctx, "b1", "b1"), "already named")
+	assert.Error(t, b.RenameBackup(ctx, "b2", "b3"))
+	assert.NoError(t, b.RenameBackup(ctx, "b1", "b2"))
+
+	renamed, err := b.readBackup(ctx, b.backupBucketName, BackupPath(b.backupRootPath, "b2"))
+	assert.NoError(t, err)
+	assert.Equal(t, "b2", renamed.GetName())
+	assert.Equal(t, "backup-id", renamed.GetId())
+	assert.Equal(t, int64(3), renamed.GetCollectionBackups()[0].GetPartitionBackups()[0].GetSegmentBackups()[0].GetSegmentId())
+	for file, content := range files {
+		data, err := b.getStorageClient().Read(ctx, b.backupBucketName, BackupPath(b.backupRootPath, "b2")+SEPERATOR+file)
+		assert.NoError(t, err, file)
+		assert.Equal(t, content, string(data))
+	}
+	exist, err := b.getStorageClient().Exist(ctx, b.backupBucketName, SummaryPath(b.backupRootPath, "b2"))
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	oldKeys, _, err := b.getStorageClient().ListWithPrefix(ctx, b.backupBucketName, BackupDirPath(b.backupRootPath, "b1"), true)
+	assert.NoError(t, err)
+	assert.Len(t, oldKeys, 0)
+
+	// and back
+	assert.NoError(t, b.RenameBackup(ctx, "b2", "b1"))
+	renamed, err = b.readBackup(ctx, b.backupBucketName, BackupPath(b.backupRootPath, "b1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "b1", renamed.GetName())
+}
@@ -3,11 +3,16 @@ package core
 import (
 	"context"
 	"fmt"
+	"path"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	jsoniter "github.com/json-iterator/go"
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	gomilvus "github.com/milvus-io/milvus-sdk-go/v2/client"
 	"github.com/milvus-io/milvus-sdk-go/v2/entity"
 	"github.com/samber/lo"
@@ -41,7 +46,21 @@ func (b *BackupContext) RestoreBackup(ctx context.Context, request *backuppb.Res
 		zap.String("bucketName", request.GetBucketName()),
 		zap.String("path", request.GetPath()),
 		zap.String("databaseCollections", utils.GetRestoreDBCollections(request)),
-		zap.Bool("skipDiskQuotaCheck", request.GetSkipImportDiskQuotaCheck()))
+		zap.Bool("skipDiskQuotaCheck", request.GetSkipImportDiskQuotaCheck()),
+		zap.Bool("continueOnError", request.GetContinueOnError()),
+		zap.Any("indexOverrides", request.GetIndexOverrides()),
+		zap.Bool("checkPrivileges", request.GetCheckPrivileges()),
+		zap.Int64("timeoutSeconds", request.GetTimeoutSeconds()),
+		zap.Bool("restoreDatabases", request.GetRestoreDatabases()),
+		zap.Bool("createMissingDatabase", request.GetCreateMissingDatabase()),
+		zap.Bool("autoReloadPreviouslyLoaded", request.GetAutoReloadPreviouslyLoaded()),
+		zap.Bool("loadRestoredPartitionsOnly", request.GetLoadRestoredPartitionsOnly()),
+		zap.Bool("buildIndexBeforeImport", request.GetBuildIndexBeforeImport()),
+		zap.Bool("deltaOnly", request.GetDeltaOnly()),
+		zap.Bool("sanitizeCollectionNames", request.GetSanitizeCollectionNames()),
+		zap.Bool("restoreAliases", request.GetRestoreAliases()),
+		zap.String("latestNamePattern", request.GetLatestNamePattern()),
+		zap.Bool("restoreCollectionProperties", request.GetRestoreCollectionProperties()))
 
 	resp := &backuppb.RestoreBackupResponse{
 		RequestId: request.GetRequestId(),
@@ -55,6 +74,11 @@ func (b *BackupContext) RestoreBackup(ctx context.Context, request *backuppb.Res
 			return resp
 		}
 	}
+	if b.params.BackupCfg.ReadOnly {
+		resp.Code = backuppb.ResponseCode_No_Permission
+		resp.Msg = ErrReadOnly.Error()
+		return resp
+	}
 
 	// 1, get and validate
 	if request.GetCollectionSuffix() != "" {
@@ -67,6 +91,65 @@ func (b *BackupContext) RestoreBackup(ctx context.Context, request *backuppb.Res
 		}
 	}
 
+	if len(request.GetIndexOverrides()) > 0 && !request.GetRestoreIndex() {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = "index_overrides only works with restoreIndex"
+		return resp
+	}
+
+	if request.GetBuildIndexBeforeImport() && !request.GetRestoreIndex() {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = "build_index_before_import only works with restoreIndex"
+		return resp
+	}
+
+	if request.GetLoadRestoredPartitionsOnly() && !request.GetAutoReloadPreviouslyLoaded() {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = "load_restored_partitions_only only works with auto_reload_previously_loaded"
+		return resp
+	}
+
+	if _, err := restoreDynamicField(false, request.GetDynamicField()); err != nil {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = err.Error()
+		return resp
+	}
+	if request.GetDynamicField() != DynamicFieldKeep && request.GetSkipCreateCollection() {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = "dynamic_field only works when the collections are created by the restore, without skipCreateCollection"
+		return resp
+	}
+
+	if err := checkExistingCollection("", 0, request.GetExistingCollectionPolicy()); err != nil {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = err.Error()
+		return resp
+	}
+	if request.GetExistingCollectionPolicy() != ExistingCollectionAppend && (!request.GetSkipCreateCollection() || request.GetDropExistCollection()) {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = "existing_collection_policy only works with skipCreateCollection into existing collections, without dropExistCollection"
+		return resp
+	}
+
+	if request.GetDeltaOnly() && (!request.GetSkipCreateCollection() || request.GetDropExistCollection() || request.GetMetaOnly()) {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = "delta_only only works with skipCreateCollection into existing collections, without dropExistCollection and metaOnly"
+		return resp
+	}
+
+	if request.GetBackupName() == LatestBackupName {
+		if err := b.resolveLatestBackup(ctx, request); err != nil {
+			log.Error("fail to resolve the latest backup", zap.Error(err))
+			resp.Code = backuppb.ResponseCode_Parameter_Error
+			resp.Msg = err.Error()
+			return resp
+		}
+	} else if request.GetLatestNamePattern() != "" {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = "latest_name_pattern only works with the backup name latest"
+		return resp
+	}
+
 	getResp := b.GetBackup(ctx, &backuppb.GetBackupRequest{
 		BackupName: request.GetBackupName(),
 		BucketName: request.GetBucketName(),
@@ -77,10 +160,10 @@ func (b *BackupContext) RestoreBackup(ctx context.Context, request *backuppb.Res
 	var backupPath string
 	if request.GetBucketName() == "" || request.GetPath() == "" {
 		backupBucketName = b.backupBucketName
-		backupPath = b.backupRootPath + SEPERATOR + request.GetBackupName()
+		backupPath = b.backupPathOf(request.GetBackupName())
 	} else {
 		backupBucketName = request.GetBucketName()
-		backupPath = request.GetPath() + SEPERATOR + request.GetBackupName()
+		backupPath = BackupPath(request.GetPath(), request.GetBackupName())
 	}
 
 	if getResp.GetCode() != backuppb.ResponseCode_Success {
@@ -99,6 +182,33 @@ func (b *BackupContext) RestoreBackup(ctx context.Context, request *backuppb.Res
 	}
 
 	backup := getResp.GetData()
+	// binlogs in backup are stored without the source milvus rootPath, so the restore paths only
+	// depend on the target milvus config, the source rootPath is only recorded for reference
+	log.Info("milvus rootPath of backup and restore target",
+		zap.String("sourceRootPath", backup.GetMilvusRootPath()),
+		zap.String("targetRootPath", b.milvusRootPath))
+	// only insert and delta logs are imported, a backup without delta logs imports insert logs only
+	if !hasBinlogType(backup.GetBinlogTypes(), BINLOG_TYPE_DELTA) {
+		if request.GetDeltaOnly() {
+			resp.Code = backuppb.ResponseCode_Parameter_Error
+			resp.Msg = "backup has no delta logs to restore"
+			return resp
+		}
+		log.Info("backup has no delta logs, deleted data before backup may be restored", zap.Strings("binlogTypes", backup.GetBinlogTypes()))
+	}
+	if request.GetDeltaOnly() && backup.GetSchemaTemplateOnly() {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = "backup is a schema template without delta logs"
+		return resp
+	}
+	if backup.GetBaseBackupName() != "" && !request.GetMetaOnly() {
+		if err := b.checkBaseBackupsExist(ctx, request, backup); err != nil {
+			log.Error("fail to restore the incremental backup", zap.Error(err))
+			resp.Code = backuppb.ResponseCode_Fail
+			resp.Msg = err.Error()
+			return resp
+		}
+	}
 
 	var taskID string
 	if request.GetId() != "" {
@@ -107,11 +217,24 @@ func (b *BackupContext) RestoreBackup(ctx context.Context, request *backuppb.Res
 		taskID = "restore_" + fmt.Sprint(time.Now().UTC().Format("2006_01_02_15_04_05_")) + fmt.Sprint(time.Now().Nanosecond())
 	}
 
+	// staging is only needed when the data has to be copied into milvus bucket
+	if !request.GetMetaOnly() && !backup.GetSchemaTemplateOnly() && b.milvusBucketName != backupBucketName {
+		err := b.checkRestoreStagingWritable(ctx, taskID)
+		if err != nil {
+			errorMsg := fmt.Sprintf("restore staging location is not writable, bucket: %s, path: %s, err: %s", b.milvusBucketName, b.restoreStagingDir(taskID), err)
+			log.Error(errorMsg)
+			resp.Code = backuppb.ResponseCode_Fail
+			resp.Msg = errorMsg
+			return resp
+		}
+	}
+
 	task := &backuppb.RestoreBackupTask{
-		Id:        taskID,
-		StateCode: backuppb.RestoreTaskStateCode_INITIAL,
-		StartTime: time.Now().Unix(),
-		Progress:  0,
+		Id:         taskID,
+		StateCode:  backuppb.RestoreTaskStateCode_INITIAL,
+		StartTime:  time.Now().Unix(),
+		Progress:   0,
+		BackupName: backup.GetName(),
 	}
 	// clean thread pool
 	defer func() {
@@ -120,10 +243,12 @@ func (b *BackupContext) RestoreBackup(ctx context.Context, request *backuppb.Res
 
 	// 2, initial restoreCollectionTasks
 	toRestoreCollectionBackups := make([]*backuppb.CollectionBackupInfo, 0)
+	// shards num overrides of db_collections by db.collection of the backup
+	shardsNums := make(map[string]int32)
 
 	dbCollectionsStr := utils.GetRestoreDBCollections(request)
 	if dbCollectionsStr != "" {
-		var dbCollections DbCollections
+		var dbCollections RestoreDbCollections
 		err := jsoniter.UnmarshalFromString(dbCollectionsStr, &dbCollections)
 		if err != nil {
 			log.Error("fail in unmarshal dbCollections in RestoreBackupRequest", zap.String("dbCollections", dbCollectionsStr), zap.Error(err))
@@ -145,11 +270,21 @@ func (b *BackupContext) RestoreBackup(ctx context.Context, request *backuppb.Res
 				}
 			} else {
 				for _, coll := range collections {
+					if err := validateShardsNum(coll.ShardsNum, b.params.BackupCfg.RestoreMaxShardsNum); err != nil {
+						errorMsg := fmt.Sprintf("invalid db_collections, collection: %s.%s, err: %s", db, coll.Name, err)
+						log.Error(errorMsg)
+						resp.Code = backuppb.ResponseCode_Parameter_Error
+						resp.Msg = errorMsg
+						return resp
+					}
+					if coll.ShardsNum > 0 {
+						shardsNums[db+"."+coll.Name] = coll.ShardsNum
+					}
 					for _, collectionBackup := range backup.GetCollectionBackups() {
 						if collectionBackup.GetDbName() == "" {
 							collectionBackup.DbName = "default"
 						}
-						if collectionBackup.GetDbName() == db && collectionBackup.CollectionName == coll {
+						if collectionBackup.GetDbName() == db && collectionBackup.CollectionName == coll.Name {
 							toRestoreCollectionBackups = append(toRestoreCollectionBackups, collectionBackup)
 						}
 					}
@@ -166,13 +301,7 @@ func (b *BackupContext) RestoreBackup(ctx context.Context, request *backuppb.Res
 	} else {
 		collectionNameDict := make(map[string]bool)
 		for _, collectionName := range request.GetCollectionNames() {
-			var fullCollectionName string
-			if strings.Contains(collectionName, ".") {
-				fullCollectionName = collectionName
-			} else {
-				fullCollectionName = "default." + collectionName
-			}
-			collectionNameDict[fullCollectionName] = true
+			collectionNameDict[FullCollectionName(collectionName, b.params.BackupCfg.DefaultDatabase)] = true
 		}
 		for _, collectionBackup := range backup.GetCollectionBackups() {
 			if collectionBackup.GetDbName() == "" {
@@ -194,22 +323,39 @@ func (b *BackupContext) RestoreBackup(ctx context.Context, request *backuppb.Res
 			dbRenames[strings.Split(oldname, ".*")[0]] = strings.Split(newName, ".*")[0]
 		}
 
-		var fullCollectionName string
-		if strings.Contains(oldname, ".") {
-			fullCollectionName = oldname
-		} else {
-			fullCollectionName = "default." + oldname
+		collectionRenames[FullCollectionName(oldname, b.params.BackupCfg.DefaultDatabase)] = FullCollectionName(newName, b.params.BackupCfg.DefaultDatabase)
+	}
+
+	if request.GetRestoreDatabases() {
+		if len(backup.GetDatabaseBackups()) == 0 {
+			errorMsg := "backup has no databases to restore, create the backup with backup_databases"
+			log.Error(errorMsg)
+			resp.Code = backuppb.ResponseCode_Parameter_Error
+			resp.Msg = errorMsg
+			return resp
 		}
-		var fullCollectionNewName string
-		if strings.Contains(newName, ".") {
-			fullCollectionNewName = newName
-		} else {
-			fullCollectionNewName = "default." + newName
+		err := b.restoreDatabases(ctx, backup.GetDatabaseBackups(), dbRenames)
+		if err != nil {
+			log.Error("fail to restore databases", zap.Error(err))
+			resp.Code = backuppb.ResponseCode_Fail
+			resp.Msg = err.Error()
+			return resp
 		}
-		collectionRenames[fullCollectionName] = fullCollectionNewName
 	}
 
+	dbs, err := b.getMilvusClient().ListDatabases(ctx)
+	if err != nil {
+		errorMsg := fmt.Sprintf("fail to list databases, err: %s", err)
+		log.Error(errorMsg)
+		resp.Code = backuppb.ResponseCode_Fail
+		resp.Msg = errorMsg
+		return resp
+	}
+	existDBs := lo.Map(dbs, func(db entity.Database, _ int) string { return db.Name })
+
 	restoreCollectionTasks := make([]*backuppb.RestoreCollectionTask, 0)
+	// target db.collection -> backup db.collection, renames, suffixes and sanitized names may map two collections to one
+	targetCollections := make(map[string]string)
 	for _, restoreCollection := range toRestoreCollectionBackups {
 		backupDBCollectionName := restoreCollection.DbName + "." + restoreCollection.GetSchema().GetName()
 		targetDBName := restoreCollection.DbName
@@ -224,34 +370,54 @@ func (b *BackupContext) RestoreBackup(ctx context.Context, request *backuppb.Res
 		} else if request.GetCollectionSuffix() != "" {
 			targetCollectionName = targetCollectionName + request.GetCollectionSuffix()
 		}
+		if request.GetSanitizeCollectionNames() && utils.ValidateType(targetCollectionName, COLLECTION_NAME) != nil {
+			sanitizedName := utils.SanitizeName(targetCollectionName)
+			sanitizedDBCollectionName := targetDBName + "." + sanitizedName
+			log.Warn("sanitize invalid target collection name",
+				zap.String("targetCollectionName", targetDBName+"."+targetCollectionName),
+				zap.String("sanitizedCollectionName", sanitizedDBCollectionName))
+			if task.SanitizedCollectionNames == nil {
+				task.SanitizedCollectionNames = make(map[string]string)
+			}
+			task.SanitizedCollectionNames[targetDBName+"."+targetCollectionName] = sanitizedDBCollectionName
+			targetCollectionName = sanitizedName
+		}
 		targetDBCollectionName := targetDBName + "." + targetCollectionName
-
-		// check if the database exist, if not, create it first
-		dbs, err := b.getMilvusClient().ListDatabases(ctx)
-		if err != nil {
-			errorMsg := fmt.Sprintf("fail to list databases, err: %s", err)
+		if other, ok := targetCollections[targetDBCollectionName]; ok {
+			errorMsg := fmt.Sprintf("collections %s and %s are both restored to %s, rename one of them", other, backupDBCollectionName, targetDBCollectionName)
 			log.Error(errorMsg)
-			resp.Code = backuppb.ResponseCode_Fail
+			resp.Code = backuppb.ResponseCode_Parameter_Error
 			resp.Msg = errorMsg
 			return resp
 		}
-		var hasDatabase = false
-		for _, db := range dbs {
-			if db.Name == targetDBName {
-				hasDatabase = true
-				break
-			}
+		targetCollections[targetDBCollectionName] = backupDBCollectionName
+
+		// the dynamic field added by the restore has no binlogs in the backup, so the data can't be imported
+		if request.GetDynamicField() == DynamicFieldEnable && !restoreCollection.GetSchema().GetEnableDynamicField() &&
+			!request.GetMetaOnly() && !backup.GetSchemaTemplateOnly() && hasSegmentBackups(restoreCollection) {
+			errorMsg := fmt.Sprintf("collection %s of the backup has no dynamic field and has data, dynamic_field %s only works with metaOnly",
+				backupDBCollectionName, DynamicFieldEnable)
+			log.Error(errorMsg)
+			resp.Code = backuppb.ResponseCode_Parameter_Error
+			resp.Msg = errorMsg
+			return resp
 		}
-		if !hasDatabase {
-			err := b.getMilvusClient().CreateDatabase(ctx, targetDBName)
-			if err != nil {
-				errorMsg := fmt.Sprintf("fail to create database %s, err: %s", targetDBName, err)
+
+		if !lo.Contains(existDBs, targetDBName) {
+			if !request.GetCreateMissingDatabase() {
+				errorMsg := fmt.Sprintf("target database %s of collection %s does not exist, set create_missing_database to create it", targetDBName, backupDBCollectionName)
 				log.Error(errorMsg)
-				resp.Code = backuppb.ResponseCode_Fail
+				resp.Code = backuppb.ResponseCode_Parameter_Error
 				resp.Msg = errorMsg
 				return resp
 			}
-			log.Info("create database", zap.String("database", targetDBName))
+			if err := b.createMissingDatabase(ctx, targetDBName); err != nil {
+				log.Error("fail to create database", zap.String("database", targetDBName), zap.Error(err))
+				resp.Code = backuppb.ResponseCode_Fail
+				resp.Msg = err.Error()
+				return resp
+			}
+			existDBs = append(existDBs, targetDBName)
 		}
 
 		// check if the collection exist, if exist, will not restore
@@ -271,43 +437,150 @@ func (b *BackupContext) RestoreBackup(ctx context.Context, request *backuppb.Res
 				resp.Msg = errorMsg
 				return resp
 			}
+		} else if !request.GetDropExistCollection() {
+			// the collection is only created with dropExistCollection, an empty existing collection just gets the data imported
+			exist, err := b.getMilvusClient().HasCollection(ctx, targetDBName, targetCollectionName)
+			if err != nil {
+				errorMsg := fmt.Sprintf("fail to check whether the collection is exist, collection_name: %s, err: %s", targetDBCollectionName, err)
+				log.Error(errorMsg)
+				resp.Code = backuppb.ResponseCode_Fail
+				resp.Msg = errorMsg
+				return resp
+			}
+			if !exist {
+				errorMsg := fmt.Sprintf("The collection to restore into doesn't exist, skipCreateCollection needs an existing collection, backupCollectName: %s, targetCollectionName: %s", backupDBCollectionName, targetDBCollectionName)
+				log.Error(errorMsg)
+				resp.Code = backuppb.ResponseCode_Parameter_Error
+				resp.Msg = errorMsg
+				return resp
+			}
+			if request.GetExistingCollectionPolicy() != ExistingCollectionAppend {
+				collectionStats, err := b.getMilvusClient().GetCollectionStatistics(ctx, targetDBName, targetCollectionName)
+				if err != nil {
+					errorMsg := fmt.Sprintf("fail to get the row count of the existing collection, collection_name: %s, err: %s", targetDBCollectionName, err)
+					log.Error(errorMsg)
+					resp.Code = backuppb.ResponseCode_Fail
+					resp.Msg = errorMsg
+					return resp
+				}
+				rowCount, err := strconv.ParseInt(collectionStats["row_count"], 10, 64)
+				if err != nil {
+					errorMsg := fmt.Sprintf("fail to parse the row count of the existing collection, collection_name: %s, stats: %v", targetDBCollectionName, collectionStats)
+					log.Error(errorMsg)
+					resp.Code = backuppb.ResponseCode_Fail
+					resp.Msg = errorMsg
+					return resp
+				}
+				if err := checkExistingCollection(targetDBCollectionName, rowCount, request.GetExistingCollectionPolicy()); err != nil {
+					log.Error("fail to restore into the existing collection", zap.Error(err))
+					resp.Code = backuppb.ResponseCode_Parameter_Error
+					resp.Msg = err.Error()
+					return resp
+				}
+			}
+			log.Info("restore into the existing collection", zap.String("targetCollectionName", targetDBCollectionName))
 		} else {
 			log.Info("skip check collection exist")
 		}
 
+		if err := checkVectorTransformers(restoreCollection, request.GetVectorTransformers(), b.params.BackupCfg.DefaultDatabase); err != nil {
+			errorMsg := fmt.Sprintf("invalid vector transformer, backupCollectName: %s, err: %s", backupDBCollectionName, err)
+			log.Error(errorMsg)
+			resp.Code = backuppb.ResponseCode_Parameter_Error
+			resp.Msg = errorMsg
+			return resp
+		}
+
+		indexOverrides, err := matchIndexOverrides(restoreCollection, request.GetIndexOverrides(), b.params.BackupCfg.DefaultDatabase)
+		if err != nil {
+			errorMsg := fmt.Sprintf("invalid index override, backupCollectName: %s, err: %s", backupDBCollectionName, err)
+			log.Error(errorMsg)
+			resp.Code = backuppb.ResponseCode_Parameter_Error
+			resp.Msg = errorMsg
+			return resp
+		}
+
 		var toRestoreSize int64 = 0
+		partitionRestoreTasks := make([]*backuppb.RestorePartitionTask, 0, len(restoreCollection.GetPartitionBackups()))
 		for _, partitionBackup := range restoreCollection.GetPartitionBackups() {
 			toRestoreSize += partitionBackup.GetSize()
+			// part_backup is not set to keep the task small, the segments are in coll_backup
+			partitionRestoreTasks = append(partitionRestoreTasks, &backuppb.RestorePartitionTask{
+				Id:            utils.UUID(),
+				StateCode:     backuppb.RestoreTaskStateCode_INITIAL,
+				PartitionName: partitionBackup.GetPartitionName(),
+				PartitionId:   partitionBackup.GetPartitionId(),
+				ToRestoreSize: partitionBackup.GetSize(),
+			})
 		}
 		id := utils.UUID()
 
 		restoreCollectionTask := &backuppb.RestoreCollectionTask{
-			Id:                    id,
-			StateCode:             backuppb.RestoreTaskStateCode_INITIAL,
-			StartTime:             time.Now().Unix(),
-			CollBackup:            restoreCollection,
-			TargetDbName:          targetDBName,
-			TargetCollectionName:  targetCollectionName,
-			PartitionRestoreTasks: []*backuppb.RestorePartitionTask{},
-			ToRestoreSize:         toRestoreSize,
-			RestoredSize:          0,
-			Progress:              0,
-			MetaOnly:              request.GetMetaOnly(),
-			RestoreIndex:          request.GetRestoreIndex(),
-			UseAutoIndex:          request.GetUseAutoIndex(),
-			DropExistCollection:   request.GetDropExistCollection(),
-			DropExistIndex:        request.GetDropExistIndex(),
-			SkipCreateCollection:  request.GetSkipCreateCollection(),
-			SkipDiskQuotaCheck:    request.GetSkipImportDiskQuotaCheck(),
+			Id:                          id,
+			StateCode:                   backuppb.RestoreTaskStateCode_INITIAL,
+			StartTime:                   time.Now().Unix(),
+			CollBackup:                  restoreCollection,
+			SourceDbName:                restoreCollection.GetDbName(),
+			SourceCollectionName:        restoreCollection.GetCollectionName(),
+			SourceCollectionId:          restoreCollection.GetCollectionId(),
+			TargetDbName:                targetDBName,
+			TargetCollectionName:        targetCollectionName,
+			PartitionRestoreTasks:       partitionRestoreTasks,
+			ToRestoreSize:               toRestoreSize,
+			RestoredSize:                0,
+			Progress:                    0,
+			MetaOnly:                    request.GetMetaOnly(),
+			RestoreIndex:                request.GetRestoreIndex(),
+			UseAutoIndex:                request.GetUseAutoIndex(),
+			DropExistCollection:         request.GetDropExistCollection(),
+			DropExistIndex:              request.GetDropExistIndex(),
+			SkipCreateCollection:        request.GetSkipCreateCollection(),
+			SkipDiskQuotaCheck:          request.GetSkipImportDiskQuotaCheck(),
+			IndexOverrides:              indexOverrides,
+			AutoReloadPreviouslyLoaded:  request.GetAutoReloadPreviouslyLoaded(),
+			LoadRestoredPartitionsOnly:  request.GetLoadRestoredPartitionsOnly(),
+			BuildIndexBeforeImport:      request.GetBuildIndexBeforeImport(),
+			DeltaOnly:                   request.GetDeltaOnly(),
+			RestoreAliases:              request.GetRestoreAliases(),
+			ShardsNum:                   shardsNums[backupDBCollectionName],
+			DynamicField:                request.GetDynamicField(),
+			RestoreCollectionProperties: request.GetRestoreCollectionProperties(),
 		}
 		restoreCollectionTasks = append(restoreCollectionTasks, restoreCollectionTask)
 		task.CollectionRestoreTasks = restoreCollectionTasks
 		task.ToRestoreSize = task.GetToRestoreSize() + toRestoreSize
 	}
+
+	// aliases of different collections may be restored into one database by the renames
+	if _, err := aliasesToRestore(task.GetCollectionRestoreTasks()); err != nil {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = err.Error()
+		return resp
+	}
+
+	if request.GetCheckPrivileges() {
+		err := b.checkRestorePrivileges(ctx, task.GetCollectionRestoreTasks())
+		if err != nil {
+			log.Error("privileges check of restore failed", zap.Error(err))
+			resp.Code = backuppb.ResponseCode_Fail
+			resp.Msg = err.Error()
+			return resp
+		}
+	}
+	if request.GetTimeoutSeconds() < 0 {
+		resp.Code = backuppb.ResponseCode_Parameter_Error
+		resp.Msg = "timeout can not be negative"
+		return resp
+	}
+	timeout := time.Duration(request.GetTimeoutSeconds()) * time.Second
+	if timeout == 0 {
+		timeout = time.Duration(b.params.BackupCfg.RestoreTimeoutSeconds) * time.Second
+	}
+
 	b.meta.AddRestoreTask(task)
 
 	if request.Async {
-		go b.executeRestoreBackupTask(ctx, backupBucketName, backupPath, backup, task)
+		go b.executeRestoreBackupTask(ctx, backupBucketName, backupPath, backup, task, request.GetContinueOnError(), timeout)
 		asyncResp := &backuppb.RestoreBackupResponse{
 			RequestId: request.GetRequestId(),
 			Code:      backuppb.ResponseCode_Success,
@@ -316,7 +589,7 @@ func (b *BackupContext) RestoreBackup(ctx context.Context, request *backuppb.Res
 		}
 		return asyncResp
 	} else {
-		endTask, err := b.executeRestoreBackupTask(ctx, backupBucketName, backupPath, backup, task)
+		endTask, err := b.executeRestoreBackupTask(ctx, backupBucketName, backupPath, backup, task, request.GetContinueOnError(), timeout)
 		resp.Data = endTask
 		if err != nil {
 			resp.Code = backuppb.ResponseCode_Fail
@@ -330,10 +603,44 @@ func (b *BackupContext) RestoreBackup(ctx context.Context, request *backuppb.Res
 	}
 }
 
-func (b *BackupContext) executeRestoreBackupTask(ctx context.Context, backupBucketName string, backupPath string, backup *backuppb.BackupInfo, task *backuppb.RestoreBackupTask) (*backuppb.RestoreBackupTask, error) {
+// resolveLatestBackup sets the backup name of the request to the latest backup matching latest_name_pattern
+// and containing the collections to restore, among the backups in the backup root path of the config
+func (b *BackupContext) resolveLatestBackup(ctx context.Context, request *backuppb.RestoreBackupRequest) error {
+	if request.GetBucketName() != "" && request.GetPath() != "" {
+		return errors.New("the latest backup only works in the backup root path of the config, without bucket_name and path")
+	}
+	listResp := b.ListBackups(ctx, &backuppb.ListBackupsRequest{})
+	if listResp.GetCode() != backuppb.ResponseCode_Success {
+		return fmt.Errorf("fail to list backups, err: %s", listResp.GetMsg())
+	}
+	latest, err := LatestBackup(listResp.GetData(), request.GetLatestNamePattern(), request.GetCollectionNames(), b.params.BackupCfg.DefaultDatabase)
+	if err != nil {
+		return err
+	}
+	if latest == nil {
+		return fmt.Errorf("no complete backup matches the name pattern %q and contains the collections %v", request.GetLatestNamePattern(), request.GetCollectionNames())
+	}
+	log.Info("resolved the latest backup to restore",
+		zap.String("backupName", latest.GetName()),
+		zap.Int64("startTime", latest.GetStartTime()),
+		zap.String("latestNamePattern", request.GetLatestNamePattern()))
+	request.BackupName = latest.GetName()
+	return nil
+}
+
+// executeRestoreBackupTask restores the collections, all the milvus requests and bulk insert polling stop when timeout
+func (b *BackupContext) executeRestoreBackupTask(ctx context.Context, backupBucketName string, backupPath string, backup *backuppb.BackupInfo, task *backuppb.RestoreBackupTask, continueOnError bool, timeout time.Duration) (*backuppb.RestoreBackupTask, error) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	// cleanup still works after the restore context is done
+	parentCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
 	wp, err := common.NewWorkerPool(ctx, b.params.BackupCfg.RestoreParallelism, RPS)
 	if err != nil {
 		return task, err
@@ -343,6 +650,11 @@ func (b *BackupContext) executeRestoreBackupTask(ctx context.Context, backupBuck
 
 	id := task.GetId()
 	b.meta.UpdateRestoreTask(id, setRestoreStateCode(backuppb.RestoreTaskStateCode_EXECUTING))
+	b.meta.AddEvent(id, EVENT_STATE, backuppb.RestoreTaskStateCode_EXECUTING.String())
+	defer func() {
+		restoreTask := b.meta.GetRestoreTask(id)
+		b.meta.AddEvent(id, EVENT_STATE, stateEventMessage(restoreTask.GetStateCode().String(), restoreTask.GetErrorMessage()))
+	}()
 	log.Info("executeRestoreBackupTask start",
 		zap.String("backup_name", backup.GetName()),
 		zap.String("backupBucketName", backupBucketName),
@@ -350,19 +662,56 @@ func (b *BackupContext) executeRestoreBackupTask(ctx context.Context, backupBuck
 
 	restoreCollectionTasks := task.GetCollectionRestoreTasks()
 
+	binlogCipher, err := b.backupCipher(backup)
+	if err != nil {
+		log.Error("fail to open the encryption of the backup", zap.String("backup_name", backup.GetName()), zap.Error(err))
+		b.meta.UpdateRestoreTask(id, setRestoreStateCode(backuppb.RestoreTaskStateCode_FAIL), setRestoreErrorMessage(err.Error()), setRestoreEndTime(time.Now().Unix()))
+		return task, err
+	}
+
+	// clean the staging dir of this restore
+	defer func() {
+		if (b.milvusBucketName != backupBucketName || binlogCipher != nil) && !b.params.BackupCfg.KeepTempFiles {
+			stagingDir := b.restoreStagingDir(id)
+			log.Info("Delete restore staging dir", zap.String("dir", stagingDir))
+			err := b.getStorageClient().RemoveWithPrefix(parentCtx, b.milvusBucketName, stagingDir)
+			if err != nil {
+				log.Warn("Delete restore staging dir failed", zap.Error(err))
+			}
+		}
+	}()
+
+	var failedMu sync.Mutex
+	failedCollections := make([]string, 0)
+
 	// 3, execute restoreCollectionTasks
 	for _, restoreCollectionTask := range restoreCollectionTasks {
 		restoreCollectionTaskClone := restoreCollectionTask
 		job := func(ctx context.Context) error {
-			endTask, err := b.executeRestoreCollectionTask(ctx, backupBucketName, backupPath, restoreCollectionTaskClone, id)
+			eventCollection := withEventCollection(restoreCollectionTaskClone.GetTargetDbName(), restoreCollectionTaskClone.GetTargetCollectionName())
+			b.meta.AddEvent(id, EVENT_COLLECTION_START, "start restore collection", eventCollection)
+			endTask, err := b.executeRestoreCollectionTask(ctx, backupBucketName, backupPath, backup.GetMilvusRootPath(), restoreCollectionTaskClone, id, binlogCipher)
 			if err != nil {
+				b.meta.AddEvent(id, EVENT_COLLECTION_FAIL, err.Error(), eventCollection)
 				log.Error("executeRestoreCollectionTask failed",
 					zap.String("TargetDBName", restoreCollectionTaskClone.GetTargetDbName()),
 					zap.String("TargetCollectionName", restoreCollectionTaskClone.GetTargetCollectionName()),
 					zap.Error(err))
-				return err
+				restoreCollectionTaskClone.StateCode = backuppb.RestoreTaskStateCode_FAIL
+				restoreCollectionTaskClone.ErrorMessage = err.Error()
+				b.meta.UpdateRestoreTask(id, setCollectionRestoreStateCode(restoreCollectionTaskClone.GetId(), backuppb.RestoreTaskStateCode_FAIL, err.Error()))
+				if !continueOnError {
+					return err
+				}
+				// record the failure and let the other collections go on
+				failedMu.Lock()
+				failedCollections = append(failedCollections, restoreCollectionTaskClone.GetTargetDbName()+"."+restoreCollectionTaskClone.GetTargetCollectionName())
+				failedMu.Unlock()
+				return nil
 			}
 			restoreCollectionTaskClone.StateCode = backuppb.RestoreTaskStateCode_SUCCESS
+			b.meta.UpdateRestoreTask(id, setCollectionRestoreStateCode(restoreCollectionTaskClone.GetId(), backuppb.RestoreTaskStateCode_SUCCESS, ""))
+			b.meta.AddEvent(id, EVENT_COLLECTION_FINISH, "finish restore collection", eventCollection)
 			log.Info("finish restore collection",
 				zap.String("db_name", restoreCollectionTaskClone.GetTargetDbName()),
 				zap.String("collection_name", restoreCollectionTaskClone.GetTargetCollectionName()),
@@ -372,15 +721,39 @@ func (b *BackupContext) executeRestoreBackupTask(ctx context.Context, backupBuck
 		wp.Submit(job)
 	}
 	wp.Done()
-	if err := wp.Wait(); err != nil {
+	err = wp.Wait()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		errorMsg := fmt.Sprintf("restore timeout after %s", timeout)
+		log.Error(errorMsg, zap.String("restoreId", id), zap.Error(err))
+		b.meta.UpdateRestoreTask(id, setRestoreStateCode(backuppb.RestoreTaskStateCode_TIMEOUT), setRestoreErrorMessage(errorMsg), setRestoreEndTime(time.Now().Unix()))
+		return task, errors.New(errorMsg)
+	}
+	if err != nil {
+		b.meta.UpdateRestoreTask(id, setRestoreStateCode(backuppb.RestoreTaskStateCode_FAIL), setRestoreErrorMessage(err.Error()), setRestoreEndTime(time.Now().Unix()))
+		return task, err
+	}
+
+	// aliases depend on their collections, so they are created after all the collections are restored
+	restoredTasks := lo.Filter(restoreCollectionTasks, func(collTask *backuppb.RestoreCollectionTask, _ int) bool {
+		return collTask.GetStateCode() == backuppb.RestoreTaskStateCode_SUCCESS
+	})
+	if err := b.restoreAliases(ctx, id, restoredTasks); err != nil {
+		b.meta.UpdateRestoreTask(id, setRestoreStateCode(backuppb.RestoreTaskStateCode_FAIL), setRestoreErrorMessage(err.Error()), setRestoreEndTime(time.Now().Unix()))
 		return task, err
 	}
 
+	if len(failedCollections) > 0 {
+		errorMsg := fmt.Sprintf("fail to restore %d of %d collections: %s", len(failedCollections), len(restoreCollectionTasks), strings.Join(failedCollections, ","))
+		log.Error(errorMsg, zap.String("restoreId", id))
+		b.meta.UpdateRestoreTask(id, setRestoreStateCode(backuppb.RestoreTaskStateCode_FAIL), setRestoreErrorMessage(errorMsg), setRestoreEndTime(time.Now().Unix()))
+		return task, errors.New(errorMsg)
+	}
+
 	b.meta.UpdateRestoreTask(id, setRestoreStateCode(backuppb.RestoreTaskStateCode_SUCCESS), setRestoreEndTime(time.Now().Unix()))
 	return task, nil
 }
 
-func (b *BackupContext) executeRestoreCollectionTask(ctx context.Context, backupBucketName string, backupPath string, task *backuppb.RestoreCollectionTask, parentTaskID string) (*backuppb.RestoreCollectionTask, error) {
+func (b *BackupContext) executeRestoreCollectionTask(ctx context.Context, backupBucketName string, backupPath string, milvusRootPath string, task *backuppb.RestoreCollectionTask, parentTaskID string, binlogCipher *binlogCipher) (*backuppb.RestoreCollectionTask, error) {
 	targetDBName := task.GetTargetDbName()
 	targetCollectionName := task.GetTargetCollectionName()
 	task.StateCode = backuppb.RestoreTaskStateCode_EXECUTING
@@ -394,9 +767,23 @@ func (b *BackupContext) executeRestoreCollectionTask(ctx context.Context, backup
 		zap.String("backupBucketName", backupBucketName),
 		zap.String("backupPath", backupPath))
 	// create collection
+	backupDynamicField := task.GetCollBackup().GetSchema().GetEnableDynamicField()
+	enableDynamicField, err := restoreDynamicField(backupDynamicField, task.GetDynamicField())
+	if err != nil {
+		task.StateCode = backuppb.RestoreTaskStateCode_FAIL
+		task.ErrorMessage = err.Error()
+		return task, err
+	}
+	if backupDynamicField && !enableDynamicField && !task.GetMetaOnly() {
+		log.Warn("restore collection without the dynamic field, the dynamic data of the backup is not restored")
+	}
 	fields := make([]*entity.Field, 0)
 	hasPartitionKey := false
 	for _, field := range task.GetCollBackup().GetSchema().GetFields() {
+		// the binlogs of the dynamic field are left out by the import if the collection has no dynamic field
+		if field.GetIsDynamic() && !enableDynamicField {
+			continue
+		}
 		fields = append(fields, &entity.Field{
 			ID:             field.GetFieldID(),
 			Name:           field.GetName(),
@@ -422,7 +809,7 @@ func (b *BackupContext) executeRestoreCollectionTask(ctx context.Context, backup
 		Description:        task.GetCollBackup().GetSchema().GetDescription(),
 		AutoID:             task.GetCollBackup().GetSchema().GetAutoID(),
 		Fields:             fields,
-		EnableDynamicField: task.GetCollBackup().GetSchema().GetEnableDynamicField(),
+		EnableDynamicField: enableDynamicField,
 	}
 
 	if task.GetDropExistCollection() {
@@ -447,23 +834,54 @@ func (b *BackupContext) executeRestoreCollectionTask(ctx context.Context, backup
 	//the SkipCreateCollection has been checked,
 	//so here it is necessary to be compatible with the situation where SkipCreateCollection and DropExistCollection are enabled at the same time.
 	if !task.GetSkipCreateCollection() || task.GetDropExistCollection() {
+		consistencyLevel := entity.ConsistencyLevel(task.GetCollBackup().GetConsistencyLevel())
+		createOpts := []gomilvus.CreateCollectionOption{
+			gomilvus.WithConsistencyLevel(consistencyLevel),
+		}
+		var partitionNum int64
+		if hasPartitionKey {
+			// backups before num_partitions was recorded use the number of the partitions
+			partitionNum = task.GetCollBackup().GetNumPartitions()
+			if partitionNum <= 0 {
+				partitionNum = int64(len(task.GetCollBackup().GetPartitionBackups()))
+			}
+			createOpts = append(createOpts, gomilvus.WithPartitionNum(partitionNum))
+		}
+		properties := make(map[string]string)
+		if task.GetRestoreCollectionProperties() {
+			for key, value := range task.GetCollBackup().GetProperties() {
+				properties[key] = value
+				createOpts = append(createOpts, gomilvus.WithCollectionProperty(key, value))
+			}
+			if ttl := CollectionTTLSeconds(task.GetCollBackup().GetProperties()); ttl > 0 {
+				// bulk insert assigns the import time to the rows, the TTL of all the restored rows starts over
+				log.Warn("restore collection with TTL, rows expired in the source are visible again until the TTL passes",
+					zap.String("targetDBName", targetDBName),
+					zap.String("targetCollectionName", targetCollectionName),
+					zap.Int64("ttlSeconds", ttl))
+			}
+		}
+		shardsNum := task.GetCollBackup().GetShardsNum()
+		if task.GetShardsNum() > 0 {
+			log.Info("create collection with the shards num of the request",
+				zap.String("targetCollectionName", targetCollectionName),
+				zap.Int32("backupShardsNum", shardsNum),
+				zap.Int32("shardsNum", task.GetShardsNum()))
+			shardsNum = task.GetShardsNum()
+		}
+		functions := task.GetCollBackup().GetSchema().GetFunctions()
 		err := retry.Do(ctx, func() error {
-			if hasPartitionKey {
-				partitionNum := len(task.GetCollBackup().GetPartitionBackups())
-				return b.getMilvusClient().CreateCollection(
-					ctx,
-					targetDBName,
-					collectionSchema,
-					task.GetCollBackup().GetShardsNum(),
-					gomilvus.WithConsistencyLevel(entity.ConsistencyLevel(task.GetCollBackup().GetConsistencyLevel())),
-					gomilvus.WithPartitionNum(int64(partitionNum)))
+			// the sdk in use can't create the functions, e.g. BM25 and text embedding of milvus 2.5
+			if len(functions) > 0 {
+				return b.getMilvusClient().CreateCollectionWithFunctions(ctx, targetDBName, collectionSchema, shardsNum,
+					consistencyLevel, partitionNum, properties, functions)
 			}
 			return b.getMilvusClient().CreateCollection(
 				ctx,
 				targetDBName,
 				collectionSchema,
-				task.GetCollBackup().GetShardsNum(),
-				gomilvus.WithConsistencyLevel(entity.ConsistencyLevel(task.GetCollBackup().GetConsistencyLevel())))
+				shardsNum,
+				createOpts...)
 		}, retry.Attempts(10), retry.Sleep(1*time.Second))
 		if err != nil {
 			errorMsg := fmt.Sprintf("fail to create collection, targetCollectionName: %s err: %s", targetCollectionName, err)
@@ -473,10 +891,54 @@ func (b *BackupContext) executeRestoreCollectionTask(ctx context.Context, backup
 			return task, err
 		}
 		log.Info("create collection",
-			zap.Bool("hasPartitionKey", hasPartitionKey))
+			zap.Bool("hasPartitionKey", hasPartitionKey),
+			zap.Int("functions", len(functions)))
+		targetCollection, err := b.getMilvusClient().DescribeCollection(ctx, targetDBName, targetCollectionName)
+		if err != nil {
+			// the id is only for tracing, the restore goes on without it
+			log.Warn("fail to describe the created collection", zap.String("targetCollectionName", targetCollectionName), zap.Error(err))
+		} else {
+			task.TargetCollectionId = targetCollection.ID
+		}
 	} else {
 		log.Info("skip create collection",
 			zap.Bool("hasPartitionKey", hasPartitionKey))
+		targetCollection, err := b.getMilvusClient().DescribeCollection(ctx, targetDBName, targetCollectionName)
+		if err != nil {
+			errorMsg := fmt.Sprintf("fail to describe collection, targetCollectionName: %s err: %s", targetCollectionName, err)
+			log.Error(errorMsg)
+			task.StateCode = backuppb.RestoreTaskStateCode_FAIL
+			task.ErrorMessage = errorMsg
+			return task, err
+		}
+		task.TargetCollectionId = targetCollection.ID
+		extraFields, err := checkTargetCollectionSchema(task.GetCollBackup().GetSchema(), targetCollection.Schema)
+		if err != nil {
+			errorMsg := fmt.Sprintf("backup can not be restored into the existing collection, targetCollectionName: %s err: %s", targetCollectionName, err)
+			log.Error(errorMsg)
+			task.StateCode = backuppb.RestoreTaskStateCode_FAIL
+			task.ErrorMessage = errorMsg
+			return task, err
+		}
+		if len(extraFields) > 0 {
+			// binlogs are imported by field id, the fields not in the backup have no binlog and are filled by milvus
+			err := b.checkExtraFieldsFillable(ctx, targetDBName, targetCollectionName, extraFields)
+			if err != nil {
+				errorMsg := fmt.Sprintf("backup can not be restored into the existing collection, targetCollectionName: %s err: %s", targetCollectionName, err)
+				log.Error(errorMsg)
+				task.StateCode = backuppb.RestoreTaskStateCode_FAIL
+				task.ErrorMessage = errorMsg
+				return task, err
+			}
+			log.Info("existing collection has fields not in the backup, they are left to null or default values",
+				zap.Strings("extraFields", extraFields))
+		}
+	}
+	if task.GetTargetCollectionId() != 0 {
+		b.meta.UpdateRestoreTask(parentTaskID, setCollectionRestoreTargetID(task.GetId(), task.GetTargetCollectionId()))
+		log.Info("restore collection",
+			zap.Int64("sourceCollectionID", task.GetSourceCollectionId()),
+			zap.Int64("targetCollectionID", task.GetTargetCollectionId()))
 	}
 
 	if task.GetDropExistIndex() {
@@ -508,65 +970,55 @@ func (b *BackupContext) executeRestoreCollectionTask(ctx context.Context, backup
 		}
 	}
 
-	if task.GetRestoreIndex() {
-		vectorFields := make(map[string]bool, 0)
-		for _, field := range collectionSchema.Fields {
-			if strings.HasSuffix(strings.ToLower(field.DataType.Name()), "vector") {
-				vectorFields[field.Name] = true
-			}
-		}
-		indexes := task.GetCollBackup().GetIndexInfos()
-		for _, index := range indexes {
-			var idx entity.Index
-			log.Info("source index",
-				zap.String("indexName", index.GetIndexName()),
-				zap.String("indexType", index.GetIndexType()),
-				zap.Any("params", index.GetParams()))
-			if _, ok := vectorFields[index.GetFieldName()]; ok && task.GetUseAutoIndex() {
-				log.Info("use auto index")
-				params := make(map[string]string, 0)
-				// auto index only support index_type and metric_type in params
-				params["index_type"] = "AUTOINDEX"
-				params["metric_type"] = index.GetParams()["metric_type"]
-				idx = entity.NewGenericIndex(index.GetIndexName(), entity.AUTOINDEX, params)
-			} else {
-				log.Info("not auto index")
-				indexType := index.GetIndexType()
-				if indexType == "marisa-trie" {
-					indexType = "Trie"
-				}
-				params := index.GetParams()
-				if params["index_type"] == "marisa-trie" {
-					params["index_type"] = "Trie"
-				}
-				idx = entity.NewGenericIndex(index.GetIndexName(), entity.IndexType(indexType), index.GetParams())
-			}
-			err := b.getMilvusClient().CreateIndex(ctx, targetDBName, targetCollectionName, index.GetFieldName(), idx, true)
-			if err != nil {
-				log.Warn("Fail to restore index", zap.Error(err))
-				return task, err
-			}
+	if task.GetRestoreIndex() && task.GetBuildIndexBeforeImport() {
+		if err := b.restoreIndexes(ctx, task, collectionSchema); err != nil {
+			return task, err
 		}
 	}
 
-	tempDir := fmt.Sprintf("restore-temp-%s-%s-%s%s", parentTaskID, task.TargetDbName, task.TargetCollectionName, SEPERATOR)
+	// bulkinsert of milvus reads the staged files from its own bucket
+	stagingBucketName := b.milvusBucketName
+	tempDir := b.restoreStagingDir(parentTaskID) + EscapePathName(task.TargetDbName) + SEPERATOR + EscapePathName(task.TargetCollectionName) + SEPERATOR
 	isSameBucket := b.milvusBucketName == backupBucketName
 	// clean the temporary file
 	defer func() {
-		if !isSameBucket && !b.params.BackupCfg.KeepTempFiles {
+		if (!isSameBucket || binlogCipher != nil) && !b.params.BackupCfg.KeepTempFiles {
 			log.Info("Delete temporary file", zap.String("dir", tempDir))
-			err := b.getStorageClient().RemoveWithPrefix(ctx, b.milvusBucketName, tempDir)
+			err := b.getStorageClient().RemoveWithPrefix(ctx, stagingBucketName, tempDir)
 			if err != nil {
 				log.Warn("Delete temporary file failed", zap.Error(err))
 			}
 		}
 	}()
 
+	// the binlogs copied into the staging dir are verified with their checksums before bulk insert
+	binlogChecksums := restoreBinlogChecksums(task.GetCollBackup(), milvusRootPath, backupPath, b.meta.GetRestoreTask(parentTaskID).GetBackupName())
+
 	// bulk insert
 	copyAndBulkInsert := func(dbName, collectionName, partitionName string, files []string, isL0 bool, skipDiskQuotaCheck bool) error {
 		realFiles := make([]string, len(files))
-		// if milvus bucket and backup bucket are not the same, should copy the data first
-		if !isSameBucket {
+		// the binlogs of an encrypted backup are decrypted into the staging dir, milvus reads them in plain
+		if binlogCipher != nil {
+			log.Info("backup is encrypted, decrypt the data first", zap.Strings("files", files))
+			for i, file := range files {
+				if file == "" {
+					realFiles[i] = file
+					continue
+				}
+				err := retry.Do(ctx, func() error {
+					return b.copyDecryptedFiles(ctx, binlogCipher, backupBucketName, stagingBucketName, file, tempDir+file)
+				}, retry.Sleep(2*time.Second), retry.Attempts(5))
+				if err != nil {
+					log.Error("fail to decrypt backup data into the staging dir after retry", zap.Error(err))
+					return err
+				}
+				if err := b.verifyStagedChecksums(ctx, stagingBucketName, tempDir, file, binlogChecksums); err != nil {
+					return err
+				}
+				realFiles[i] = tempDir + file
+			}
+		} else if !isSameBucket {
+			// if milvus bucket and backup bucket are not the same, should copy the data first
 			log.Info("milvus bucket and backup bucket are not the same, copy the data first", zap.Strings("files", files))
 			for i, file := range files {
 				// empty delta file, no need to copy
@@ -575,12 +1027,15 @@ func (b *BackupContext) executeRestoreCollectionTask(ctx context.Context, backup
 				} else {
 					log.Debug("Copy temporary restore file", zap.String("from", file), zap.String("to", tempDir+file))
 					err := retry.Do(ctx, func() error {
-						return b.getStorageClient().Copy(ctx, backupBucketName, b.milvusBucketName, file, tempDir+file)
+						return b.getStorageClient().Copy(ctx, backupBucketName, stagingBucketName, file, tempDir+file)
 					}, retry.Sleep(2*time.Second), retry.Attempts(5))
 					if err != nil {
 						log.Error("fail to copy backup date from backup bucket to restore target milvus bucket after retry", zap.Error(err))
 						return err
 					}
+					if err := b.verifyStagedChecksums(ctx, stagingBucketName, tempDir, file, binlogChecksums); err != nil {
+						return err
+					}
 					realFiles[i] = tempDir + file
 				}
 			}
@@ -588,7 +1043,14 @@ func (b *BackupContext) executeRestoreCollectionTask(ctx context.Context, backup
 			realFiles = files
 		}
 
-		err := b.executeBulkInsert(ctx, dbName, collectionName, partitionName, realFiles, int64(task.GetCollBackup().BackupTimestamp), isL0, skipDiskQuotaCheck)
+		err := retry.Do(ctx, func() error {
+			err := b.executeBulkInsert(ctx, dbName, collectionName, partitionName, realFiles, int64(task.GetCollBackup().BackupTimestamp), isL0, skipDiskQuotaCheck)
+			if err != nil && !isRetryableBulkInsertError(err) {
+				return retry.Unrecoverable(err)
+			}
+			return err
+		}, retry.Attempts(uint(b.params.BackupCfg.RestoreBulkinsertRetries+1)), retry.Sleep(BULKINSERT_RETRY_SLEEP),
+			retry.MaxSleepTime(BULKINSERT_RETRY_MAX_SLEEP), retry.Jitter(b.params.BackupCfg.RetryJitter))
 		if err != nil {
 			log.Error("fail to bulk insert to partition",
 				zap.String("partition", partitionName),
@@ -654,7 +1116,14 @@ func (b *BackupContext) executeRestoreCollectionTask(ctx context.Context, backup
 		} else {
 			// bulk insert by segment groups
 			for _, groupId := range groupIds {
-				files, size, err := b.getBackupPartitionPathsWithGroupID(ctx, backupBucketName, backupPath, partitionBackup, groupId)
+				groupBackupPath := backupPath
+				// a segment reused by an incremental backup is in its own group, restored from the backup holding it
+				if groupSegment, ok := lo.Find(notl0Segments, func(segment *backuppb.SegmentBackupInfo) bool {
+					return segment.GetGroupId() == groupId && segment.GetBaseBackupName() != ""
+				}); ok {
+					groupBackupPath = SegmentBackupPath(backupPath, b.meta.GetRestoreTask(parentTaskID).GetBackupName(), groupSegment)
+				}
+				files, size, err := b.getBackupPartitionPathsWithGroupID(ctx, backupBucketName, groupBackupPath, partitionBackup, groupId)
 				if err != nil {
 					log.Error("fail to get partition backup binlog files",
 						zap.Error(err),
@@ -668,11 +1137,21 @@ func (b *BackupContext) executeRestoreCollectionTask(ctx context.Context, backup
 		for _, value := range restoreFileGroups {
 			group := value
 			job := func(ctx context.Context) error {
-				err := copyAndBulkInsert(targetDBName, targetCollectionName, partitionBackup.GetPartitionName(), group.files, false, task.GetSkipDiskQuotaCheck())
+				var err error
+				if !task.GetDeltaOnly() {
+					err = copyAndBulkInsert(targetDBName, targetCollectionName, partitionBackup.GetPartitionName(), group.files, false, task.GetSkipDiskQuotaCheck())
+				} else if group.files[1] != "" {
+					// the delta logs of the group are imported like l0 segments, which apply the deletions to the partition
+					err = copyAndBulkInsert(targetDBName, targetCollectionName, partitionBackup.GetPartitionName(), []string{group.files[1]}, true, task.GetSkipDiskQuotaCheck())
+				}
 				if err != nil {
 					return err
 				} else {
-					b.meta.UpdateRestoreTask(parentTaskID, addCollectionRestoredSize(task.GetCollBackup().GetCollectionId(), group.size))
+					b.meta.UpdateRestoreTask(parentTaskID, addCollectionRestoredSize(task.GetCollBackup().GetCollectionId(), group.size),
+						addPartitionRestoredSize(task.GetId(), partitionBackup.GetPartitionName(), group.size))
+					b.meta.AddEvent(parentTaskID, EVENT_PROGRESS,
+						fmt.Sprintf("restored %d bytes of partition %s", group.size, partitionBackup.GetPartitionName()),
+						withEventCollection(targetDBName, targetCollectionName))
 					restoredSize.Add(group.size)
 					task.RestoredSize = restoredSize.Load()
 					return nil
@@ -694,7 +1173,7 @@ func (b *BackupContext) executeRestoreCollectionTask(ctx context.Context, backup
 		}
 	}
 
-	err := b.getRestoreWorkerPool(parentTaskID).WaitJobs(jobIds)
+	err = b.getRestoreWorkerPool(parentTaskID).WaitJobs(jobIds)
 	if err != nil {
 		return task, err
 	}
@@ -726,10 +1205,166 @@ func (b *BackupContext) executeRestoreCollectionTask(ctx context.Context, backup
 		}
 	}
 	err = b.getRestoreWorkerPool(parentTaskID).WaitJobs(l0JobIds)
+	if err != nil {
+		return task, err
+	}
+
+	// building the indexes once on all the imported data is usually faster than building them during import
+	if task.GetRestoreIndex() && !task.GetBuildIndexBeforeImport() {
+		if err := b.restoreIndexes(ctx, task, collectionSchema); err != nil {
+			return task, err
+		}
+	}
 
+	if task.GetAutoReloadPreviouslyLoaded() {
+		// the indexes are created async, loading needs them built
+		if task.GetRestoreIndex() {
+			if err := b.waitIndexesBuilt(ctx, task); err != nil {
+				return task, err
+			}
+		}
+		err = b.reloadCollection(ctx, task)
+	}
 	return task, err
 }
 
+// restoreIndexes creates the indexes of the collection in backup on the target collection
+func (b *BackupContext) restoreIndexes(ctx context.Context, task *backuppb.RestoreCollectionTask, collectionSchema *entity.Schema) error {
+	targetDBName := task.GetTargetDbName()
+	targetCollectionName := task.GetTargetCollectionName()
+	log := log.With(
+		zap.String("target_db_name", targetDBName),
+		zap.String("target_collection_name", targetCollectionName))
+	vectorFields := make(map[string]bool, 0)
+	for _, field := range collectionSchema.Fields {
+		if strings.HasSuffix(strings.ToLower(field.DataType.Name()), "vector") {
+			vectorFields[field.Name] = true
+		}
+	}
+	// indexes are collection level, a partition scoped backup has the indexes of the whole collection.
+	// When restoring into an existing collection, e.g. partitions of several backups one by one, the index
+	// is only created by the first restore
+	indexes := task.GetCollBackup().GetIndexInfos()
+	for _, index := range indexes {
+		if task.GetSkipCreateCollection() && !task.GetDropExistIndex() {
+			exist, err := b.hasIndex(ctx, targetDBName, targetCollectionName, index.GetFieldName(), index.GetIndexName())
+			if err != nil {
+				return err
+			}
+			if exist {
+				log.Info("index already exist in target collection, skip create",
+					zap.String("fieldName", index.GetFieldName()),
+					zap.String("indexName", index.GetIndexName()))
+				continue
+			}
+		}
+		var idx entity.Index
+		log.Info("source index",
+			zap.String("indexName", index.GetIndexName()),
+			zap.String("indexType", index.GetIndexType()),
+			zap.Any("params", index.GetParams()))
+		indexType, indexParams := applyIndexOverride(index, task.GetIndexOverrides())
+		if _, ok := vectorFields[index.GetFieldName()]; ok && task.GetUseAutoIndex() {
+			log.Info("use auto index")
+			params := make(map[string]string, 0)
+			// auto index only support index_type and metric_type in params
+			params["index_type"] = "AUTOINDEX"
+			params["metric_type"] = indexParams["metric_type"]
+			idx = entity.NewGenericIndex(index.GetIndexName(), entity.AUTOINDEX, params)
+		} else {
+			log.Info("not auto index")
+			if indexType == "marisa-trie" {
+				indexType = "Trie"
+			}
+			if indexParams["index_type"] == "marisa-trie" {
+				indexParams["index_type"] = "Trie"
+			}
+			idx = entity.NewGenericIndex(index.GetIndexName(), entity.IndexType(indexType), indexParams)
+		}
+		err := b.getMilvusClient().CreateIndex(ctx, targetDBName, targetCollectionName, index.GetFieldName(), idx, true)
+		if err != nil {
+			log.Warn("Fail to restore index", zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+// hasIndex returns whether the field of the collection has an index with the name
+func (b *BackupContext) hasIndex(ctx context.Context, dbName, collectionName, fieldName, indexName string) (bool, error) {
+	fieldIndexes, err := b.getMilvusClient().DescribeIndex(ctx, dbName, collectionName, fieldName)
+	if err != nil {
+		if strings.Contains(err.Error(), "index not found") ||
+			strings.HasPrefix(err.Error(), "index doesn't exist") {
+			return false, nil
+		}
+		return false, fmt.Errorf("fail to describe index of field %s in collection %s.%s, err: %w", fieldName, dbName, collectionName, err)
+	}
+	return lo.ContainsBy(fieldIndexes, func(index entity.Index) bool { return index.Name() == indexName }), nil
+}
+
+// partitionsToReload returns the partitions loaded or loading at backup time, all is true if the whole collection was loaded.
+// If restoredOnly, all is always false, so that the partitions of the target collection not in the backup are not loaded
+func partitionsToReload(collection *backuppb.CollectionBackupInfo, restoredOnly bool) (all bool, partitionNames []string) {
+	partitionNames = make([]string, 0)
+	for _, partition := range collection.GetPartitionBackups() {
+		if partition.GetLoadState() == LoadState_Loaded || partition.GetLoadState() == LoadState_Loading {
+			partitionNames = append(partitionNames, partition.GetPartitionName())
+		}
+	}
+	if restoredOnly {
+		if collection.GetLoadState() == LoadState_Loaded {
+			partitionNames = lo.Map(collection.GetPartitionBackups(), func(partition *backuppb.PartitionBackupInfo, _ int) string {
+				return partition.GetPartitionName()
+			})
+		}
+		return false, partitionNames
+	}
+	if collection.GetLoadState() == LoadState_Loaded {
+		return true, partitionNames
+	}
+	return len(partitionNames) > 0 && len(partitionNames) == len(collection.GetPartitionBackups()), partitionNames
+}
+
+// reloadCollection loads the restored collection as it was loaded at backup time
+func (b *BackupContext) reloadCollection(ctx context.Context, task *backuppb.RestoreCollectionTask) error {
+	targetDBName := task.GetTargetDbName()
+	targetCollectionName := task.GetTargetCollectionName()
+	all, partitionNames := partitionsToReload(task.GetCollBackup(), task.GetLoadRestoredPartitionsOnly())
+	if all {
+		log.Info("reload collection loaded at backup time",
+			zap.String("target_db_name", targetDBName),
+			zap.String("target_collection_name", targetCollectionName))
+		err := b.getMilvusClient().LoadCollection(ctx, targetDBName, targetCollectionName, true)
+		if err != nil {
+			return fmt.Errorf("fail to load collection %s.%s, err: %w", targetDBName, targetCollectionName, err)
+		}
+		return nil
+	}
+	if len(partitionNames) == 0 {
+		log.Info("collection not loaded at backup time, skip reload",
+			zap.String("target_db_name", targetDBName),
+			zap.String("target_collection_name", targetCollectionName))
+		return nil
+	}
+	log.Info("reload partitions loaded at backup time",
+		zap.String("target_db_name", targetDBName),
+		zap.String("target_collection_name", targetCollectionName),
+		zap.Strings("partitions", partitionNames))
+	err := b.getMilvusClient().LoadPartitions(ctx, targetDBName, targetCollectionName, partitionNames, true)
+	if err != nil {
+		return fmt.Errorf("fail to load partitions %v of collection %s.%s, err: %w", partitionNames, targetDBName, targetCollectionName, err)
+	}
+	return nil
+}
+
+// hasSegmentBackups returns whether the collection backup has segments, so that its restore imports data
+func hasSegmentBackups(collection *backuppb.CollectionBackupInfo) bool {
+	return lo.SomeBy(collection.GetPartitionBackups(), func(partition *backuppb.PartitionBackupInfo) bool {
+		return len(partition.GetSegmentBackups()) > 0
+	})
+}
+
 func collectGroupIdsFromSegments(segments []*backuppb.SegmentBackupInfo) []int64 {
 	dict := make(map[int64]bool)
 	res := make([]int64, 0)
@@ -742,6 +1377,133 @@ func collectGroupIdsFromSegments(segments []*backuppb.SegmentBackupInfo) []int64
 	return res
 }
 
+// restoreDatabases creates the databases in the backup which don't exist in the target milvus, with their properties.
+// Existing databases are kept as they are.
+func (b *BackupContext) restoreDatabases(ctx context.Context, databases []*backuppb.DatabaseBackupInfo, dbRenames map[string]string) error {
+	dbs, err := b.getMilvusClient().ListDatabases(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to list databases, err: %w", err)
+	}
+	existDBs := lo.Map(dbs, func(db entity.Database, _ int) string { return db.Name })
+	for _, database := range databases {
+		targetDBName := database.GetDbName()
+		if value, ok := dbRenames[targetDBName]; ok {
+			targetDBName = value
+		}
+		if lo.Contains(existDBs, targetDBName) {
+			log.Info("database already exist, skip create it", zap.String("database", targetDBName))
+			continue
+		}
+		opts := make([]gomilvus.CreateDatabaseOption, 0, len(database.GetProperties()))
+		for key, value := range database.GetProperties() {
+			opts = append(opts, gomilvus.WithDatabaseProperty(key, value))
+		}
+		err := b.getMilvusClient().CreateDatabase(ctx, targetDBName, opts...)
+		if err != nil {
+			return fmt.Errorf("fail to create database %s, err: %w", targetDBName, err)
+		}
+		existDBs = append(existDBs, targetDBName)
+		log.Info("create database", zap.String("database", targetDBName), zap.Any("properties", database.GetProperties()))
+	}
+	return nil
+}
+
+// createMissingDatabase creates a target database of the restore, a database created concurrently by others is taken as created
+func (b *BackupContext) createMissingDatabase(ctx context.Context, dbName string) error {
+	if err := utils.ValidateType(dbName, DATABASE_NAME); err != nil {
+		return err
+	}
+	err := b.getMilvusClient().CreateDatabase(ctx, dbName)
+	if err == nil {
+		log.Info("create database", zap.String("database", dbName))
+		return nil
+	}
+	dbs, listErr := b.getMilvusClient().ListDatabases(ctx)
+	if listErr == nil && lo.ContainsBy(dbs, func(db entity.Database) bool { return db.Name == dbName }) {
+		log.Info("database is created concurrently", zap.String("database", dbName), zap.Error(err))
+		return nil
+	}
+	return fmt.Errorf("fail to create database %s, err: %w", dbName, err)
+}
+
+// checkTargetCollectionSchema checks the backup can be imported into an existing collection, whose schema may have more
+// fields than the backup. Binlogs are stored by field id, so the backup fields must keep their ids and types in the target.
+// It returns the names of the target fields not in the backup, which are left to null or default values by the import.
+func checkTargetCollectionSchema(backupSchema *backuppb.CollectionSchema, target *entity.Schema) ([]string, error) {
+	targetFields := lo.SliceToMap(target.Fields, func(field *entity.Field) (string, *entity.Field) { return field.Name, field })
+	for _, field := range backupSchema.GetFields() {
+		targetField, ok := targetFields[field.GetName()]
+		if !ok {
+			return nil, fmt.Errorf("field %s of the backup not exist in the target collection", field.GetName())
+		}
+		if targetField.DataType != entity.FieldType(field.GetDataType()) {
+			return nil, fmt.Errorf("field %s data type mismatch, backup: %s, target: %s",
+				field.GetName(), field.GetDataType().String(), targetField.DataType.Name())
+		}
+		if targetField.PrimaryKey != field.GetIsPrimaryKey() {
+			return nil, fmt.Errorf("field %s primary key mismatch, backup: %t, target: %t", field.GetName(), field.GetIsPrimaryKey(), targetField.PrimaryKey)
+		}
+		if targetField.ID != field.GetFieldID() {
+			return nil, fmt.Errorf("field %s id mismatch, backup: %d, target: %d", field.GetName(), field.GetFieldID(), targetField.ID)
+		}
+		delete(targetFields, field.GetName())
+	}
+
+	extraFields := make([]string, 0, len(targetFields))
+	for _, field := range target.Fields {
+		if _, ok := targetFields[field.Name]; !ok {
+			continue
+		}
+		if field.PrimaryKey || isVectorFieldType(field.DataType) {
+			return nil, fmt.Errorf("field %s of the target collection not exist in the backup, primary key and vector fields can not be filled", field.Name)
+		}
+		extraFields = append(extraFields, field.Name)
+	}
+	return extraFields, nil
+}
+
+var (
+	errBulkInsertSubmit = errors.New("fail to submit bulk insert")
+	errBulkInsertFailed = errors.New("bulk insert fail")
+)
+
+// failed reasons of the imports which won't succeed by retry
+var permanentBulkInsertReasons = []string{"schema", "field", "dim", "data type", "primary key", "not exist", "not found", "invalid", "illegal", "mismatch"}
+
+// isRetryableBulkInsertError returns whether the import of a segment group may succeed by retry.
+// Imports without progress may still run in milvus and are not retried, a retry could import the data twice.
+func isRetryableBulkInsertError(err error) bool {
+	if !errors.Is(err, errBulkInsertSubmit) && !errors.Is(err, errBulkInsertFailed) {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return !lo.ContainsBy(permanentBulkInsertReasons, func(reason string) bool { return strings.Contains(msg, reason) })
+}
+
+// isVectorFieldType returns whether a field is a vector field, which can be neither nullable nor have a default value
+func isVectorFieldType(dataType entity.FieldType) bool {
+	switch dataType {
+	case entity.FieldTypeFloatVector, entity.FieldTypeBinaryVector, entity.FieldTypeFloat16Vector,
+		entity.FieldTypeBFloat16Vector, entity.FieldTypeSparseVector:
+		return true
+	}
+	return false
+}
+
+// checkExtraFieldsFillable checks the fields of the target collection not in the backup are nullable or have default
+// values, so the import fills them
+func (b *BackupContext) checkExtraFieldsFillable(ctx context.Context, db, collName string, extraFields []string) error {
+	fillable, err := b.getMilvusClient().GetFillableFields(ctx, db, collName)
+	if err != nil {
+		return fmt.Errorf("fail to check the fields not in the backup are nullable or have default values, err: %w", err)
+	}
+	notFillable := lo.Filter(extraFields, func(field string, _ int) bool { return !fillable[field] })
+	if len(notFillable) > 0 {
+		return fmt.Errorf("fields %v of the target collection not exist in the backup, and they are neither nullable nor have default values", notFillable)
+	}
+	return nil
+}
+
 func (b *BackupContext) executeBulkInsert(ctx context.Context, db, coll string, partition string, files []string, endTime int64, isL0 bool, skipDiskQuotaCheck bool) error {
 	log.Info("execute bulk insert",
 		zap.String("db", db),
@@ -771,7 +1533,7 @@ func (b *BackupContext) executeBulkInsert(ctx context.Context, db, coll string,
 			zap.String("partitionName", partition),
 			zap.Strings("files", files),
 			zap.Error(err))
-		return err
+		return fmt.Errorf("%w: %s", errBulkInsertSubmit, err.Error())
 	}
 	err = b.watchBulkInsertState(ctx, taskId, BULKINSERT_TIMEOUT, BULKINSERT_SLEEP_INTERVAL)
 	if err != nil {
@@ -804,21 +1566,27 @@ func (b *BackupContext) watchBulkInsertState(ctx context.Context, taskId int64,
 		switch importTaskState.State {
 		case entity.BulkInsertFailed:
 			if value, ok := importTaskState.Infos["failed_reason"]; ok {
-				return errors.New("bulk insert fail, info: " + value)
+				return fmt.Errorf("%w, info: %s", errBulkInsertFailed, value)
 			} else {
-				return errors.New("bulk insert fail")
+				return errBulkInsertFailed
 			}
 		case entity.BulkInsertCompleted:
 			return nil
 		default:
 			currentProgress := importTaskState.Progress()
 			if currentProgress > lastProgress {
+				lastProgress = currentProgress
 				lastUpdateTime = time.Now().Unix()
 			} else if (currentTimestamp - lastUpdateTime) >= timeout {
 				log.Warn(fmt.Sprintf("bulkinsert task state progress hang for more than %d s", timeout))
-				return errors.New("import task timeout")
+				return fmt.Errorf("import task %d timeout, no progress for more than %d s", taskId, timeout)
+			}
+			select {
+			case <-ctx.Done():
+				log.Warn("stop watching bulkinsert task", zap.Int64("id", taskId), zap.Error(ctx.Err()))
+				return fmt.Errorf("stop watching import task %d: %w", taskId, ctx.Err())
+			case <-time.After(time.Second * time.Duration(sleepSeconds)):
 			}
-			time.Sleep(time.Second * time.Duration(sleepSeconds))
 			continue
 		}
 	}
@@ -877,3 +1645,307 @@ func (b *BackupContext) getBackupPartitionPathsWithGroupID(ctx context.Context,
 
 	return []string{insertPath, deltaPath}, totalSize, nil
 }
+
+// restoreBinlogChecksums maps the backup objects of the insert and delta binlogs of the collection to their checksums,
+// it is empty for a backup made without backup.verifyChecksum
+func restoreBinlogChecksums(collection *backuppb.CollectionBackupInfo, milvusRootPath, backupPath, backupName string) map[string]string {
+	checksums := make(map[string]string)
+	segments := append([]*backuppb.SegmentBackupInfo{}, collection.GetL0Segments()...)
+	for _, partition := range collection.GetPartitionBackups() {
+		segments = append(segments, partition.GetSegmentBackups()...)
+	}
+	for _, segment := range segments {
+		binlogDir := SegmentBackupPath(backupPath, backupName, segment) + SEPERATOR + BINGLOG_DIR
+		for _, fieldBinlogs := range [][]*backuppb.FieldBinlog{segment.GetBinlogs(), segment.GetDeltalogs()} {
+			for _, fieldBinlog := range fieldBinlogs {
+				for _, binlog := range fieldBinlog.GetBinlogs() {
+					if binlog.GetSha256() == "" {
+						continue
+					}
+					targetPath := BackupSegmentBinlogPath(binlog.GetLogPath(), milvusRootPath, binlogDir, segment.GetPartitionId(), segment.GetGroupId())
+					checksums[targetPath] = binlog.GetSha256()
+				}
+			}
+		}
+	}
+	return checksums
+}
+
+// verifyStagedChecksums compares the checksums of the objects staged from the backup files for bulk insert with the ones
+// recorded at backup, the objects without checksum are not verified
+func (b *BackupContext) verifyStagedChecksums(ctx context.Context, stagingBucketName, stagingDir, file string, checksums map[string]string) error {
+	if len(checksums) == 0 {
+		return nil
+	}
+	keys, _, err := b.getStorageClient().ListWithPrefix(ctx, stagingBucketName, stagingDir+file, true)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		expected, ok := checksums[strings.TrimPrefix(key, stagingDir)]
+		if !ok {
+			continue
+		}
+		checksum, err := b.objectChecksum(ctx, stagingBucketName, key, nil)
+		if err != nil {
+			return fmt.Errorf("fail to compute checksum of restored binlog %s, err: %w", key, err)
+		}
+		if checksum != expected {
+			log.Error("checksum mismatch of restored binlog", zap.String("path", key),
+				zap.String("sha256", checksum), zap.String("expectedSha256", expected))
+			return fmt.Errorf("checksum mismatch of restored binlog %s, sha256: %s, expected: %s", key, checksum, expected)
+		}
+	}
+	return nil
+}
+
+// restoreStagingDir returns the staging dir of a restore task, staged objects of different restores never overlap
+func (b *BackupContext) restoreStagingDir(restoreID string) string {
+	return strings.TrimSuffix(b.params.BackupCfg.RestoreStagingPath, SEPERATOR) + SEPERATOR + restoreID + SEPERATOR
+}
+
+// matchIndexOverrides returns the index overrides of the collection,
+// and checks each of them can be built on the field it targets
+func matchIndexOverrides(collection *backuppb.CollectionBackupInfo, overrides []*backuppb.IndexParamOverride, defaultDB string) ([]*backuppb.IndexParamOverride, error) {
+	fullCollectionName := collection.GetDbName() + "." + collection.GetCollectionName()
+	matched := make([]*backuppb.IndexParamOverride, 0)
+	for _, override := range overrides {
+		overrideCollectionName := override.GetCollectionName()
+		if overrideCollectionName != "" {
+			overrideCollectionName = FullCollectionName(overrideCollectionName, defaultDB)
+		}
+		if overrideCollectionName != "" && overrideCollectionName != fullCollectionName {
+			continue
+		}
+
+		field, found := lo.Find(collection.GetSchema().GetFields(), func(field *backuppb.FieldSchema) bool {
+			return field.GetName() == override.GetFieldName()
+		})
+		if !found {
+			if overrideCollectionName == "" {
+				continue
+			}
+			return nil, fmt.Errorf("field %s not found", override.GetFieldName())
+		}
+		index, found := lo.Find(collection.GetIndexInfos(), func(index *backuppb.IndexInfo) bool {
+			return index.GetFieldName() == override.GetFieldName()
+		})
+		if !found {
+			if overrideCollectionName == "" {
+				continue
+			}
+			return nil, fmt.Errorf("field %s has no index in backup", override.GetFieldName())
+		}
+
+		indexType, params := applyIndexOverride(index, []*backuppb.IndexParamOverride{override})
+		if err := utils.ValidateIndexParams(field.GetDataType(), indexType, params); err != nil {
+			return nil, fmt.Errorf("field %s: %w", override.GetFieldName(), err)
+		}
+		matched = append(matched, override)
+	}
+	return matched, nil
+}
+
+// applyIndexOverride returns the index type and params to build, the original index info is not modified
+func applyIndexOverride(index *backuppb.IndexInfo, overrides []*backuppb.IndexParamOverride) (string, map[string]string) {
+	indexType := index.GetIndexType()
+	params := make(map[string]string, len(index.GetParams()))
+	for k, v := range index.GetParams() {
+		params[k] = v
+	}
+	for _, override := range overrides {
+		if override.GetFieldName() != index.GetFieldName() {
+			continue
+		}
+		if override.GetIndexType() != "" {
+			indexType = override.GetIndexType()
+			params["index_type"] = indexType
+		}
+		for k, v := range override.GetParams() {
+			params[k] = v
+		}
+	}
+	return indexType, params
+}
+
+// checkRestoreStagingWritable writes and removes a probe object to make sure the staging location is usable
+func (b *BackupContext) checkRestoreStagingWritable(ctx context.Context, restoreID string) error {
+	bucketName := b.milvusBucketName
+	probe := b.restoreStagingDir(restoreID) + ".writable"
+	if err := b.getStorageClient().Write(ctx, bucketName, probe, []byte(restoreID)); err != nil {
+		return err
+	}
+	return b.getStorageClient().Remove(ctx, bucketName, probe)
+}
+
+// waitIndexesBuilt waits until the indexes of the collection in backup are built on the target collection
+func (b *BackupContext) waitIndexesBuilt(ctx context.Context, task *backuppb.RestoreCollectionTask) error {
+	targetDBName := task.GetTargetDbName()
+	targetCollectionName := task.GetTargetCollectionName()
+	start := time.Now().Unix()
+	for _, index := range task.GetCollBackup().GetIndexInfos() {
+		for {
+			state, err := b.getMilvusClient().GetIndexState(ctx, targetDBName, targetCollectionName, index.GetFieldName(), index.GetIndexName())
+			if err != nil {
+				return fmt.Errorf("fail to get state of index %s of collection %s.%s, err: %w", index.GetIndexName(), targetDBName, targetCollectionName, err)
+			}
+			if state == entity.IndexState(commonpb.IndexState_Finished) {
+				break
+			}
+			if state == entity.IndexState(commonpb.IndexState_Failed) {
+				return fmt.Errorf("fail to build index %s of collection %s.%s", index.GetIndexName(), targetDBName, targetCollectionName)
+			}
+			if time.Now().Unix()-start >= BULKINSERT_TIMEOUT {
+				return fmt.Errorf("index %s of collection %s.%s not built in %d s", index.GetIndexName(), targetDBName, targetCollectionName, BULKINSERT_TIMEOUT)
+			}
+			log.Info("wait index built",
+				zap.String("target_db_name", targetDBName),
+				zap.String("target_collection_name", targetCollectionName),
+				zap.String("indexName", index.GetIndexName()),
+				zap.Int32("state", int32(state)))
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("stop waiting index %s: %w", index.GetIndexName(), ctx.Err())
+			case <-time.After(time.Second * time.Duration(BULKINSERT_SLEEP_INTERVAL)):
+			}
+		}
+	}
+	return nil
+}
+
+type aliasToRestore struct {
+	db         string
+	collection string
+	alias      string
+}
+
+// aliasesToRestore lists the aliases of the collections to restore with their aliases, sorted by database and alias.
+// An alias is unique in a database, it is an error if collections restored into one database have the same alias.
+func aliasesToRestore(tasks []*backuppb.RestoreCollectionTask) ([]aliasToRestore, error) {
+	aliases := make([]aliasToRestore, 0)
+	aliasCollections := make(map[string]string)
+	for _, task := range tasks {
+		if !task.GetRestoreAliases() {
+			continue
+		}
+		for _, alias := range task.GetCollBackup().GetAliases() {
+			key := task.GetTargetDbName() + "." + alias
+			if collection, ok := aliasCollections[key]; ok {
+				if collection != task.GetTargetCollectionName() {
+					return nil, fmt.Errorf("alias %s in database %s is of both collection %s and %s", alias, task.GetTargetDbName(), collection, task.GetTargetCollectionName())
+				}
+				continue
+			}
+			aliasCollections[key] = task.GetTargetCollectionName()
+			aliases = append(aliases, aliasToRestore{db: task.GetTargetDbName(), collection: task.GetTargetCollectionName(), alias: alias})
+		}
+	}
+	sort.Slice(aliases, func(i, j int) bool {
+		if aliases[i].db != aliases[j].db {
+			return aliases[i].db < aliases[j].db
+		}
+		return aliases[i].alias < aliases[j].alias
+	})
+	return aliases, nil
+}
+
+// restoreAliases creates the aliases of the restored collections
+func (b *BackupContext) restoreAliases(ctx context.Context, restoreID string, tasks []*backuppb.RestoreCollectionTask) error {
+	aliases, err := aliasesToRestore(tasks)
+	if err != nil {
+		return err
+	}
+	for _, alias := range aliases {
+		err := b.getMilvusClient().CreateAlias(ctx, alias.db, alias.collection, alias.alias)
+		if err != nil {
+			return fmt.Errorf("fail to create alias %s of collection %s.%s, err: %w", alias.alias, alias.db, alias.collection, err)
+		}
+		log.Info("restore alias", zap.String("db", alias.db), zap.String("collection", alias.collection), zap.String("alias", alias.alias))
+		b.meta.AddEvent(restoreID, EVENT_PROGRESS, "create alias "+alias.alias, withEventCollection(alias.db, alias.collection))
+	}
+	return nil
+}
+
+// validateShardsNum checks a shards num override against the max shards num of the target milvus
+func validateShardsNum(shardsNum int32, maxShardsNum int) error {
+	if shardsNum < 0 {
+		return fmt.Errorf("shards_num %d can not be negative", shardsNum)
+	}
+	if maxShardsNum > 0 && int(shardsNum) > maxShardsNum {
+		return fmt.Errorf("shards_num %d exceeds the max shards num %d of milvus, see backup.restoreMaxShardsNum", shardsNum, maxShardsNum)
+	}
+	return nil
+}
+
+// restoreDynamicField returns whether the restored collection has the dynamic field by the dynamic_field of the restore
+func restoreDynamicField(backupEnabled bool, mode string) (bool, error) {
+	switch mode {
+	case DynamicFieldKeep:
+		return backupEnabled, nil
+	case DynamicFieldEnable:
+		return true, nil
+	case DynamicFieldDisable:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unknown dynamic_field %s, support %s and %s", mode, DynamicFieldEnable, DynamicFieldDisable)
+	}
+}
+
+// checkExistingCollection returns the error restoring into the existing collection with the rows by
+// the existing_collection_policy of the restore, an empty collection is always restored into
+func checkExistingCollection(collectionName string, rowCount int64, policy string) error {
+	switch policy {
+	case ExistingCollectionAppend:
+		return nil
+	case ExistingCollectionFail:
+		if rowCount > 0 {
+			return fmt.Errorf("collection %s to restore into already has %d rows and existing_collection_policy is %s, "+
+				"restore into an empty collection or set dropExistCollection to replace it", collectionName, rowCount, policy)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown existing_collection_policy %s, support %s", policy, ExistingCollectionFail)
+	}
+}
+
+// FullCollectionName prefixes a collection name given without a db, e.g. coll instead of db.coll, with defaultDB
+func FullCollectionName(collectionName, defaultDB string) string {
+	if strings.Contains(collectionName, ".") {
+		return collectionName
+	}
+	return defaultDB + "." + collectionName
+}
+
+// LatestBackup returns the complete backup with the latest start time whose name matches the glob pattern and which
+// contains all the collections, nil if no backup matches. An empty pattern matches all the backups.
+func LatestBackup(backups []*backuppb.BackupInfo, namePattern string, collectionNames []string, defaultDB string) (*backuppb.BackupInfo, error) {
+	if _, err := path.Match(namePattern, ""); err != nil {
+		return nil, fmt.Errorf("illegal latest_name_pattern %s, err: %w", namePattern, err)
+	}
+	var latest *backuppb.BackupInfo
+	for _, backup := range backups {
+		if backup.GetStateCode() != backuppb.BackupTaskStateCode_BACKUP_SUCCESS {
+			continue
+		}
+		if namePattern != "" {
+			if matched, _ := path.Match(namePattern, backup.GetName()); !matched {
+				continue
+			}
+		}
+		backupCollections := make(map[string]bool)
+		for _, collection := range backup.GetCollectionBackups() {
+			dbName := collection.GetDbName()
+			if dbName == "" {
+				dbName = "default"
+			}
+			backupCollections[dbName+"."+collection.GetCollectionName()] = true
+		}
+		if !lo.EveryBy(collectionNames, func(name string) bool { return backupCollections[FullCollectionName(name, defaultDB)] }) {
+			continue
+		}
+		if latest == nil || backup.GetStartTime() > latest.GetStartTime() {
+			latest = backup
+		}
+	}
+	return latest, nil
+}
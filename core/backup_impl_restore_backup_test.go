@@ -0,0 +1,230 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+func TestVerifyStagedChecksums(t *testing.T) {
+	ctx := context.Background()
+	b := newLocalBackupContext(t)
+	backupPath := BackupPath(b.backupRootPath, "incr")
+	collection := &backuppb.CollectionBackupInfo{PartitionBackups: []*backuppb.PartitionBackupInfo{{SegmentBackups: []*backuppb.SegmentBackupInfo{
+		{PartitionId: 2, SegmentId: 3, GroupId: 3, Binlogs: []*backuppb.FieldBinlog{{Binlogs: []*backuppb.Binlog{
+			{LogPath: "files/insert_log/1/2/3/100/1", Sha256: binlogChecksum([]byte("binlog 1"))},
+			{LogPath: "files/insert_log/1/2/3/101/1"},
+		}}}},
+		{PartitionId: 2, SegmentId: 4, GroupId: 4, BaseBackupName: "full", Deltalogs: []*backuppb.FieldBinlog{{Binlogs: []*backuppb.Binlog{
+			{LogPath: "files/delta_log/1/2/4/1", Sha256: binlogChecksum([]byte("binlog 2"))},
+		}}}},
+	}}}}
+	checksums := restoreBinlogChecksums(collection, "files", backupPath, "incr")
+	// the binlog without checksum is not verified, the reused segment is in the base backup
+	assert.Equal(t, map[string]string{
+		backupPath + "/binlogs/insert_log/1/2/3/3/100/1":                      binlogChecksum([]byte("binlog 1")),
+		BackupPath(b.backupRootPath, "full") + "/binlogs/delta_log/1/2/4/4/1": binlogChecksum([]byte("binlog 2")),
+	}, checksums)
+
+	// the backup path in the temp dir is absolute
+	stagingDir := b.backupRootPath + "/staging"
+	file := backupPath + "/binlogs/insert_log/1/2/3/"
+	storageClient := b.getStorageClient()
+	assert.NoError(t, storageClient.Write(ctx, b.milvusBucketName, stagingDir+file+"3/100/1", []byte("binlog 1")))
+	assert.NoError(t, storageClient.Write(ctx, b.milvusBucketName, stagingDir+file+"3/101/1", []byte("binlog without checksum")))
+	assert.NoError(t, b.verifyStagedChecksums(ctx, b.milvusBucketName, stagingDir, file, checksums))
+	assert.NoError(t, b.verifyStagedChecksums(ctx, b.milvusBucketName, stagingDir, file, map[string]string{}))
+
+	assert.NoError(t, storageClient.Write(ctx, b.milvusBucketName, stagingDir+file+"3/100/1", []byte("corrupted")))
+	err := b.verifyStagedChecksums(ctx, b.milvusBucketName, stagingDir, file, checksums)
+	assert.ErrorContains(t, err, "checksum mismatch of restored binlog "+stagingDir+file+"3/100/1")
+}
+
+func TestHasSegmentBackups(t *testing.T) {
+	collection := &backuppb.CollectionBackupInfo{
+		PartitionBackups: []*backuppb.PartitionBackupInfo{{PartitionId: 1}},
+	}
+	assert.False(t, hasSegmentBackups(collection))
+	collection.PartitionBackups = append(collection.PartitionBackups, &backuppb.PartitionBackupInfo{
+		PartitionId:    2,
+		SegmentBackups: []*backuppb.SegmentBackupInfo{{SegmentId: 3}},
+	})
+	assert.True(t, hasSegmentBackups(collection))
+}
+
+func TestCheckTargetCollectionSchema(t *testing.T) {
+	backupSchema := &backuppb.CollectionSchema{Fields: []*backuppb.FieldSchema{
+		{FieldID: 100, Name: "id", IsPrimaryKey: true, DataType: backuppb.DataType_Int64},
+		{FieldID: 101, Name: "vec", DataType: backuppb.DataType_FloatVector},
+	}}
+	target := &entity.Schema{Fields: []*entity.Field{
+		{ID: 100, Name: "id", PrimaryKey: true, DataType: entity.FieldTypeInt64},
+		{ID: 101, Name: "vec", DataType: entity.FieldTypeFloatVector},
+		{ID: 102, Name: "tag", DataType: entity.FieldTypeVarChar},
+	}}
+	extraFields, err := checkTargetCollectionSchema(backupSchema, target)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tag"}, extraFields)
+
+	for _, dataType := range []entity.FieldType{entity.FieldTypeBinaryVector, entity.FieldTypeSparseVector, entity.FieldTypeBFloat16Vector} {
+		target.Fields[2].DataType = dataType
+		_, err = checkTargetCollectionSchema(backupSchema, target)
+		assert.Error(t, err)
+	}
+
+	target.Fields = target.Fields[:2]
+	target.Fields[0].PrimaryKey = false
+	_, err = checkTargetCollectionSchema(backupSchema, target)
+	assert.Error(t, err)
+
+	target.Fields[0].PrimaryKey = true
+	target.Fields[1].ID = 102
+	_, err = checkTargetCollectionSchema(backupSchema, target)
+	assert.Error(t, err)
+
+	target.Fields = target.Fields[:1]
+	_, err = checkTargetCollectionSchema(backupSchema, target)
+	assert.Error(t, err)
+}
+
+func TestPartitionsToReload(t *testing.T) {
+	collection := &backuppb.CollectionBackupInfo{
+		LoadState: LoadState_Loading,
+		PartitionBackups: []*backuppb.PartitionBackupInfo{
+			{PartitionName: "p1", LoadState: LoadState_Loaded},
+			{PartitionName: "p2", LoadState: LoadState_Loading},
+			{PartitionName: "p3", LoadState: LoadState_NotLoad},
+		},
+	}
+	all, partitionNames := partitionsToReload(collection, false)
+	assert.False(t, all)
+	assert.Equal(t, []string{"p1", "p2"}, partitionNames)
+
+	collection.PartitionBackups[2].LoadState = LoadState_Loading
+	all, _ = partitionsToReload(collection, false)
+	assert.True(t, all)
+
+	collection.LoadState = LoadState_NotLoad
+	for _, partition := range collection.GetPartitionBackups() {
+		partition.LoadState = LoadState_NotLoad
+	}
+	all, partitionNames = partitionsToReload(collection, false)
+	assert.False(t, all)
+	assert.Empty(t, partitionNames)
+
+	// only the restored partitions are loaded even if the whole collection was loaded
+	collection.LoadState = LoadState_Loaded
+	all, partitionNames = partitionsToReload(collection, true)
+	assert.False(t, all)
+	assert.Equal(t, []string{"p1", "p2", "p3"}, partitionNames)
+}
+
+func TestRestoreDynamicField(t *testing.T) {
+	for _, backupEnabled := range []bool{true, false} {
+		enabled, err := restoreDynamicField(backupEnabled, DynamicFieldKeep)
+		assert.NoError(t, err)
+		assert.Equal(t, backupEnabled, enabled)
+		enabled, err = restoreDynamicField(backupEnabled, DynamicFieldEnable)
+		assert.NoError(t, err)
+		assert.True(t, enabled)
+		enabled, err = restoreDynamicField(backupEnabled, DynamicFieldDisable)
+		assert.NoError(t, err)
+		assert.False(t, enabled)
+	}
+	_, err := restoreDynamicField(true, "drop")
+	assert.Error(t, err)
+}
+
+func TestFullCollectionName(t *testing.T) {
+	assert.Equal(t, "default.coll", FullCollectionName("coll", "default"))
+	assert.Equal(t, "prod.coll", FullCollectionName("coll", "prod"))
+	assert.Equal(t, "db1.coll", FullCollectionName("db1.coll", "prod"))
+}
+
+func TestAliasesToRestore(t *testing.T) {
+	newTask := func(db, coll string, restoreAliases bool, aliases ...string) *backuppb.RestoreCollectionTask {
+		return &backuppb.RestoreCollectionTask{
+			TargetDbName:         db,
+			TargetCollectionName: coll,
+			RestoreAliases:       restoreAliases,
+			CollBackup:           &backuppb.CollectionBackupInfo{Aliases: aliases},
+		}
+	}
+	aliases, err := aliasesToRestore([]*backuppb.RestoreCollectionTask{
+		newTask("db2", "c3", true, "a1"),
+		newTask("db1", "c2", true, "b1", "a2"),
+		newTask("db1", "c1", false, "a3"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []aliasToRestore{{"db1", "c2", "a2"}, {"db1", "c2", "b1"}, {"db2", "c3", "a1"}}, aliases)
+
+	_, err = aliasesToRestore([]*backuppb.RestoreCollectionTask{
+		newTask("db1", "c1", true, "a1"),
+		newTask("db1", "c2", true, "a1"),
+	})
+	assert.Error(t, err)
+}
+
+func TestIsRetryableBulkInsertError(t *testing.T) {
+	assert.True(t, isRetryableBulkInsertError(errBulkInsertFailed))
+	assert.True(t, isRetryableBulkInsertError(fmt.Errorf("%w, info: %s", errBulkInsertFailed, "datanode is offline")))
+	assert.True(t, isRetryableBulkInsertError(fmt.Errorf("%w: %s", errBulkInsertSubmit, "rate limit exceeded")))
+	assert.False(t, isRetryableBulkInsertError(fmt.Errorf("%w, info: %s", errBulkInsertFailed, "the field 'vec' dim mismatch")))
+	assert.False(t, isRetryableBulkInsertError(fmt.Errorf("%w: %s", errBulkInsertSubmit, "collection not found")))
+	assert.False(t, isRetryableBulkInsertError(errors.New("import task 1 timeout, no progress for more than 3600 s")))
+}
+
+func TestLatestBackup(t *testing.T) {
+	newBackup := func(name string, startTime int64, stateCode backuppb.BackupTaskStateCode, collections ...string) *backuppb.BackupInfo {
+		backup := &backuppb.BackupInfo{Name: name, StartTime: startTime, StateCode: stateCode}
+		for _, collection := range collections {
+			backup.CollectionBackups = append(backup.CollectionBackups, &backuppb.CollectionBackupInfo{CollectionName: collection})
+		}
+		return backup
+	}
+	backups := []*backuppb.BackupInfo{
+		newBackup("daily_1", 100, backuppb.BackupTaskStateCode_BACKUP_SUCCESS, "c1", "c2"),
+		newBackup("daily_2", 300, backuppb.BackupTaskStateCode_BACKUP_SUCCESS, "c1"),
+		newBackup("daily_3", 400, backuppb.BackupTaskStateCode_BACKUP_FAIL, "c1", "c2"),
+		newBackup("weekly_1", 200, backuppb.BackupTaskStateCode_BACKUP_SUCCESS, "c1", "c2"),
+	}
+
+	latest, err := LatestBackup(backups, "", nil, "default")
+	assert.NoError(t, err)
+	assert.Equal(t, "daily_2", latest.GetName())
+
+	latest, err = LatestBackup(backups, "", []string{"c2"}, "default")
+	assert.NoError(t, err)
+	assert.Equal(t, "weekly_1", latest.GetName())
+
+	latest, err = LatestBackup(backups, "daily_*", []string{"default.c2"}, "default")
+	assert.NoError(t, err)
+	assert.Equal(t, "daily_1", latest.GetName())
+
+	latest, err = LatestBackup(backups, "monthly_*", nil, "default")
+	assert.NoError(t, err)
+	assert.Nil(t, latest)
+
+	_, err = LatestBackup(backups, "daily_[", nil, "default")
+	assert.Error(t, err)
+}
+
+func TestCheckExistingCollection(t *testing.T) {
+	// an empty existing collection is restored into whatever the policy
+	assert.NoError(t, checkExistingCollection("db.c1", 0, ExistingCollectionAppend))
+	assert.NoError(t, checkExistingCollection("db.c1", 0, ExistingCollectionFail))
+
+	assert.NoError(t, checkExistingCollection("db.c1", 10, ExistingCollectionAppend))
+	err := checkExistingCollection("db.c1", 10, ExistingCollectionFail)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "db.c1")
+	assert.Contains(t, err.Error(), "10 rows")
+
+	assert.Error(t, checkExistingCollection("db.c1", 0, "overwrite"))
+}
@@ -0,0 +1,120 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+	"github.com/zilliztech/milvus-backup/internal/log"
+)
+
+const (
+	PrivilegeObjectGlobal     = "Global"
+	PrivilegeObjectCollection = "Collection"
+
+	AdminRole = "admin"
+)
+
+type requiredPrivilege struct {
+	db         string
+	object     string
+	objectName string
+	privilege  string
+}
+
+func (p requiredPrivilege) String() string {
+	return fmt.Sprintf("%s.%s:%s(%s)", p.db, p.object, p.privilege, p.objectName)
+}
+
+// restoreRequiredPrivileges lists the privileges the restore tasks will use on the target milvus
+func restoreRequiredPrivileges(tasks []*backuppb.RestoreCollectionTask) []requiredPrivilege {
+	privileges := make([]requiredPrivilege, 0)
+	for _, task := range tasks {
+		db, coll := task.GetTargetDbName(), task.GetTargetCollectionName()
+		if !task.GetSkipCreateCollection() || task.GetDropExistCollection() {
+			privileges = append(privileges, requiredPrivilege{db, PrivilegeObjectGlobal, "*", "CreateCollection"})
+		}
+		if task.GetDropExistCollection() {
+			privileges = append(privileges, requiredPrivilege{db, PrivilegeObjectCollection, coll, "DropCollection"})
+		}
+		if task.GetRestoreIndex() {
+			privileges = append(privileges, requiredPrivilege{db, PrivilegeObjectCollection, coll, "CreateIndex"})
+		}
+		// index states are checked before reload, and the existing indexes before creating them in an existing collection
+		if task.GetRestoreIndex() && (task.GetAutoReloadPreviouslyLoaded() || task.GetSkipCreateCollection()) {
+			privileges = append(privileges, requiredPrivilege{db, PrivilegeObjectCollection, coll, "IndexDetail"})
+		}
+		if task.GetDropExistIndex() {
+			privileges = append(privileges, requiredPrivilege{db, PrivilegeObjectCollection, coll, "DropIndex"})
+		}
+		if !task.GetMetaOnly() {
+			privileges = append(privileges, requiredPrivilege{db, PrivilegeObjectCollection, coll, "CreatePartition"})
+			privileges = append(privileges, requiredPrivilege{db, PrivilegeObjectCollection, coll, "Import"})
+		}
+		if task.GetAutoReloadPreviouslyLoaded() {
+			privileges = append(privileges, requiredPrivilege{db, PrivilegeObjectCollection, coll, "Load"})
+		}
+		if task.GetRestoreAliases() && len(task.GetCollBackup().GetAliases()) > 0 {
+			privileges = append(privileges, requiredPrivilege{db, PrivilegeObjectGlobal, "*", "CreateAlias"})
+		}
+	}
+	return lo.Uniq(privileges)
+}
+
+// isPrivilegeGranted checks the grants of one db cover the privilege, the target collections usually
+// don't exist yet, so a collection privilege must be granted on the exact name or on all collections
+func isPrivilegeGranted(grants []entity.RoleGrants, privilege requiredPrivilege) bool {
+	return lo.ContainsBy(grants, func(grant entity.RoleGrants) bool {
+		if grant.Object != privilege.object {
+			return false
+		}
+		if grant.ObjectName != "*" && grant.ObjectName != privilege.objectName {
+			return false
+		}
+		return grant.PrivilegeName == privilege.privilege || grant.PrivilegeName == "*" || grant.PrivilegeName == "All"
+	})
+}
+
+// checkRestorePrivileges returns an error listing all the missing privileges of the milvus user
+func (b *BackupContext) checkRestorePrivileges(ctx context.Context, tasks []*backuppb.RestoreCollectionTask) error {
+	user := b.params.MilvusCfg.User
+	userDesc, err := b.getMilvusClient().DescribeUser(ctx, user)
+	if err != nil {
+		return fmt.Errorf("fail to describe milvus user %s, err: %w", user, err)
+	}
+	if lo.Contains(userDesc.Roles, AdminRole) {
+		log.Info("milvus user has admin role, skip privileges check", zap.String("user", user))
+		return nil
+	}
+
+	// grants of all roles of the user, by db
+	dbGrants := make(map[string][]entity.RoleGrants)
+	missing := make([]string, 0)
+	for _, privilege := range restoreRequiredPrivileges(tasks) {
+		grants, ok := dbGrants[privilege.db]
+		if !ok {
+			grants = make([]entity.RoleGrants, 0)
+			for _, role := range userDesc.Roles {
+				roleGrants, err := b.getMilvusClient().ListGrants(ctx, role, privilege.db)
+				if err != nil {
+					return fmt.Errorf("fail to list grants of role %s, err: %w", role, err)
+				}
+				grants = append(grants, roleGrants...)
+			}
+			dbGrants[privilege.db] = grants
+		}
+		if !isPrivilegeGranted(grants, privilege) {
+			missing = append(missing, privilege.String())
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("milvus user %s with roles %v lacks privileges: %s", user, userDesc.Roles, strings.Join(missing, ", "))
+	}
+	log.Info("milvus user has all the privileges to restore", zap.String("user", user), zap.Strings("roles", userDesc.Roles))
+	return nil
+}
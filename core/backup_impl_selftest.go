@@ -0,0 +1,180 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"go.uber.org/zap"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+	"github.com/zilliztech/milvus-backup/internal/log"
+)
+
+const (
+	selfTestRows       = 100
+	selfTestDim        = 4
+	selfTestDB         = "default"
+	selfTestSuffix     = "_restored"
+	selfTestPKField    = "id"
+	selfTestVecField   = "vec"
+	selfTestNamePrefix = "milvus_backup_selftest_"
+)
+
+// SelfTestStage is the result of one stage of SelfTest, Err is nil if the stage passed
+type SelfTestStage struct {
+	Name string
+	Err  error
+}
+
+// SelfTest backs up and restores a tiny temporary collection to validate the config end to end:
+// milvus connection, storage read, write and copy, flush and bulk insert.
+// It stops at the first failed stage, the temporary collections and backup are always cleaned up in the last stage.
+func (b *BackupContext) SelfTest(ctx context.Context) (stages []SelfTestStage) {
+	log.Info("receive SelfTest")
+	if b.params.BackupCfg.ReadOnly {
+		return []SelfTestStage{{Name: "read-only check", Err: ErrReadOnly}}
+	}
+	stages = make([]SelfTestStage, 0)
+	run := func(name string, fn func() error) bool {
+		err := fn()
+		stages = append(stages, SelfTestStage{Name: name, Err: err})
+		if err != nil {
+			log.Warn("self test stage failed", zap.String("stage", name), zap.Error(err))
+		}
+		return err == nil
+	}
+
+	suffix := strconv.FormatInt(time.Now().Unix(), 10)
+	collectionName := selfTestNamePrefix + suffix
+	restoredName := collectionName + selfTestSuffix
+	backupName := selfTestNamePrefix + "backup_" + suffix
+	// stages is the named result, so the cleanup stage run after the return is in the result
+	defer func() {
+		run("cleanup", func() error { return b.cleanupSelfTest(ctx, collectionName, restoredName, backupName) })
+	}()
+
+	if !run("connect milvus", func() error {
+		_, err := b.getMilvusClient().GetVersion(ctx)
+		return err
+	}) {
+		return stages
+	}
+	if !run("storage write copy read", func() error { return b.selfTestStorage(ctx, selfTestNamePrefix+suffix) }) {
+		return stages
+	}
+	if !run("create collection", func() error { return b.createSelfTestCollection(ctx, collectionName) }) {
+		return stages
+	}
+	if !run("backup", func() error {
+		resp := b.CreateBackup(ctx, &backuppb.CreateBackupRequest{
+			BackupName:      backupName,
+			CollectionNames: []string{collectionName},
+		})
+		if resp.GetCode() != backuppb.ResponseCode_Success {
+			return errors.New(resp.GetMsg())
+		}
+		return nil
+	}) {
+		return stages
+	}
+	if !run("restore", func() error {
+		resp := b.RestoreBackup(ctx, &backuppb.RestoreBackupRequest{
+			BackupName:       backupName,
+			CollectionNames:  []string{collectionName},
+			CollectionSuffix: selfTestSuffix,
+		})
+		if resp.GetCode() != backuppb.ResponseCode_Success {
+			return errors.New(resp.GetMsg())
+		}
+		return nil
+	}) {
+		return stages
+	}
+	run("verify", func() error {
+		stats, err := b.getMilvusClient().GetCollectionStatistics(ctx, selfTestDB, restoredName)
+		if err != nil {
+			return err
+		}
+		if stats["row_count"] != strconv.Itoa(selfTestRows) {
+			return fmt.Errorf("restored collection has %s rows, expected %d", stats["row_count"], selfTestRows)
+		}
+		return nil
+	})
+	return stages
+}
+
+// selfTestStorage writes an object in the milvus path, copies it to the backup path and reads it back, like backup and restore do
+func (b *BackupContext) selfTestStorage(ctx context.Context, name string) error {
+	milvusPath := b.milvusRootPath + SEPERATOR + name
+	backupPath := b.backupRootPath + SEPERATOR + name
+	content := []byte(name)
+	if err := b.getStorageClient().Write(ctx, b.milvusBucketName, milvusPath, content); err != nil {
+		return fmt.Errorf("fail to write %s, err: %w", milvusPath, err)
+	}
+	defer b.getStorageClient().Remove(ctx, b.milvusBucketName, milvusPath)
+	if err := b.getStorageClient().Copy(ctx, b.milvusBucketName, b.backupBucketName, milvusPath, backupPath); err != nil {
+		return fmt.Errorf("fail to copy %s to %s, err: %w", milvusPath, backupPath, err)
+	}
+	defer b.getStorageClient().Remove(ctx, b.backupBucketName, backupPath)
+	read, err := b.getStorageClient().Read(ctx, b.backupBucketName, backupPath)
+	if err != nil {
+		return fmt.Errorf("fail to read %s, err: %w", backupPath, err)
+	}
+	if string(read) != string(content) {
+		return fmt.Errorf("content of %s differs from the written one", backupPath)
+	}
+	return nil
+}
+
+func (b *BackupContext) createSelfTestCollection(ctx context.Context, collectionName string) error {
+	schema := entity.NewSchema().WithName(collectionName).WithDescription("temporary collection of milvus-backup selftest").
+		WithField(entity.NewField().WithName(selfTestPKField).WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName(selfTestVecField).WithDataType(entity.FieldTypeFloatVector).WithDim(selfTestDim))
+	if err := b.getMilvusClient().CreateCollection(ctx, selfTestDB, schema, 1); err != nil {
+		return err
+	}
+	ids := make([]int64, selfTestRows)
+	vectors := make([][]float32, selfTestRows)
+	for i := range ids {
+		ids[i] = int64(i)
+		vectors[i] = make([]float32, selfTestDim)
+		for j := range vectors[i] {
+			vectors[i][j] = float32(i*selfTestDim + j)
+		}
+	}
+	_, err := b.getMilvusClient().Insert(ctx, selfTestDB, collectionName,
+		entity.NewColumnInt64(selfTestPKField, ids), entity.NewColumnFloatVector(selfTestVecField, selfTestDim, vectors))
+	return err
+}
+
+// cleanupSelfTest removes what the self test created, the ones not created are skipped
+func (b *BackupContext) cleanupSelfTest(ctx context.Context, collectionName, restoredName, backupName string) error {
+	var errs []error
+	for _, name := range []string{collectionName, restoredName} {
+		exist, err := b.getMilvusClient().HasCollection(ctx, selfTestDB, name)
+		if err == nil && exist {
+			err = b.getMilvusClient().DropCollection(ctx, selfTestDB, name)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("fail to drop collection %s, err: %w", name, err))
+		}
+	}
+	exist, err := b.HasBackup(ctx, backupName)
+	if err == nil && exist {
+		resp := b.DeleteBackup(ctx, &backuppb.DeleteBackupRequest{BackupName: backupName})
+		if resp.GetCode() != backuppb.ResponseCode_Success {
+			err = errors.New(resp.GetMsg())
+		}
+	}
+	if err != nil {
+		errs = append(errs, fmt.Errorf("fail to delete backup %s, err: %w", backupName, err))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%v", errs)
+	}
+	return nil
+}
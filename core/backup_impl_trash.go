@@ -0,0 +1,209 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zilliztech/milvus-backup/internal/log"
+)
+
+// trashEntry is a backup soft deleted with backup.softDelete
+type trashEntry struct {
+	name      string
+	deletedAt int64
+}
+
+func (e trashEntry) String() string {
+	return fmt.Sprintf("%s (deleted at %s)", e.name, time.Unix(e.deletedAt, 0).UTC().Format(time.RFC3339))
+}
+
+// checkTrashRootPath fails the trash operations without a backup root path, the trash would be in the bucket root
+// beside the backups instead of beside the backup root path
+func (b *BackupContext) checkTrashRootPath() error {
+	if strings.Trim(b.backupRootPath, SEPERATOR) == "" {
+		return errors.New("the trash of backup.softDelete needs a non-empty minio.backupRootPath")
+	}
+	return nil
+}
+
+// moveBackupToTrash copies the backup to the trash and removes it, a crash in between leaves the backup in place
+func (b *BackupContext) moveBackupToTrash(ctx context.Context, backupName string) error {
+	if err := b.checkTrashRootPath(); err != nil {
+		return err
+	}
+	deletedAt := time.Now().Unix()
+	trashPath := TrashBackupPath(b.backupRootPath, backupName, deletedAt)
+	if err := b.copyBackupObjects(ctx, b.backupPathOf(backupName), trashPath, true); err != nil {
+		return fmt.Errorf("fail to move backup %s to the trash, err: %w", backupName, err)
+	}
+	if err := b.removeBackupObjects(ctx, backupName); err != nil {
+		return err
+	}
+	log.Info("moved backup to the trash", zap.String("backupName", backupName), zap.String("trashPath", trashPath))
+
+	// the trash of the expired backups is removed with every delete, a failure only leaves them for purge-trash
+	if b.params.BackupCfg.TrashRetentionSeconds > 0 {
+		if _, err := b.purgeTrash(ctx, false, false); err != nil {
+			log.Warn("fail to purge the expired backups in the trash", zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// listTrash returns the soft deleted backups sorted by the delete time, the same name can be deleted more than once
+func (b *BackupContext) listTrash(ctx context.Context) ([]trashEntry, error) {
+	if err := b.checkTrashRootPath(); err != nil {
+		return nil, err
+	}
+	keys, _, err := b.getStorageClient().ListWithPrefix(ctx, b.backupBucketName, TrashRootPath(b.backupRootPath)+SEPERATOR, true)
+	if err != nil {
+		return nil, fmt.Errorf("fail to list the trash, err: %w", err)
+	}
+	entries := make([]trashEntry, 0)
+	for _, key := range keys {
+		if name, deletedAt, ok := TrashMetaPathToBackup(b.backupRootPath, key); ok {
+			entries = append(entries, trashEntry{name: name, deletedAt: deletedAt})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].deletedAt < entries[j].deletedAt
+	})
+	return entries, nil
+}
+
+// UndeleteBackup recovers the last soft deleted backup of the name from the trash.
+// All its objects are copied back, the meta last, so the backup is only readable once complete.
+func (b *BackupContext) UndeleteBackup(ctx context.Context, backupName string) error {
+	log.Info("receive UndeleteBackup", zap.String("backupName", backupName))
+	if !b.started {
+		err := b.Start()
+		if err != nil {
+			return err
+		}
+	}
+	if b.params.BackupCfg.ReadOnly {
+		return ErrReadOnly
+	}
+	if backupName == "" {
+		return errors.New("empty backup name")
+	}
+
+	entries, err := b.listTrash(ctx)
+	if err != nil {
+		return err
+	}
+	var entry *trashEntry
+	for i := range entries {
+		if entries[i].name == backupName {
+			entry = &entries[i]
+		}
+	}
+	if entry == nil {
+		return fmt.Errorf("backup %s not found in the trash", backupName)
+	}
+
+	backupPath := b.backupPathOf(backupName)
+	exist, err := b.getStorageClient().Exist(ctx, b.backupBucketName, backupPath+SEPERATOR+META_PREFIX+SEPERATOR+BACKUP_META_FILE)
+	if err != nil {
+		return fmt.Errorf("fail to check backup %s exist, err: %w", backupName, err)
+	}
+	if exist {
+		return fmt.Errorf("backup already exist with the name: %s", backupName)
+	}
+
+	trashPath := TrashBackupPath(b.backupRootPath, backupName, entry.deletedAt)
+	if err := b.copyBackupObjects(ctx, trashPath, backupPath, true); err != nil {
+		return fmt.Errorf("fail to copy backup %s from the trash, err: %w", backupName, err)
+	}
+	if err := b.getStorageClient().RemoveWithPrefix(ctx, b.backupBucketName, trashPath+SEPERATOR); err != nil {
+		log.Warn("backup is undeleted but fail to remove it from the trash, it will be purged later",
+			zap.String("backupName", backupName), zap.String("trashPath", trashPath), zap.Error(err))
+	}
+	log.Info("finish UndeleteBackup", zap.String("backupName", backupName), zap.Int64("deletedAt", entry.deletedAt))
+	return nil
+}
+
+// PurgeTrash removes the backups deleted more than backup.trashRetentionSeconds ago from the trash, or all of them,
+// and returns the removed ones. With dryRun, they are only listed. The bases of incremental backups are kept.
+func (b *BackupContext) PurgeTrash(ctx context.Context, all, dryRun bool) ([]string, error) {
+	log.Info("receive PurgeTrash", zap.Bool("all", all), zap.Bool("dryRun", dryRun))
+	if !b.started {
+		err := b.Start()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if b.params.BackupCfg.ReadOnly && !dryRun {
+		return nil, ErrReadOnly
+	}
+	return b.purgeTrash(ctx, all, dryRun)
+}
+
+func (b *BackupContext) purgeTrash(ctx context.Context, all, dryRun bool) ([]string, error) {
+	entries, err := b.listTrash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dependents, err := b.incrementalDependents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	retention := b.params.BackupCfg.TrashRetentionSeconds
+	expiredBefore := time.Now().Unix() - retention
+	purged := make([]string, 0)
+	for _, entry := range entries {
+		if !all && (retention == 0 || entry.deletedAt >= expiredBefore) {
+			continue
+		}
+		// a base deleted with force is kept in the trash while incremental backups reuse its segments
+		if names := dependents[entry.name]; len(names) > 0 {
+			log.Warn("keep the base of incremental backups in the trash", zap.String("backupName", entry.name),
+				zap.Strings("incrementalBackups", names))
+			continue
+		}
+		if !dryRun {
+			trashPath := TrashBackupPath(b.backupRootPath, entry.name, entry.deletedAt)
+			if err := b.getStorageClient().RemoveWithPrefix(ctx, b.backupBucketName, trashPath+SEPERATOR); err != nil {
+				return purged, fmt.Errorf("fail to purge backup %s from the trash, err: %w", entry.name, err)
+			}
+		}
+		purged = append(purged, entry.String())
+	}
+	log.Info("purged the trash", zap.Strings("backups", purged), zap.Bool("dryRun", dryRun))
+	return purged, nil
+}
+
+// TrashRootPath is beside the backup root path, so that the trash is not listed as backups or removed as orphans
+func TrashRootPath(backupRootPath string) string {
+	return strings.TrimSuffix(backupRootPath, SEPERATOR) + TRASH_SUFFIX
+}
+
+// TrashBackupPath is the path of a backup soft deleted at deletedAt (unix seconds), without the trailing separator
+func TrashBackupPath(backupRootPath, backupName string, deletedAt int64) string {
+	return NestedBackupPath(TrashRootPath(backupRootPath)+SEPERATOR+strconv.FormatInt(deletedAt, 10), backupName)
+}
+
+// TrashMetaPathToBackup returns the name and delete time of a soft deleted backup by the path of its backup meta file
+func TrashMetaPathToBackup(backupRootPath, metaPath string) (string, int64, bool) {
+	suffix := SEPERATOR + META_PREFIX + SEPERATOR + BACKUP_META_FILE
+	prefix := TrashRootPath(backupRootPath) + SEPERATOR
+	if !strings.HasPrefix(metaPath, prefix) || !strings.HasSuffix(metaPath, suffix) {
+		return "", 0, false
+	}
+	elems := strings.SplitN(strings.TrimSuffix(strings.TrimPrefix(metaPath, prefix), suffix), SEPERATOR, 2)
+	if len(elems) != 2 || elems[1] == "" {
+		return "", 0, false
+	}
+	deletedAt, err := strconv.ParseInt(elems[0], 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return NestedBackupPathToName("", elems[1]), deletedAt, true
+}
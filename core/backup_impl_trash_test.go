@@ -0,0 +1,113 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrashFlows(t *testing.T) {
+	ctx := context.Background()
+	b := newLocalBackupContext(t)
+	b.started = true
+	b.params.BackupCfg.TrashRetentionSeconds = 0
+	files := map[string]string{
+		"meta/backup_meta.json":        "{}",
+		"binlogs/insert_log/1/2/3/4/5": "binlog",
+		"channel_position/cp.json":     "cp",
+		"summary.json":                 "summary",
+	}
+	backupPath := b.backupPathOf("b1")
+	write := func() {
+		for path, content := range files {
+			assert.NoError(t, b.getStorageClient().Write(ctx, b.backupBucketName, backupPath+SEPERATOR+path, []byte(content)))
+		}
+	}
+	assertFiles := func(path string, exist bool) {
+		for file, content := range files {
+			data, err := b.getStorageClient().Read(ctx, b.backupBucketName, path+SEPERATOR+file)
+			if exist {
+				assert.NoError(t, err, file)
+				assert.Equal(t, content, string(data))
+			} else {
+				assert.Error(t, err, file)
+			}
+		}
+	}
+	write()
+
+	// move to the trash and undelete, every object is moved both ways
+	assert.NoError(t, b.moveBackupToTrash(ctx, "b1"))
+	assertFiles(backupPath, false)
+	entries, err := b.listTrash(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "b1", entries[0].name)
+	trashPath := TrashBackupPath(b.backupRootPath, "b1", entries[0].deletedAt)
+	assertFiles(trashPath, true)
+
+	assert.Error(t, b.UndeleteBackup(ctx, "b2"))
+	write()
+	err = b.UndeleteBackup(ctx, "b1")
+	assert.ErrorContains(t, err, "already exist")
+	assert.NoError(t, b.removeBackupObjects(ctx, "b1"))
+	assert.NoError(t, b.UndeleteBackup(ctx, "b1"))
+	assertFiles(backupPath, true)
+	assertFiles(trashPath, false)
+	entries, err = b.listTrash(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 0)
+
+	// purge only removes the expired backups, or all of them
+	assert.NoError(t, b.moveBackupToTrash(ctx, "b1"))
+	purged, err := b.PurgeTrash(ctx, false, false)
+	assert.NoError(t, err)
+	assert.Len(t, purged, 0)
+	purged, err = b.PurgeTrash(ctx, true, true)
+	assert.NoError(t, err)
+	assert.Len(t, purged, 1)
+	entries, err = b.listTrash(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	trashPath = TrashBackupPath(b.backupRootPath, "b1", entries[0].deletedAt)
+	assertFiles(trashPath, true)
+	purged, err = b.PurgeTrash(ctx, true, false)
+	assert.NoError(t, err)
+	assert.Len(t, purged, 1)
+	entries, err = b.listTrash(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 0)
+	assertFiles(trashPath, false)
+
+	// without a backup root path, the trash would be mixed with the backups
+	b.backupRootPath = ""
+	assert.ErrorContains(t, b.moveBackupToTrash(ctx, "b1"), "minio.backupRootPath")
+	assert.ErrorContains(t, b.UndeleteBackup(ctx, "b1"), "minio.backupRootPath")
+	_, err = b.PurgeTrash(ctx, true, false)
+	assert.ErrorContains(t, err, "minio.backupRootPath")
+}
+
+func TestTrashBackupPath(t *testing.T) {
+	assert.Equal(t, "backup_trash", TrashRootPath("backup"))
+	assert.Equal(t, "backup_trash/1700000000/my%20backup", TrashBackupPath("backup", "my backup", 1700000000))
+	assert.Equal(t, "backup_trash/1700000000/2024/01/backup_x", TrashBackupPath("backup", "2024/01/backup_x", 1700000000))
+
+	for _, name := range []string{"my backup", "2024/01/backup_x"} {
+		metaPath := TrashBackupPath("backup", name, 1700000000) + "/meta/backup_meta.json"
+		backupName, deletedAt, ok := TrashMetaPathToBackup("backup", metaPath)
+		assert.True(t, ok)
+		assert.Equal(t, name, backupName)
+		assert.Equal(t, int64(1700000000), deletedAt)
+	}
+
+	for _, metaPath := range []string{
+		"backup/my_backup/meta/backup_meta.json",
+		"backup_trash/1700000000/my_backup/meta/collection_meta.json",
+		"backup_trash/1700000000/meta/backup_meta.json",
+		"backup_trash/abc/my_backup/meta/backup_meta.json",
+	} {
+		_, _, ok := TrashMetaPathToBackup("backup", metaPath)
+		assert.False(t, ok, metaPath)
+	}
+}
@@ -0,0 +1,220 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+	"github.com/zilliztech/milvus-backup/internal/log"
+)
+
+// checkBaseBackup returns the base backup of an incremental backup, or the reason if it can't be the base
+func (b *BackupContext) checkBaseBackup(ctx context.Context, request *backuppb.CreateBackupRequest) (*backuppb.BackupInfo, string) {
+	baseName := request.GetBaseBackupName()
+	if request.GetMetaOnly() || request.GetSchemaTemplateOnly() {
+		return nil, "an incremental backup copies data, it can not be meta only or a schema template"
+	}
+	if baseName == request.GetBackupName() {
+		return nil, fmt.Sprintf("backup %s can not be the base of itself", baseName)
+	}
+	base, err := b.getBaseBackup(ctx, baseName)
+	if err != nil {
+		return nil, err.Error()
+	}
+	if base.GetStateCode() != backuppb.BackupTaskStateCode_BACKUP_SUCCESS {
+		return nil, fmt.Sprintf("base backup %s is %s, only a complete backup can be the base", baseName, base.GetStateCode())
+	}
+	if base.GetSchemaTemplateOnly() {
+		return nil, fmt.Sprintf("base backup %s is a schema template without data", baseName)
+	}
+	// the binlogs of the base are reused as they are, so the incremental backup must be encrypted like the base
+	if encrypted := base.GetEncryptionScheme() != ""; encrypted != b.params.BackupCfg.EncryptionEnable {
+		return nil, fmt.Sprintf("base backup %s is encrypted: %t, but backup.encryption.enable is %t",
+			baseName, encrypted, b.params.BackupCfg.EncryptionEnable)
+	}
+	return base, ""
+}
+
+func (b *BackupContext) getBaseBackup(ctx context.Context, baseName string) (*backuppb.BackupInfo, error) {
+	resp := b.GetBackup(ctx, &backuppb.GetBackupRequest{BackupName: baseName})
+	if resp.GetCode() != backuppb.ResponseCode_Success {
+		return nil, fmt.Errorf("fail to get base backup %s: %s", baseName, resp.GetMsg())
+	}
+	if resp.GetData() == nil {
+		return nil, fmt.Errorf("base backup %s doesn't exist", baseName)
+	}
+	return resp.GetData(), nil
+}
+
+// loadBaseSegments returns the segments of the base backup by id, the segments reused by the base from its own base
+// keep the name of the backup holding their binlogs, so that a chain of incremental backups is restored from
+// the backups actually holding the binlogs
+func (b *BackupContext) loadBaseSegments(ctx context.Context, baseName string) (map[int64]*backuppb.SegmentBackupInfo, error) {
+	base, err := b.getBaseBackup(ctx, baseName)
+	if err != nil {
+		return nil, err
+	}
+	segments := make(map[int64]*backuppb.SegmentBackupInfo)
+	for _, collection := range base.GetCollectionBackups() {
+		for _, partition := range collection.GetPartitionBackups() {
+			for _, segment := range partition.GetSegmentBackups() {
+				segment = proto.Clone(segment).(*backuppb.SegmentBackupInfo)
+				if segment.GetBaseBackupName() == "" {
+					segment.BaseBackupName = baseName
+				}
+				segments[segment.GetSegmentId()] = segment
+			}
+		}
+	}
+	log.Info("load the segments of the base backup", zap.String("baseBackupName", baseName), zap.Int("segments", len(segments)))
+	return segments, nil
+}
+
+// markBaseSegments marks the segments unchanged since the base backup, their binlogs are not copied again.
+// The segments of the incremental backup have the binlogs listed by fillSegmentsBackupInfo. A reused segment is in
+// its own group like in the base, so its binlogs are at the same paths under the base.
+func (b *BackupContext) markBaseSegments(segments []*backuppb.SegmentBackupInfo) int {
+	reused := 0
+	for _, segment := range segments {
+		base, ok := b.baseSegments[segment.GetSegmentId()]
+		if !ok || !BaseSegmentReusable(segment, base) {
+			continue
+		}
+		b.meta.UpdateSegment(segment.GetPartitionId(), segment.GetSegmentId(), setSegmentBaseBackup(base.GetBaseBackupName()))
+		reused++
+	}
+	return reused
+}
+
+// baseBackupNames returns the backups holding the segments reused by an incremental backup, sorted
+func baseBackupNames(backup *backuppb.BackupInfo) []string {
+	names := make([]string, 0)
+	for _, collection := range backup.GetCollectionBackups() {
+		for _, partition := range collection.GetPartitionBackups() {
+			for _, segment := range partition.GetSegmentBackups() {
+				if segment.GetBaseBackupName() != "" {
+					names = append(names, segment.GetBaseBackupName())
+				}
+			}
+		}
+	}
+	names = lo.Uniq(names)
+	sort.Strings(names)
+	return names
+}
+
+// checkNotIncremental fails an operation on the objects of one backup, e.g. export or relocate, if the backup is incremental,
+// as the segments it reuses are in the base backups and the operation would leave them out
+func checkNotIncremental(backup *backuppb.BackupInfo, op string) error {
+	if baseNames := baseBackupNames(backup); len(baseNames) > 0 {
+		return fmt.Errorf("backup %s reuses segments of the base backups %v, %s of an incremental backup is not supported",
+			backup.GetName(), baseNames, op)
+	}
+	return nil
+}
+
+// checkBaseBackupsExist fails the restore of an incremental backup early if a backup holding its segments is removed
+func (b *BackupContext) checkBaseBackupsExist(ctx context.Context, request *backuppb.RestoreBackupRequest, backup *backuppb.BackupInfo) error {
+	for _, baseName := range baseBackupNames(backup) {
+		resp := b.GetBackup(ctx, &backuppb.GetBackupRequest{
+			BackupName:    baseName,
+			BucketName:    request.GetBucketName(),
+			Path:          request.GetPath(),
+			WithoutDetail: true,
+		})
+		if resp.GetCode() != backuppb.ResponseCode_Success || resp.GetData() == nil {
+			return fmt.Errorf("base backup %s holding segments of the incremental backup %s is not available: %s",
+				baseName, backup.GetName(), resp.GetMsg())
+		}
+	}
+	return nil
+}
+
+// incrementalDependents maps the backups holding segments of incremental backups to the incremental backups reusing
+// them. A base backup can't be removed or renamed without breaking the restore of its dependents.
+func (b *BackupContext) incrementalDependents(ctx context.Context) (map[string][]string, error) {
+	resp := b.ListBackups(ctx, &backuppb.ListBackupsRequest{})
+	if resp.GetCode() != backuppb.ResponseCode_Success {
+		return nil, fmt.Errorf("fail to list the backups to find incremental backups: %s", resp.GetMsg())
+	}
+	dependents := make(map[string][]string)
+	for _, backup := range resp.GetData() {
+		for _, baseName := range baseBackupNames(backup) {
+			dependents[baseName] = append(dependents[baseName], backup.GetName())
+		}
+	}
+	return dependents, nil
+}
+
+// checkNoIncrementalDependents fails if the backup is the base of incremental backups
+func (b *BackupContext) checkNoIncrementalDependents(ctx context.Context, backupName string) error {
+	dependents, err := b.incrementalDependents(ctx)
+	if err != nil {
+		return err
+	}
+	if names := dependents[backupName]; len(names) > 0 {
+		return fmt.Errorf("backup %s is the base of the incremental backups %v", backupName, names)
+	}
+	return nil
+}
+
+// segmentBinlogKeys lists the path and size of all the binlogs and index files of the segment, sorted
+func segmentBinlogKeys(segment *backuppb.SegmentBackupInfo) []string {
+	keys := make([]string, 0)
+	for _, fieldBinlogs := range [][]*backuppb.FieldBinlog{segment.GetBinlogs(), segment.GetDeltalogs(), segment.GetStatslogs(), indexFieldBinlogs(segment)} {
+		for _, binlogs := range fieldBinlogs {
+			for _, binlog := range binlogs.GetBinlogs() {
+				keys = append(keys, fmt.Sprintf("%s:%d", binlog.GetLogPath(), binlog.GetLogSize()))
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// BaseSegmentReusable returns whether an incremental backup can reuse the binlogs of the segment in its base backup
+// instead of copying them. Sealed segments are immutable except their delta logs, so the segment is reused only if
+// it has exactly the same binlogs in the base. The group of the segment in the base must be the segment itself,
+// so that restore imports the group without the other segments of the base. L0 segments are always copied.
+func BaseSegmentReusable(segment, base *backuppb.SegmentBackupInfo) bool {
+	if base == nil || segment.GetIsL0() || base.GetIsL0() || !base.GetBackuped() {
+		return false
+	}
+	if base.GetPartitionId() != segment.GetPartitionId() || base.GetGroupId() != base.GetSegmentId() {
+		return false
+	}
+	keys, baseKeys := segmentBinlogKeys(segment), segmentBinlogKeys(base)
+	if len(keys) == 0 || len(keys) != len(baseKeys) {
+		return false
+	}
+	for i := range keys {
+		if keys[i] != baseKeys[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// BaseBackupPath is the path of the base backup of an incremental backup at backupPath, under the same root path
+// and in the same layout, flat or nested
+func BaseBackupPath(backupPath, backupName, baseName string) string {
+	if nested := NestedBackupPath("", backupName); strings.HasSuffix(backupPath, nested) {
+		return NestedBackupPath(strings.TrimSuffix(backupPath, nested), baseName)
+	}
+	return BackupPath(strings.TrimSuffix(backupPath, BackupPath("", backupName)), baseName)
+}
+
+// SegmentBackupPath is the path of the backup holding the binlogs of a segment of the backup at backupPath,
+// the base backup for a segment reused by an incremental backup. Create, export and restore resolve it the same way.
+func SegmentBackupPath(backupPath, backupName string, segment *backuppb.SegmentBackupInfo) string {
+	if segment.GetBaseBackupName() == "" {
+		return backupPath
+	}
+	return BaseBackupPath(backupPath, backupName, segment.GetBaseBackupName())
+}
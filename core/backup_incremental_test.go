@@ -0,0 +1,133 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+func TestIncrementalDependents(t *testing.T) {
+	ctx := context.Background()
+	b := newLocalBackupContext(t)
+	b.started = true
+	backupWithSegment := func(name, baseName string) *backuppb.BackupInfo {
+		return &backuppb.BackupInfo{
+			Id:        name,
+			Name:      name,
+			StateCode: backuppb.BackupTaskStateCode_BACKUP_SUCCESS,
+			CollectionBackups: []*backuppb.CollectionBackupInfo{{
+				Id:           name,
+				CollectionId: 1,
+				PartitionBackups: []*backuppb.PartitionBackupInfo{{
+					CollectionId:   1,
+					PartitionId:    2,
+					SegmentBackups: []*backuppb.SegmentBackupInfo{{CollectionId: 1, PartitionId: 2, SegmentId: 3, BaseBackupName: baseName}},
+				}},
+			}},
+		}
+	}
+	writeLocalBackup(t, b, backupWithSegment("base", ""))
+	writeLocalBackup(t, b, backupWithSegment("incr", "base"))
+
+	dependents, err := b.incrementalDependents(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{"base": {"incr"}}, dependents)
+
+	// the base is neither renamed nor deleted without force, the incremental backup is
+	assert.ErrorContains(t, b.RenameBackup(ctx, "base", "base2"), "base of the incremental backups [incr]")
+	resp := b.DeleteBackup(ctx, &backuppb.DeleteBackupRequest{BackupName: "base"})
+	assert.Equal(t, backuppb.ResponseCode_Fail, resp.GetCode())
+	assert.Contains(t, resp.GetMsg(), "delete with force")
+	exist, err := b.HasBackup(ctx, "base")
+	assert.NoError(t, err)
+	assert.True(t, exist)
+
+	// a base deleted with force into the trash is kept by purge while the incremental backup exists
+	b.params.BackupCfg.SoftDelete = true
+	resp = b.DeleteBackup(ctx, &backuppb.DeleteBackupRequest{BackupName: "base", Force: true})
+	assert.Equal(t, backuppb.ResponseCode_Success, resp.GetCode(), resp.GetMsg())
+	purged, err := b.PurgeTrash(ctx, true, false)
+	assert.NoError(t, err)
+	assert.Len(t, purged, 0)
+
+	resp = b.DeleteBackup(ctx, &backuppb.DeleteBackupRequest{BackupName: "incr"})
+	assert.Equal(t, backuppb.ResponseCode_Success, resp.GetCode(), resp.GetMsg())
+	purged, err = b.PurgeTrash(ctx, true, false)
+	assert.NoError(t, err)
+	assert.Len(t, purged, 2)
+}
+
+func TestIncrementalBackup(t *testing.T) {
+	newSegment := func(segmentID, groupID int64, deltalogs ...string) *backuppb.SegmentBackupInfo {
+		segment := &backuppb.SegmentBackupInfo{
+			SegmentId:   segmentID,
+			PartitionId: 2,
+			GroupId:     groupID,
+			Backuped:    true,
+			Binlogs: []*backuppb.FieldBinlog{{FieldID: 100, Binlogs: []*backuppb.Binlog{
+				{LogPath: fmt.Sprintf("files/insert_log/1/2/%d/100/1", segmentID), LogSize: 10},
+			}}},
+		}
+		for _, deltalog := range deltalogs {
+			segment.Deltalogs = append(segment.Deltalogs, &backuppb.FieldBinlog{Binlogs: []*backuppb.Binlog{{LogPath: deltalog, LogSize: 5}}})
+		}
+		return segment
+	}
+
+	assert.True(t, BaseSegmentReusable(newSegment(3, 0), newSegment(3, 3)))
+	assert.False(t, BaseSegmentReusable(newSegment(3, 0), nil))
+	// new delta logs since the base
+	assert.False(t, BaseSegmentReusable(newSegment(3, 0, "files/delta_log/1/2/3/1"), newSegment(3, 3)))
+	// grouped with other segments in the base
+	assert.False(t, BaseSegmentReusable(newSegment(3, 0), newSegment(3, 1)))
+	l0 := newSegment(3, 0)
+	l0.IsL0 = true
+	assert.False(t, BaseSegmentReusable(l0, newSegment(3, 3)))
+
+	b := &BackupContext{meta: newMetaManager()}
+	b.baseSegments = map[int64]*backuppb.SegmentBackupInfo{3: newSegment(3, 3), 4: newSegment(4, 4)}
+	b.baseSegments[3].BaseBackupName = "full"
+	b.baseSegments[4].BaseBackupName = "incr_1"
+	segments := []*backuppb.SegmentBackupInfo{newSegment(3, 0), newSegment(4, 0, "files/delta_log/1/2/4/1"), newSegment(5, 0)}
+	for _, segment := range segments {
+		b.meta.AddSegment(segment)
+	}
+	assert.Equal(t, 1, b.markBaseSegments(segments))
+	assert.Equal(t, "full", b.meta.GetSegment(3).GetBaseBackupName())
+	assert.Equal(t, "", b.meta.GetSegment(4).GetBaseBackupName())
+	assert.Equal(t, "", b.meta.GetSegment(5).GetBaseBackupName())
+
+	backup := &backuppb.BackupInfo{CollectionBackups: []*backuppb.CollectionBackupInfo{{PartitionBackups: []*backuppb.PartitionBackupInfo{
+		{SegmentBackups: []*backuppb.SegmentBackupInfo{{BaseBackupName: "incr_1"}, {BaseBackupName: "full"}, {}, {BaseBackupName: "full"}}},
+	}}}}
+	assert.Equal(t, []string{"full", "incr_1"}, baseBackupNames(backup))
+
+	assert.Equal(t, "backup/full", BaseBackupPath("backup/incr_1", "incr_1", "full"))
+	assert.Equal(t, "backup/my%20full", BaseBackupPath("backup/incr%201", "incr 1", "my full"))
+	assert.Equal(t, "backup/2024/01/full", BaseBackupPath("backup/2024/02/incr", "2024/02/incr", "2024/01/full"))
+}
+
+func TestExportRelocateIncrementalBackup(t *testing.T) {
+	b := newLocalBackupContext(t)
+	b.started = true
+	writeLocalBackup(t, b, &backuppb.BackupInfo{
+		Id:             "backup-id",
+		Name:           "incr_1",
+		StateCode:      backuppb.BackupTaskStateCode_BACKUP_SUCCESS,
+		MilvusRootPath: "files",
+		CollectionBackups: []*backuppb.CollectionBackupInfo{{PartitionBackups: []*backuppb.PartitionBackupInfo{{
+			SegmentBackups: []*backuppb.SegmentBackupInfo{{SegmentId: 3, BaseBackupName: "full",
+				Binlogs: []*backuppb.FieldBinlog{{Binlogs: []*backuppb.Binlog{{LogPath: "files/insert_log/1/2/3/100/1"}}}}}},
+		}}}},
+	})
+
+	var tarball bytes.Buffer
+	assert.ErrorContains(t, b.ExportBackup(b.ctx, "incr_1", &tarball, false), "export of an incremental backup is not supported")
+	assert.Equal(t, 0, tarball.Len())
+	assert.ErrorContains(t, b.RelocateBackup(b.ctx, "incr_1", "files", "data/files"), "relocate of an incremental backup is not supported")
+}
@@ -3,9 +3,14 @@ package core
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
 	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
 )
 
@@ -15,19 +20,55 @@ const (
 	COLLECTION_META_FILE = "collection_meta.json"
 	PARTITION_META_FILE  = "partition_meta.json"
 	SEGMENT_META_FILE    = "segment_meta.json"
-	FULL_META_FILE       = "full_meta.json"
-	CP_META_FILE         = "channel_cp_meta.json"
-	SEPERATOR            = "/"
+	// segment meta shard file with the shard index
+	SEGMENT_META_SHARD_FILE = "segment_meta_%d.json"
+	FULL_META_FILE          = "full_meta.json"
+	CP_META_FILE            = "channel_cp_meta.json"
+	// human-readable summary for operators, not read by milvus-backup
+	SUMMARY_FILE = "summary.json"
+	// collections prepared by a backup before copying the data, to resume it if interrupted
+	PREPARED_META_FILE = "prepared_meta.json"
+	SEPERATOR          = "/"
+	// suffix of the backup root path of the soft deleted backups
+	TRASH_SUFFIX = "_trash"
 
 	BINGLOG_DIR    = "binlogs"
 	INSERT_LOG_DIR = "insert_log"
 	DELTA_LOG_DIR  = "delta_log"
 	STATS_LOG_DIR  = "stats_log"
+	INDEX_FILE_DIR = "index_files"
+
+	BINLOG_TYPE_INSERT = "insert"
+	BINLOG_TYPE_DELTA  = "delta"
+	BINLOG_TYPE_STATS  = "stats"
+	BINLOG_TYPE_INDEX  = "index"
 
 	LoadState_NotExist = "NotExist"
 	LoadState_NotLoad  = "NotLoad"
 	LoadState_Loading  = "Loading"
 	LoadState_Loaded   = "Loaded"
+
+	DefaultPartitionName = "_default"
+
+	// backup name of RestoreBackupRequest to restore the latest backup
+	LatestBackupName = "latest"
+
+	// milvus version of the backups created with backup.ignoreVersionError when GetVersion fails
+	UnknownMilvusVersion = "unknown"
+
+	// partitions of the collections to backup
+	PartitionScopeAll            = "all"
+	PartitionScopeDefaultOnly    = "default_only"
+	PartitionScopeExcludeDefault = "exclude_default"
+
+	// dynamic field of the restored collections
+	DynamicFieldKeep    = ""
+	DynamicFieldEnable  = "enable"
+	DynamicFieldDisable = "disable"
+
+	// what to do with the existing collections having rows, restored into with skipCreateCollection
+	ExistingCollectionAppend = ""
+	ExistingCollectionFail   = "fail"
 )
 
 type BackupMetaBytes struct {
@@ -35,7 +76,9 @@ type BackupMetaBytes struct {
 	CollectionMetaBytes []byte
 	PartitionMetaBytes  []byte
 	SegmentMetaBytes    []byte
-	FullMetaBytes       []byte
+	// set instead of SegmentMetaBytes if the segment meta is split into shards
+	SegmentMetaShards [][]byte
+	FullMetaBytes     []byte
 }
 
 type LeveledBackupInfo struct {
@@ -91,16 +134,31 @@ func treeToLevel(backup *backuppb.BackupInfo) (LeveledBackupInfo, error) {
 	}
 	backup.Size = backupSize
 	backupLevel := &backuppb.BackupInfo{
-		Id:              backup.GetId(),
-		StateCode:       backup.GetStateCode(),
-		ErrorMessage:    backup.GetErrorMessage(),
-		StartTime:       backup.GetStartTime(),
-		EndTime:         backup.GetEndTime(),
-		Progress:        backup.GetProgress(),
-		Name:            backup.GetName(),
-		BackupTimestamp: backup.GetBackupTimestamp(),
-		Size:            backup.GetSize(),
-		MilvusVersion:   backup.GetMilvusVersion(),
+		Id:                  backup.GetId(),
+		StateCode:           backup.GetStateCode(),
+		ErrorMessage:        backup.GetErrorMessage(),
+		StartTime:           backup.GetStartTime(),
+		EndTime:             backup.GetEndTime(),
+		Progress:            backup.GetProgress(),
+		Name:                backup.GetName(),
+		BackupTimestamp:     backup.GetBackupTimestamp(),
+		BackupTime:          backup.GetBackupTime(),
+		CopyStats:           backup.GetCopyStats(),
+		Resumed:             backup.GetResumed(),
+		EncryptionScheme:    backup.GetEncryptionScheme(),
+		EncryptionKeyId:     backup.GetEncryptionKeyId(),
+		BaseBackupName:      backup.GetBaseBackupName(),
+		EncryptionSalt:      backup.GetEncryptionSalt(),
+		EncryptedDataKey:    backup.GetEncryptedDataKey(),
+		Size:                backup.GetSize(),
+		MilvusVersion:       backup.GetMilvusVersion(),
+		MilvusRootPath:      backup.GetMilvusRootPath(),
+		SchemaTemplateOnly:  backup.GetSchemaTemplateOnly(),
+		BinlogTypes:         backup.GetBinlogTypes(),
+		SnapshotSpreadMs:    backup.GetSnapshotSpreadMs(),
+		DatabaseBackups:     backup.GetDatabaseBackups(),
+		UnlocatedSegmentIds: backup.GetUnlocatedSegmentIds(),
+		SkippedCollections:  backup.GetSkippedCollections(),
 	}
 
 	return LeveledBackupInfo{
@@ -112,10 +170,27 @@ func treeToLevel(backup *backuppb.BackupInfo) (LeveledBackupInfo, error) {
 }
 
 func serialize(backup *backuppb.BackupInfo) (*BackupMetaBytes, error) {
+	return serializeWithSegmentShards(backup, 0)
+}
+
+// serializeWithSegmentShards splits the segment meta into shards of at most maxSegmentsPerFile segments,
+// so that a collection with a huge number of segments doesn't make one huge file. 0 means no split.
+func serializeWithSegmentShards(backup *backuppb.BackupInfo, maxSegmentsPerFile int) (*BackupMetaBytes, error) {
 	level, err := treeToLevel(backup)
 	if err != nil {
 		return nil, err
 	}
+	var segmentMetaShards [][]byte
+	if maxSegmentsPerFile > 0 && len(level.segmentLevel.GetInfos()) > maxSegmentsPerFile {
+		for _, chunk := range lo.Chunk(level.segmentLevel.GetInfos(), maxSegmentsPerFile) {
+			shardBytes, err := json.Marshal(&backuppb.SegmentLevelBackupInfo{Infos: chunk})
+			if err != nil {
+				return nil, err
+			}
+			segmentMetaShards = append(segmentMetaShards, shardBytes)
+		}
+		level.backupLevel.SegmentMetaShards = int32(len(segmentMetaShards))
+	}
 	collectionBackupMetaBytes, err := json.Marshal(level.collectionLevel)
 	if err != nil {
 		return nil, err
@@ -124,15 +199,29 @@ func serialize(backup *backuppb.BackupInfo) (*BackupMetaBytes, error) {
 	if err != nil {
 		return nil, err
 	}
-	segmentBackupMetaBytes, err := json.Marshal(level.segmentLevel)
-	if err != nil {
-		return nil, err
+	var segmentBackupMetaBytes []byte
+	if len(segmentMetaShards) == 0 {
+		segmentBackupMetaBytes, err = json.Marshal(level.segmentLevel)
+		if err != nil {
+			return nil, err
+		}
 	}
 	backupMetaBytes, err := json.Marshal(level.backupLevel)
 	if err != nil {
 		return nil, err
 	}
-	fullMetaBytes, err := json.Marshal(backup)
+	fullMeta := backup
+	if len(segmentMetaShards) > 0 {
+		// the segments are only in the shards, the full meta would be as huge as the unsplit segment meta
+		fullMeta = proto.Clone(backup).(*backuppb.BackupInfo)
+		fullMeta.SegmentMetaShards = level.backupLevel.GetSegmentMetaShards()
+		for _, collection := range fullMeta.GetCollectionBackups() {
+			for _, partition := range collection.GetPartitionBackups() {
+				partition.SegmentBackups = nil
+			}
+		}
+	}
+	fullMetaBytes, err := json.Marshal(fullMeta)
 	if err != nil {
 		return nil, err
 	}
@@ -142,6 +231,7 @@ func serialize(backup *backuppb.BackupInfo) (*BackupMetaBytes, error) {
 		CollectionMetaBytes: collectionBackupMetaBytes,
 		PartitionMetaBytes:  partitionBackupMetaBytes,
 		SegmentMetaBytes:    segmentBackupMetaBytes,
+		SegmentMetaShards:   segmentMetaShards,
 		FullMetaBytes:       fullMetaBytes,
 	}, nil
 }
@@ -149,15 +239,31 @@ func serialize(backup *backuppb.BackupInfo) (*BackupMetaBytes, error) {
 // levelToTree rebuild complete tree structure BackupInfo from backup-collection-partition-segment 4-level structure
 func levelToTree(level *LeveledBackupInfo) (*backuppb.BackupInfo, error) {
 	backupInfo := &backuppb.BackupInfo{
-		Id:              level.backupLevel.GetId(),
-		StateCode:       level.backupLevel.GetStateCode(),
-		ErrorMessage:    level.backupLevel.GetErrorMessage(),
-		StartTime:       level.backupLevel.GetStartTime(),
-		EndTime:         level.backupLevel.GetEndTime(),
-		Progress:        level.backupLevel.GetProgress(),
-		Name:            level.backupLevel.GetName(),
-		BackupTimestamp: level.backupLevel.GetBackupTimestamp(),
-		MilvusVersion:   level.backupLevel.GetMilvusVersion(),
+		Id:                  level.backupLevel.GetId(),
+		StateCode:           level.backupLevel.GetStateCode(),
+		ErrorMessage:        level.backupLevel.GetErrorMessage(),
+		StartTime:           level.backupLevel.GetStartTime(),
+		EndTime:             level.backupLevel.GetEndTime(),
+		Progress:            level.backupLevel.GetProgress(),
+		Name:                level.backupLevel.GetName(),
+		BackupTimestamp:     level.backupLevel.GetBackupTimestamp(),
+		BackupTime:          level.backupLevel.GetBackupTime(),
+		CopyStats:           level.backupLevel.GetCopyStats(),
+		Resumed:             level.backupLevel.GetResumed(),
+		EncryptionScheme:    level.backupLevel.GetEncryptionScheme(),
+		EncryptionKeyId:     level.backupLevel.GetEncryptionKeyId(),
+		BaseBackupName:      level.backupLevel.GetBaseBackupName(),
+		EncryptionSalt:      level.backupLevel.GetEncryptionSalt(),
+		EncryptedDataKey:    level.backupLevel.GetEncryptedDataKey(),
+		MilvusVersion:       level.backupLevel.GetMilvusVersion(),
+		MilvusRootPath:      level.backupLevel.GetMilvusRootPath(),
+		SchemaTemplateOnly:  level.backupLevel.GetSchemaTemplateOnly(),
+		BinlogTypes:         level.backupLevel.GetBinlogTypes(),
+		SnapshotSpreadMs:    level.backupLevel.GetSnapshotSpreadMs(),
+		DatabaseBackups:     level.backupLevel.GetDatabaseBackups(),
+		UnlocatedSegmentIds: level.backupLevel.GetUnlocatedSegmentIds(),
+		SkippedCollections:  level.backupLevel.GetSkippedCollections(),
+		SegmentMetaShards:   level.backupLevel.GetSegmentMetaShards(),
 	}
 	segmentDict := make(map[string][]*backuppb.SegmentBackupInfo, len(level.segmentLevel.GetInfos()))
 	for _, segment := range level.segmentLevel.GetInfos() {
@@ -205,7 +311,20 @@ func deserialize(backup *BackupMetaBytes) (*backuppb.BackupInfo, error) {
 	partitionLevel := &backuppb.PartitionLevelBackupInfo{}
 	err = json.Unmarshal(backup.PartitionMetaBytes, partitionLevel)
 	segmentLevel := &backuppb.SegmentLevelBackupInfo{}
-	err = json.Unmarshal(backup.SegmentMetaBytes, segmentLevel)
+	if backupInfo.GetSegmentMetaShards() > 0 {
+		if len(backup.SegmentMetaShards) != int(backupInfo.GetSegmentMetaShards()) {
+			return nil, fmt.Errorf("backup has %d segment meta shards, got %d", backupInfo.GetSegmentMetaShards(), len(backup.SegmentMetaShards))
+		}
+		for _, shardBytes := range backup.SegmentMetaShards {
+			shard := &backuppb.SegmentLevelBackupInfo{}
+			if err := json.Unmarshal(shardBytes, shard); err != nil {
+				return nil, err
+			}
+			segmentLevel.Infos = append(segmentLevel.Infos, shard.GetInfos()...)
+		}
+	} else {
+		err = json.Unmarshal(backup.SegmentMetaBytes, segmentLevel)
+	}
 
 	return levelToTree(&LeveledBackupInfo{
 		collectionLevel: collectionLevel,
@@ -215,16 +334,37 @@ func deserialize(backup *BackupMetaBytes) (*backuppb.BackupInfo, error) {
 	})
 }
 
+// EscapePathName escapes a name used as one element of an object key, e.g. spaces, unicode and separators,
+// so that it doesn't change the structure of the key. Letters, numbers and underscores are kept as they are,
+// so the paths of names passing the name validation don't change.
+func EscapePathName(name string) string {
+	return url.PathEscape(name)
+}
+
+// UnescapePathName decodes a name escaped by EscapePathName, the name is returned as it is if it is not escaped
+func UnescapePathName(name string) string {
+	unescaped, err := url.PathUnescape(name)
+	if err != nil {
+		return name
+	}
+	return unescaped
+}
+
 func BackupPathToName(backupRootPath, path string) string {
-	return strings.Replace(strings.Replace(path, backupRootPath+SEPERATOR, "", 1), SEPERATOR, "", 1)
+	return UnescapePathName(strings.Replace(strings.Replace(path, backupRootPath+SEPERATOR, "", 1), SEPERATOR, "", 1))
+}
+
+// BackupPath is the path of the backup without the trailing separator
+func BackupPath(backupRootPath, backupName string) string {
+	return backupRootPath + SEPERATOR + EscapePathName(backupName)
 }
 
 func BackupDirPath(backupRootPath, backupName string) string {
-	return backupRootPath + SEPERATOR + backupName + SEPERATOR
+	return BackupPath(backupRootPath, backupName) + SEPERATOR
 }
 
 func BackupMetaDirPath(backupRootPath, backupName string) string {
-	return backupRootPath + SEPERATOR + backupName + SEPERATOR + META_PREFIX
+	return BackupPath(backupRootPath, backupName) + SEPERATOR + META_PREFIX
 }
 
 func BackupMetaPath(backupRootPath, backupName string) string {
@@ -243,6 +383,10 @@ func SegmentMetaPath(backupRootPath, backupName string) string {
 	return BackupMetaDirPath(backupRootPath, backupName) + SEPERATOR + SEGMENT_META_FILE
 }
 
+func SegmentMetaShardPath(backupRootPath, backupName string, shard int) string {
+	return BackupMetaDirPath(backupRootPath, backupName) + SEPERATOR + fmt.Sprintf(SEGMENT_META_SHARD_FILE, shard)
+}
+
 func FullMetaPath(backupRootPath, backupName string) string {
 	return BackupMetaDirPath(backupRootPath, backupName) + SEPERATOR + FULL_META_FILE
 }
@@ -251,30 +395,190 @@ func ChannelCPMetaPath(backupRootPath, backupName string) string {
 	return BackupMetaDirPath(backupRootPath, backupName) + SEPERATOR + CP_META_FILE
 }
 
+func SummaryPath(backupRootPath, backupName string) string {
+	return BackupMetaDirPath(backupRootPath, backupName) + SEPERATOR + SUMMARY_FILE
+}
+
+type backupMetaFile struct {
+	path    string
+	content []byte
+}
+
+// backupMetaFiles lists the files of the serialized backup meta in writing order. A backup is readable only if
+// the backup meta file exists, so it is the last one after all the other meta files.
+func backupMetaFiles(backupRootPath, backupName string, output *BackupMetaBytes) []backupMetaFile {
+	files := []backupMetaFile{{FullMetaPath(backupRootPath, backupName), output.FullMetaBytes}}
+	if len(output.SegmentMetaShards) > 0 {
+		for i, shardBytes := range output.SegmentMetaShards {
+			files = append(files, backupMetaFile{SegmentMetaShardPath(backupRootPath, backupName, i), shardBytes})
+		}
+	} else {
+		files = append(files, backupMetaFile{SegmentMetaPath(backupRootPath, backupName), output.SegmentMetaBytes})
+	}
+	return append(files,
+		backupMetaFile{PartitionMetaPath(backupRootPath, backupName), output.PartitionMetaBytes},
+		backupMetaFile{CollectionMetaPath(backupRootPath, backupName), output.CollectionMetaBytes},
+		backupMetaFile{BackupMetaPath(backupRootPath, backupName), output.BackupMetaBytes})
+}
+
+type backupSummary struct {
+	BackupName         string              `json:"backup_name"`
+	BackupID           string              `json:"backup_id"`
+	State              string              `json:"state"`
+	StartTime          string              `json:"start_time"`
+	EndTime            string              `json:"end_time"`
+	MilvusVersion      string              `json:"milvus_version"`
+	BackupTimestamp    uint64              `json:"backup_timestamp"`
+	BackupTime         string              `json:"backup_time"`
+	Size               int64               `json:"size"`
+	Options            backupOptions       `json:"options"`
+	SkippedCollections []string            `json:"skipped_collections,omitempty"`
+	Collections        []collectionSummary `json:"collections"`
+}
+
+type backupOptions struct {
+	SchemaTemplateOnly bool     `json:"schema_template_only"`
+	BinlogTypes        []string `json:"binlog_types"`
+	BackupDatabases    bool     `json:"backup_databases"`
+	SnapshotSpreadMs   int64    `json:"snapshot_spread_ms"`
+}
+
+type collectionSummary struct {
+	DbName         string   `json:"db_name"`
+	CollectionName string   `json:"collection_name"`
+	CollectionID   int64    `json:"collection_id"`
+	Size           int64    `json:"size"`
+	RowCount       int64    `json:"row_count"`
+	LoadState      string   `json:"load_state"`
+	Partitions     []string `json:"partitions"`
+	SegmentNum     int      `json:"segment_num"`
+	Indexes        []string `json:"indexes"`
+}
+
+// backupSummaryBytes renders the summary of the backup, start_time and end_time of a backup are unix milliseconds
+func backupSummaryBytes(backup *backuppb.BackupInfo) ([]byte, error) {
+	formatTime := func(ms int64) string {
+		if ms == 0 {
+			return ""
+		}
+		return time.UnixMilli(ms).UTC().Format(time.RFC3339)
+	}
+	summary := backupSummary{
+		BackupName:      backup.GetName(),
+		BackupID:        backup.GetId(),
+		State:           backup.GetStateCode().String(),
+		StartTime:       formatTime(backup.GetStartTime()),
+		EndTime:         formatTime(backup.GetEndTime()),
+		MilvusVersion:   backup.GetMilvusVersion(),
+		BackupTimestamp: backup.GetBackupTimestamp(),
+		BackupTime:      backup.GetBackupTime(),
+		Size:            backup.GetSize(),
+		Options: backupOptions{
+			SchemaTemplateOnly: backup.GetSchemaTemplateOnly(),
+			BinlogTypes:        backup.GetBinlogTypes(),
+			BackupDatabases:    len(backup.GetDatabaseBackups()) > 0,
+			SnapshotSpreadMs:   backup.GetSnapshotSpreadMs(),
+		},
+		SkippedCollections: backup.GetSkippedCollections(),
+		Collections:        make([]collectionSummary, 0, len(backup.GetCollectionBackups())),
+	}
+	for _, collection := range backup.GetCollectionBackups() {
+		segmentNum := len(collection.GetL0Segments())
+		for _, partition := range collection.GetPartitionBackups() {
+			segmentNum += len(partition.GetSegmentBackups())
+		}
+		summary.Collections = append(summary.Collections, collectionSummary{
+			DbName:         collection.GetDbName(),
+			CollectionName: collection.GetCollectionName(),
+			CollectionID:   collection.GetCollectionId(),
+			Size:           collection.GetSize(),
+			RowCount:       collection.GetRowCount(),
+			LoadState:      collection.GetLoadState(),
+			Partitions: lo.Map(collection.GetPartitionBackups(), func(partition *backuppb.PartitionBackupInfo, _ int) string {
+				return partition.GetPartitionName()
+			}),
+			SegmentNum: segmentNum,
+			Indexes: lo.Map(collection.GetIndexInfos(), func(index *backuppb.IndexInfo, _ int) string {
+				return fmt.Sprintf("%s(%s:%s)", index.GetIndexName(), index.GetFieldName(), index.GetIndexType())
+			}),
+		})
+	}
+	sort.Slice(summary.Collections, func(i, j int) bool {
+		if summary.Collections[i].DbName != summary.Collections[j].DbName {
+			return summary.Collections[i].DbName < summary.Collections[j].DbName
+		}
+		return summary.Collections[i].CollectionName < summary.Collections[j].CollectionName
+	})
+	return json.MarshalIndent(summary, "", "  ")
+}
+
 func BackupBinlogDirPath(backupRootPath, backupName string) string {
-	return backupRootPath + SEPERATOR + backupName + SEPERATOR + BINGLOG_DIR
+	return BackupPath(backupRootPath, backupName) + SEPERATOR + BINGLOG_DIR
+}
+
+// RebaseBinlogPath moves a binlog path from under rootPath to under targetDir,
+// e.g. files/insert_log/1/2/3/ with rootPath files => targetDir/insert_log/1/2/3/
+func RebaseBinlogPath(binlogPath, rootPath, targetDir string) string {
+	rootPath = strings.Trim(rootPath, SEPERATOR)
+	if rootPath != "" {
+		binlogPath = strings.TrimPrefix(binlogPath, rootPath+SEPERATOR)
+	}
+	return strings.TrimSuffix(targetDir, SEPERATOR) + SEPERATOR + strings.TrimPrefix(binlogPath, SEPERATOR)
+}
+
+// BackupSegmentBinlogPath maps a binlog path of milvus to its path in the backup binlog dir, binlogs of a segment
+// backed up in a group are put under the group dir after the partition dir,
+// e.g. files/insert_log/1/2/3/100/1 with group 4 => targetDir/insert_log/1/2/4/3/100/1
+// The group dir is only inserted at the partition level of the path, never in the root paths or other ids.
+func BackupSegmentBinlogPath(binlogPath, rootPath, targetDir string, partitionID, groupID int64) string {
+	targetPath := RebaseBinlogPath(binlogPath, rootPath, targetDir)
+	if groupID == 0 {
+		return targetPath
+	}
+	targetDir = strings.TrimSuffix(targetDir, SEPERATOR) + SEPERATOR
+	// log_type/collection_id/partition_id/rest
+	parts := strings.SplitN(strings.TrimPrefix(targetPath, targetDir), SEPERATOR, 4)
+	// index files are located by build, they keep the layout of milvus
+	if len(parts) < 4 || parts[0] == INDEX_FILE_DIR || parts[2] != strconv.FormatInt(partitionID, 10) {
+		return targetPath
+	}
+	return targetDir + strings.Join([]string{parts[0], parts[1], parts[2], strconv.FormatInt(groupID, 10), parts[3]}, SEPERATOR)
 }
 
 func SimpleListBackupsResponse(input *backuppb.ListBackupsResponse) *backuppb.ListBackupsResponse {
 	simpleBackupInfos := make([]*backuppb.BackupInfo, 0)
 	for _, backup := range input.GetData() {
 		simpleBackupInfos = append(simpleBackupInfos, &backuppb.BackupInfo{
-			Id:              backup.GetId(),
-			Name:            backup.GetName(),
-			StateCode:       backup.GetStateCode(),
-			ErrorMessage:    backup.GetErrorMessage(),
-			BackupTimestamp: backup.GetBackupTimestamp(),
-			Size:            backup.GetSize(),
-			StartTime:       backup.GetStartTime(),
-			EndTime:         backup.GetEndTime(),
-			MilvusVersion:   backup.GetMilvusVersion(),
+			Id:                  backup.GetId(),
+			Name:                backup.GetName(),
+			StateCode:           backup.GetStateCode(),
+			ErrorMessage:        backup.GetErrorMessage(),
+			BackupTimestamp:     backup.GetBackupTimestamp(),
+			BackupTime:          backup.GetBackupTime(),
+			CopyStats:           backup.GetCopyStats(),
+			Resumed:             backup.GetResumed(),
+			EncryptionScheme:    backup.GetEncryptionScheme(),
+			EncryptionKeyId:     backup.GetEncryptionKeyId(),
+			BaseBackupName:      backup.GetBaseBackupName(),
+			Size:                backup.GetSize(),
+			StartTime:           backup.GetStartTime(),
+			EndTime:             backup.GetEndTime(),
+			MilvusVersion:       backup.GetMilvusVersion(),
+			MilvusRootPath:      backup.GetMilvusRootPath(),
+			SchemaTemplateOnly:  backup.GetSchemaTemplateOnly(),
+			BinlogTypes:         backup.GetBinlogTypes(),
+			SnapshotSpreadMs:    backup.GetSnapshotSpreadMs(),
+			DatabaseBackups:     backup.GetDatabaseBackups(),
+			UnlocatedSegmentIds: backup.GetUnlocatedSegmentIds(),
+			SkippedCollections:  backup.GetSkippedCollections(),
 		})
 	}
 	return &backuppb.ListBackupsResponse{
-		RequestId: input.GetRequestId(),
-		Code:      input.GetCode(),
-		Msg:       input.GetMsg(),
-		Data:      simpleBackupInfos,
+		RequestId:             input.GetRequestId(),
+		Code:                  input.GetCode(),
+		Msg:                   input.GetMsg(),
+		Data:                  simpleBackupInfos,
+		NextContinuationToken: input.GetNextContinuationToken(),
 	}
 }
 
@@ -313,16 +617,21 @@ func SimpleRestoreResponse(input *backuppb.RestoreBackupResponse) *backuppb.Rest
 	collectionRestores := make([]*backuppb.RestoreCollectionTask, 0)
 	for _, coll := range restore.GetCollectionRestoreTasks() {
 		collectionRestores = append(collectionRestores, &backuppb.RestoreCollectionTask{
-			Id:                   coll.GetId(),
-			StateCode:            coll.GetStateCode(),
-			ErrorMessage:         coll.GetErrorMessage(),
-			StartTime:            coll.GetStartTime(),
-			EndTime:              coll.GetEndTime(),
-			Progress:             coll.GetProgress(),
-			TargetCollectionName: coll.GetTargetCollectionName(),
-			TargetDbName:         coll.GetTargetDbName(),
-			ToRestoreSize:        coll.GetToRestoreSize(),
-			RestoredSize:         coll.GetRestoredSize(),
+			Id:                    coll.GetId(),
+			StateCode:             coll.GetStateCode(),
+			ErrorMessage:          coll.GetErrorMessage(),
+			StartTime:             coll.GetStartTime(),
+			EndTime:               coll.GetEndTime(),
+			Progress:              coll.GetProgress(),
+			TargetCollectionName:  coll.GetTargetCollectionName(),
+			TargetDbName:          coll.GetTargetDbName(),
+			SourceDbName:          coll.GetSourceDbName(),
+			SourceCollectionName:  coll.GetSourceCollectionName(),
+			SourceCollectionId:    coll.GetSourceCollectionId(),
+			TargetCollectionId:    coll.GetTargetCollectionId(),
+			ToRestoreSize:         coll.GetToRestoreSize(),
+			RestoredSize:          coll.GetRestoredSize(),
+			PartitionRestoreTasks: coll.GetPartitionRestoreTasks(),
 		})
 	}
 
@@ -337,3 +646,41 @@ func SimpleRestoreResponse(input *backuppb.RestoreBackupResponse) *backuppb.Rest
 }
 
 type DbCollections = map[string][]string
+
+// BackupDbCollections is the db_collections of CreateBackupRequest, a collection is either a name
+// or an object like {"name": "coll", "force": true} to skip flush of the collection.
+type BackupDbCollections = map[string][]BackupCollection
+
+type BackupCollection struct {
+	Name string `json:"name"`
+	// skip flush like CreateBackupRequest.force, only for this collection
+	Force bool `json:"force"`
+}
+
+func (c *BackupCollection) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		c.Force = false
+		return json.Unmarshal(data, &c.Name)
+	}
+	type plain BackupCollection
+	return json.Unmarshal(data, (*plain)(c))
+}
+
+// RestoreDbCollections is the db_collections of RestoreBackupRequest, a collection is either a name
+// or an object like {"name": "coll", "shards_num": 4} to create the collection with another shards num.
+type RestoreDbCollections = map[string][]RestoreCollection
+
+type RestoreCollection struct {
+	Name string `json:"name"`
+	// 0 means the shards num of the backup
+	ShardsNum int32 `json:"shards_num"`
+}
+
+func (c *RestoreCollection) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		c.ShardsNum = 0
+		return json.Unmarshal(data, &c.Name)
+	}
+	type plain RestoreCollection
+	return json.Unmarshal(data, (*plain)(c))
+}
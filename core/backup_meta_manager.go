@@ -2,8 +2,10 @@ package core
 
 import (
 	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
@@ -20,6 +22,10 @@ type MetaManager struct {
 	collectionBackupReverse    map[int64]string                                    // collectionID -> backupId
 	backupNameToIdDict         map[string]string
 	restoreTasks               map[string]*backuppb.RestoreBackupTask
+	events                     map[string][]*backuppb.OperationEvent // operationId -> events
+	eventNotifiers             map[string]chan struct{}              // operationId -> closed when new event comes
+	eventOperations            []string                              // operationIds in the order of first event
+	backupNotifiers            map[string]chan struct{}              // backupId -> closed when the backup meta changes
 	mu                         sync.Mutex
 }
 
@@ -34,6 +40,10 @@ func newMetaManager() *MetaManager {
 		collectionBackupReverse:    make(map[int64]string, 0),
 		backupNameToIdDict:         make(map[string]string, 0),
 		restoreTasks:               make(map[string]*backuppb.RestoreBackupTask, 0),
+		events:                     make(map[string][]*backuppb.OperationEvent, 0),
+		eventNotifiers:             make(map[string]chan struct{}, 0),
+		eventOperations:            make([]string, 0),
+		backupNotifiers:            make(map[string]chan struct{}, 0),
 		mu:                         sync.Mutex{},
 	}
 }
@@ -62,6 +72,22 @@ func (meta *MetaManager) GetBackupByName(name string) *backuppb.BackupInfo {
 	return backup
 }
 
+// IsBackupInProgress returns true if the backup with the name is being created in this process
+func (meta *MetaManager) IsBackupInProgress(name string) bool {
+	meta.mu.Lock()
+	defer meta.mu.Unlock()
+	id, exist := meta.backupNameToIdDict[name]
+	if !exist {
+		return false
+	}
+	backup, exist := meta.backups[id]
+	if !exist {
+		return false
+	}
+	return backup.GetStateCode() == backuppb.BackupTaskStateCode_BACKUP_INITIAL ||
+		backup.GetStateCode() == backuppb.BackupTaskStateCode_BACKUP_EXECUTING
+}
+
 func (meta *MetaManager) AddBackup(backup *backuppb.BackupInfo) {
 	meta.mu.Lock()
 	defer meta.mu.Unlock()
@@ -69,6 +95,21 @@ func (meta *MetaManager) AddBackup(backup *backuppb.BackupInfo) {
 	meta.backupNameToIdDict[backup.Name] = backup.Id
 }
 
+// RenameBackup renames the backup with the name in this process, if there is one
+func (meta *MetaManager) RenameBackup(oldName, newName string) {
+	meta.mu.Lock()
+	defer meta.mu.Unlock()
+	id, exist := meta.backupNameToIdDict[oldName]
+	if !exist {
+		return
+	}
+	delete(meta.backupNameToIdDict, oldName)
+	meta.backupNameToIdDict[newName] = id
+	if backup, exist := meta.backups[id]; exist {
+		backup.Name = newName
+	}
+}
+
 func (meta *MetaManager) AddCollection(collection *backuppb.CollectionBackupInfo) {
 	meta.mu.Lock()
 	defer meta.mu.Unlock()
@@ -77,6 +118,7 @@ func (meta *MetaManager) AddCollection(collection *backuppb.CollectionBackupInfo
 	}
 	meta.collections[collection.Id][collection.GetCollectionId()] = collection
 	meta.collectionBackupReverse[collection.GetCollectionId()] = collection.Id
+	meta.notifyBackup(collection.Id)
 }
 
 func (meta *MetaManager) AddPartition(partition *backuppb.PartitionBackupInfo) {
@@ -87,6 +129,7 @@ func (meta *MetaManager) AddPartition(partition *backuppb.PartitionBackupInfo) {
 	}
 	meta.partitions[partition.GetCollectionId()][partition.GetPartitionId()] = partition
 	meta.partitionCollectionReverse[partition.GetPartitionId()] = partition.GetCollectionId()
+	meta.notifyBackup(meta.collectionBackupReverse[partition.GetCollectionId()])
 }
 
 func (meta *MetaManager) AddSegment(segment *backuppb.SegmentBackupInfo) {
@@ -97,6 +140,33 @@ func (meta *MetaManager) AddSegment(segment *backuppb.SegmentBackupInfo) {
 	}
 	meta.segments[segment.GetPartitionId()][segment.GetSegmentId()] = segment
 	meta.segmentPartitionReverse[segment.GetSegmentId()] = segment.GetPartitionId()
+	meta.notifyBackup(meta.collectionBackupReverse[segment.GetCollectionId()])
+}
+
+// RemoveCollections removes the collections of the backup matching, with their partitions and segments
+func (meta *MetaManager) RemoveCollections(backupID string, match func(collection *backuppb.CollectionBackupInfo) bool) {
+	meta.mu.Lock()
+	defer meta.mu.Unlock()
+	for collectionID, collection := range meta.collections[backupID] {
+		if match(collection) {
+			meta.removeCollection(backupID, collectionID)
+		}
+	}
+}
+
+func (meta *MetaManager) removeCollection(backupID string, collectionID int64) {
+	for partitionID := range meta.partitions[collectionID] {
+		for segmentID := range meta.segments[partitionID] {
+			delete(meta.segmentPartitionReverse, segmentID)
+		}
+		delete(meta.segments, partitionID)
+		delete(meta.partitionCollectionReverse, partitionID)
+	}
+	delete(meta.partitions, collectionID)
+	delete(meta.collectionBackupReverse, collectionID)
+	if collections, exist := meta.collections[backupID]; exist {
+		delete(collections, collectionID)
+	}
 }
 
 type BackupOpt func(backup *backuppb.BackupInfo)
@@ -136,16 +206,57 @@ func setName(name string) BackupOpt {
 	}
 }
 
-// backup timestamp
+// backup timestamp, with its readable time
 func setBackupTimestamp(backupTimestamp uint64) BackupOpt {
 	return func(backup *backuppb.BackupInfo) {
 		backup.BackupTimestamp = backupTimestamp
+		backup.BackupTime = FormatBackupTimestamp(backupTimestamp)
+	}
+}
+
+func setCopyStats(copyStats *backuppb.CopyStats) BackupOpt {
+	return func(backup *backuppb.BackupInfo) {
+		backup.CopyStats = copyStats
 	}
 }
 
 // array of collection backup
 //repeated CollectionBackupInfo collection_backups = 9;
 
+func setSnapshotSpreadMs(spread int64) BackupOpt {
+	return func(backup *backuppb.BackupInfo) {
+		backup.SnapshotSpreadMs = spread
+	}
+}
+
+func setDatabaseBackups(databases []*backuppb.DatabaseBackupInfo) BackupOpt {
+	return func(backup *backuppb.BackupInfo) {
+		backup.DatabaseBackups = databases
+	}
+}
+
+func addUnlocatedSegmentIDs(segmentIDs []int64) BackupOpt {
+	return func(backup *backuppb.BackupInfo) {
+		backup.UnlocatedSegmentIds = lo.Uniq(append(backup.UnlocatedSegmentIds, segmentIDs...))
+	}
+}
+
+func addSkippedCollection(collection string) BackupOpt {
+	return func(backup *backuppb.BackupInfo) {
+		backup.SkippedCollections = lo.Uniq(append(backup.SkippedCollections, collection))
+	}
+}
+
+// setEncryptionOf sets the encryption of the backup to the one of the other backup, so they share the data key
+func setEncryptionOf(other *backuppb.BackupInfo) BackupOpt {
+	return func(backup *backuppb.BackupInfo) {
+		backup.EncryptionScheme = other.GetEncryptionScheme()
+		backup.EncryptionKeyId = other.GetEncryptionKeyId()
+		backup.EncryptionSalt = other.GetEncryptionSalt()
+		backup.EncryptedDataKey = other.GetEncryptedDataKey()
+	}
+}
+
 func setSize(size int64) BackupOpt {
 	return func(backup *backuppb.BackupInfo) {
 		backup.Size = size
@@ -173,6 +284,7 @@ func (meta *MetaManager) UpdateBackup(backupID string, opts ...BackupOpt) {
 		opt(cBackup)
 	}
 	meta.backups[backup.Id] = cBackup
+	meta.notifyBackup(backup.Id)
 }
 
 type CollectionOpt func(collection *backuppb.CollectionBackupInfo)
@@ -207,10 +319,11 @@ func setCollectionProgress(progress int32) CollectionOpt {
 	}
 }
 
-// backup timestamp
+// backup timestamp, with its readable time
 func setCollectionBackupTimestamp(backupTimestamp uint64) CollectionOpt {
 	return func(collection *backuppb.CollectionBackupInfo) {
 		collection.BackupTimestamp = backupTimestamp
+		collection.BackupTime = FormatBackupTimestamp(backupTimestamp)
 	}
 }
 
@@ -286,6 +399,12 @@ func setCollectionBackupPhysicalTimestamp(backupPhysicalTimestamp uint64) Collec
 	}
 }
 
+func setCollectionRowCount(rowCount int64) CollectionOpt {
+	return func(collection *backuppb.CollectionBackupInfo) {
+		collection.RowCount = rowCount
+	}
+}
+
 func setCollectionChannelCheckpoints(channelCheckpoints map[string]string) CollectionOpt {
 	return func(collection *backuppb.CollectionBackupInfo) {
 		collection.ChannelCheckpoints = channelCheckpoints
@@ -307,6 +426,7 @@ func (meta *MetaManager) UpdateCollection(backupID string, collectionID int64, o
 		opt(cBackup)
 	}
 	meta.collections[backupID][collectionID] = cBackup
+	meta.notifyBackup(backupID)
 }
 
 type PartitionOpt func(partition *backuppb.PartitionBackupInfo)
@@ -321,9 +441,10 @@ func (meta *MetaManager) GetPartitions(collectionID int64) map[int64]*backuppb.P
 	meta.mu.Lock()
 	defer meta.mu.Unlock()
 
-	partitions, exist := meta.partitions[collectionID]
-	if !exist {
-		return make(map[int64]*backuppb.PartitionBackupInfo, 0)
+	// return a copy, the map of meta may be changed by other backup tasks while the caller iterates it
+	partitions := make(map[int64]*backuppb.PartitionBackupInfo, len(meta.partitions[collectionID]))
+	for partitionID, partition := range meta.partitions[collectionID] {
+		partitions[partitionID] = partition
 	}
 	return partitions
 }
@@ -337,6 +458,7 @@ func (meta *MetaManager) UpdatePartition(collectionID int64, partitionID int64,
 		opt(cBackup)
 	}
 	meta.partitions[collectionID][partitionID] = cBackup
+	meta.notifyBackup(meta.collectionBackupReverse[collectionID])
 }
 
 type SegmentOpt func(segment *backuppb.SegmentBackupInfo)
@@ -400,6 +522,18 @@ func setSegmentDeltaBinlogs(binlogs []*backuppb.FieldBinlog) SegmentOpt {
 	}
 }
 
+func setSegmentIndexFiles(indexFiles []*backuppb.SegmentIndexFiles) SegmentOpt {
+	return func(segment *backuppb.SegmentBackupInfo) {
+		segment.IndexFiles = indexFiles
+	}
+}
+
+func setSegmentBaseBackup(baseBackupName string) SegmentOpt {
+	return func(segment *backuppb.SegmentBackupInfo) {
+		segment.BaseBackupName = baseBackupName
+	}
+}
+
 func setSegmentGroupId(groupId int64) SegmentOpt {
 	return func(segment *backuppb.SegmentBackupInfo) {
 		segment.GroupId = groupId
@@ -421,6 +555,29 @@ func (meta *MetaManager) UpdateSegment(partitionID int64, segmentID int64, opts
 		opt(cBackup)
 	}
 	meta.segments[partitionID][segmentID] = cBackup
+	meta.notifyBackup(meta.collectionBackupReverse[meta.partitionCollectionReverse[partitionID]])
+}
+
+// WatchBackup returns a channel which will be closed when the backup, its collections, partitions or segments change
+func (meta *MetaManager) WatchBackup(backupID string) (<-chan struct{}, bool) {
+	meta.mu.Lock()
+	defer meta.mu.Unlock()
+	if _, exist := meta.backups[backupID]; !exist {
+		return nil, false
+	}
+	notifier, ok := meta.backupNotifiers[backupID]
+	if !ok {
+		notifier = make(chan struct{})
+		meta.backupNotifiers[backupID] = notifier
+	}
+	return notifier, true
+}
+
+func (meta *MetaManager) notifyBackup(backupID string) {
+	if notifier, ok := meta.backupNotifiers[backupID]; ok {
+		close(notifier)
+		delete(meta.backupNotifiers, backupID)
+	}
 }
 
 func (meta *MetaManager) GetBackupBySegmentID(segmentID int64) *backuppb.BackupInfo {
@@ -462,14 +619,18 @@ func (meta *MetaManager) GetFullMeta(id string) *backuppb.BackupInfo {
 	var backupedSize int64 = 0
 	var totalSize int64 = 0
 	cloneBackup := proto.Clone(backup).(*backuppb.BackupInfo)
+	// sizes are summed up from the segments, sizes kept in meta may be set before the segments are filled
+	cloneBackup.Size = 0
 
 	collectionBackups := make([]*backuppb.CollectionBackupInfo, 0)
 	for collectionID, collection := range collections {
 		collectionBackup := proto.Clone(collection).(*backuppb.CollectionBackupInfo)
+		collectionBackup.Size = 0
 		partitionBackups := make([]*backuppb.PartitionBackupInfo, 0)
 		for partitionID, partition := range meta.partitions[collectionID] {
 			segmentBackups := make([]*backuppb.SegmentBackupInfo, 0)
 			partitionBackup := proto.Clone(partition).(*backuppb.PartitionBackupInfo)
+			partitionBackup.Size = 0
 			for _, segment := range meta.segments[partitionID] {
 				segmentBackups = append(segmentBackups, proto.Clone(segment).(*backuppb.SegmentBackupInfo))
 				if segment.Backuped {
@@ -539,6 +700,63 @@ func addCollectionRestoredSize(collectionID, restoredSize int64) RestoreTaskOpt
 	}
 }
 
+// addPartitionRestoredSize only updates the partition task, the collection is updated by addCollectionRestoredSize
+func addPartitionRestoredSize(collectionTaskID string, partitionName string, restoredSize int64) RestoreTaskOpt {
+	return func(task *backuppb.RestoreBackupTask) {
+		for _, coll := range task.GetCollectionRestoreTasks() {
+			if coll.GetId() != collectionTaskID {
+				continue
+			}
+			for _, partition := range coll.GetPartitionRestoreTasks() {
+				if partition.GetPartitionName() != partitionName {
+					continue
+				}
+				if partition.StartTime == 0 {
+					partition.StartTime = time.Now().Unix()
+				}
+				partition.RestoredSize = partition.RestoredSize + restoredSize
+				if partition.RestoredSize >= partition.ToRestoreSize {
+					partition.StateCode = backuppb.RestoreTaskStateCode_SUCCESS
+					partition.EndTime = time.Now().Unix()
+				} else {
+					partition.StateCode = backuppb.RestoreTaskStateCode_EXECUTING
+				}
+			}
+		}
+	}
+}
+
+// setCollectionRestoreStateCode also finishes the partitions, a failed collection fails its unfinished partitions
+func setCollectionRestoreStateCode(collectionTaskID string, stateCode backuppb.RestoreTaskStateCode, errorMessage string) RestoreTaskOpt {
+	return func(task *backuppb.RestoreBackupTask) {
+		for _, coll := range task.GetCollectionRestoreTasks() {
+			if coll.GetId() == collectionTaskID {
+				coll.StateCode = stateCode
+				coll.ErrorMessage = errorMessage
+				for _, partition := range coll.GetPartitionRestoreTasks() {
+					if partition.GetStateCode() == backuppb.RestoreTaskStateCode_SUCCESS {
+						continue
+					}
+					if stateCode == backuppb.RestoreTaskStateCode_SUCCESS || stateCode == backuppb.RestoreTaskStateCode_FAIL {
+						partition.StateCode = stateCode
+						partition.EndTime = time.Now().Unix()
+					}
+				}
+			}
+		}
+	}
+}
+
+func setCollectionRestoreTargetID(collectionTaskID string, targetCollectionID int64) RestoreTaskOpt {
+	return func(task *backuppb.RestoreBackupTask) {
+		for _, coll := range task.GetCollectionRestoreTasks() {
+			if coll.GetId() == collectionTaskID {
+				coll.TargetCollectionId = targetCollectionID
+			}
+		}
+	}
+}
+
 func (meta *MetaManager) UpdateRestoreTask(restoreID string, opts ...RestoreTaskOpt) {
 	meta.mu.Lock()
 	defer meta.mu.Unlock()
@@ -563,3 +781,97 @@ func (meta *MetaManager) GetRestoreTask(taskID string) *backuppb.RestoreBackupTa
 	defer meta.mu.Unlock()
 	return meta.restoreTasks[taskID]
 }
+
+const (
+	EVENT_STATE             = "state"
+	EVENT_COLLECTION_START  = "collection_start"
+	EVENT_COLLECTION_FINISH = "collection_finish"
+	EVENT_COLLECTION_FAIL   = "collection_fail"
+	EVENT_COLLECTION_SKIP   = "collection_skip"
+	EVENT_PROGRESS          = "progress"
+
+	// max events kept for one operation, the oldest are dropped first
+	MaxEventsPerOperation = 1000
+	// max operations whose events are kept, events of the oldest operation are dropped first
+	MaxEventOperations = 100
+)
+
+type EventOpt func(event *backuppb.OperationEvent)
+
+func withEventCollection(dbName, collectionName string) EventOpt {
+	return func(event *backuppb.OperationEvent) {
+		event.DbName = dbName
+		event.CollectionName = collectionName
+	}
+}
+
+func stateEventMessage(state string, errorMessage string) string {
+	if errorMessage == "" {
+		return state
+	}
+	return state + ": " + errorMessage
+}
+
+// AddEvent appends an event of a backup or restore and wakes up the waiting readers
+func (meta *MetaManager) AddEvent(operationID string, eventType string, message string, opts ...EventOpt) {
+	meta.mu.Lock()
+	defer meta.mu.Unlock()
+	events, exist := meta.events[operationID]
+	if !exist {
+		if len(meta.eventOperations) >= MaxEventOperations {
+			oldest := meta.eventOperations[0]
+			meta.eventOperations = meta.eventOperations[1:]
+			delete(meta.events, oldest)
+			if notifier, ok := meta.eventNotifiers[oldest]; ok {
+				close(notifier)
+				delete(meta.eventNotifiers, oldest)
+			}
+		}
+		meta.eventOperations = append(meta.eventOperations, operationID)
+	}
+	var seq int64 = 1
+	if len(events) > 0 {
+		seq = events[len(events)-1].GetSeq() + 1
+	}
+	event := &backuppb.OperationEvent{
+		Seq:     seq,
+		Time:    time.Now().Unix(),
+		Type:    eventType,
+		Message: message,
+	}
+	for _, opt := range opts {
+		opt(event)
+	}
+	events = append(events, event)
+	if len(events) > MaxEventsPerOperation {
+		events = events[len(events)-MaxEventsPerOperation:]
+	}
+	meta.events[operationID] = events
+	if notifier, ok := meta.eventNotifiers[operationID]; ok {
+		close(notifier)
+		delete(meta.eventNotifiers, operationID)
+	}
+}
+
+// GetEvents returns the events with seq greater than afterSeq,
+// and a channel which will be closed when a new event of the operation is added
+func (meta *MetaManager) GetEvents(operationID string, afterSeq int64) ([]*backuppb.OperationEvent, <-chan struct{}, bool) {
+	meta.mu.Lock()
+	defer meta.mu.Unlock()
+	events, exist := meta.events[operationID]
+	if !exist {
+		return nil, nil, false
+	}
+	res := make([]*backuppb.OperationEvent, 0)
+	for _, event := range events {
+		if event.GetSeq() > afterSeq {
+			res = append(res, proto.Clone(event).(*backuppb.OperationEvent))
+		}
+	}
+	notifier, ok := meta.eventNotifiers[operationID]
+	if !ok {
+		notifier = make(chan struct{})
+		meta.eventNotifiers[operationID] = notifier
+	}
+	return res, notifier, true
+}
@@ -0,0 +1,102 @@
+package core
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+func TestOperationEvents(t *testing.T) {
+	meta := newMetaManager()
+	_, _, exist := meta.GetEvents("not_exist", 0)
+	assert.False(t, exist)
+
+	for i := 0; i < MaxEventsPerOperation+10; i++ {
+		meta.AddEvent("op", EVENT_PROGRESS, strconv.Itoa(i))
+	}
+	events, notifier, exist := meta.GetEvents("op", 0)
+	assert.True(t, exist)
+	assert.Equal(t, MaxEventsPerOperation, len(events))
+	assert.Equal(t, int64(11), events[0].GetSeq())
+
+	events, _, _ = meta.GetEvents("op", int64(MaxEventsPerOperation+9))
+	assert.Equal(t, 1, len(events))
+
+	meta.AddEvent("op", EVENT_STATE, "done", withEventCollection("db", "coll"))
+	select {
+	case <-notifier:
+	default:
+		t.Fatal("notifier should be closed after new event")
+	}
+	events, _, _ = meta.GetEvents("op", int64(MaxEventsPerOperation+10))
+	assert.Equal(t, "coll", events[0].GetCollectionName())
+
+	for i := 0; i < MaxEventOperations; i++ {
+		meta.AddEvent(strconv.Itoa(i), EVENT_STATE, "start")
+	}
+	_, _, exist = meta.GetEvents("op", 0)
+	assert.False(t, exist)
+}
+
+func TestPartitionRestoreProgress(t *testing.T) {
+	meta := newMetaManager()
+	meta.AddRestoreTask(&backuppb.RestoreBackupTask{
+		Id:            "restore",
+		ToRestoreSize: 30,
+		CollectionRestoreTasks: []*backuppb.RestoreCollectionTask{{
+			Id:            "coll",
+			CollBackup:    &backuppb.CollectionBackupInfo{CollectionId: 1},
+			ToRestoreSize: 30,
+			PartitionRestoreTasks: []*backuppb.RestorePartitionTask{
+				{PartitionName: "p1", ToRestoreSize: 20},
+				{PartitionName: "p2", ToRestoreSize: 10},
+				{PartitionName: "empty"},
+			},
+		}},
+	})
+
+	meta.UpdateRestoreTask("restore", addCollectionRestoredSize(1, 10), addPartitionRestoredSize("coll", "p1", 10))
+	partitions := meta.GetRestoreTask("restore").GetCollectionRestoreTasks()[0].GetPartitionRestoreTasks()
+	assert.Equal(t, backuppb.RestoreTaskStateCode_EXECUTING, partitions[0].GetStateCode())
+	assert.Equal(t, int32(50), restoreProgress(partitions[0].GetStateCode(), partitions[0].GetRestoredSize(), partitions[0].GetToRestoreSize()))
+	assert.Equal(t, backuppb.RestoreTaskStateCode_INITIAL, partitions[1].GetStateCode())
+
+	meta.UpdateRestoreTask("restore", addCollectionRestoredSize(1, 10), addPartitionRestoredSize("coll", "p1", 10))
+	partitions = meta.GetRestoreTask("restore").GetCollectionRestoreTasks()[0].GetPartitionRestoreTasks()
+	assert.Equal(t, backuppb.RestoreTaskStateCode_SUCCESS, partitions[0].GetStateCode())
+
+	meta.UpdateRestoreTask("restore", setCollectionRestoreStateCode("coll", backuppb.RestoreTaskStateCode_FAIL, "fail"))
+	collection := meta.GetRestoreTask("restore").GetCollectionRestoreTasks()[0]
+	assert.Equal(t, int64(20), collection.GetRestoredSize())
+	assert.Equal(t, backuppb.RestoreTaskStateCode_SUCCESS, collection.GetPartitionRestoreTasks()[0].GetStateCode())
+	assert.Equal(t, backuppb.RestoreTaskStateCode_FAIL, collection.GetPartitionRestoreTasks()[1].GetStateCode())
+	assert.Equal(t, backuppb.RestoreTaskStateCode_FAIL, collection.GetPartitionRestoreTasks()[2].GetStateCode())
+}
+
+func TestRestoreCollectionIDMapping(t *testing.T) {
+	meta := newMetaManager()
+	meta.AddRestoreTask(&backuppb.RestoreBackupTask{
+		Id: "restore",
+		CollectionRestoreTasks: []*backuppb.RestoreCollectionTask{{
+			Id:                   "coll",
+			CollBackup:           &backuppb.CollectionBackupInfo{CollectionId: 1},
+			SourceDbName:         "db1",
+			SourceCollectionName: "c1",
+			SourceCollectionId:   1,
+			TargetDbName:         "db2",
+			TargetCollectionName: "c1_restored",
+		}},
+	})
+
+	meta.UpdateRestoreTask("restore", setCollectionRestoreTargetID("coll", 2))
+	resp := SimpleRestoreResponse(&backuppb.RestoreBackupResponse{Data: meta.GetRestoreTask("restore")})
+	collection := resp.GetData().GetCollectionRestoreTasks()[0]
+	assert.Nil(t, collection.GetCollBackup())
+	assert.Equal(t, "db1", collection.GetSourceDbName())
+	assert.Equal(t, "c1", collection.GetSourceCollectionName())
+	assert.Equal(t, int64(1), collection.GetSourceCollectionId())
+	assert.Equal(t, int64(2), collection.GetTargetCollectionId())
+}
@@ -2,10 +2,12 @@ package core
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 
 	jsoniter "github.com/json-iterator/go"
@@ -154,6 +156,16 @@ func TestDbCollectionJson(t *testing.T) {
 	var dbCollection2 DbCollections
 	jsoniter.UnmarshalFromString(jsonStr, &dbCollection2)
 	println(dbCollection2)
+
+	var backupDbCollections BackupDbCollections
+	err = jsoniter.UnmarshalFromString(jsonStr, &backupDbCollections)
+	assert.NoError(t, err)
+	assert.Equal(t, []BackupCollection{{Name: "coll1"}, {Name: "coll2"}}, backupDbCollections["db1"])
+
+	err = jsoniter.UnmarshalFromString(`{"db1":["coll1",{"name":"coll2","force":true}],"db2":[]}`, &backupDbCollections)
+	assert.NoError(t, err)
+	assert.Equal(t, []BackupCollection{{Name: "coll1"}, {Name: "coll2", Force: true}}, backupDbCollections["db1"])
+	assert.Empty(t, backupDbCollections["db2"])
 }
 
 func readBackup(backupDir string) (*backuppb.BackupInfo, error) {
@@ -259,3 +271,213 @@ func TestSimpleRestoreResponse(t *testing.T) {
 	assert.Equal(t, info.Msg, simpleInfo.Msg)
 	assert.Equal(t, info.RequestId, simpleInfo.RequestId)
 }
+
+func TestRebaseBinlogPath(t *testing.T) {
+	backupBinlogDir := BackupBinlogDirPath("backup", "b1")
+	assert.Equal(t, "backup/b1/binlogs/insert_log/1/2/3/100/1",
+		RebaseBinlogPath("files/insert_log/1/2/3/100/1", "files", backupBinlogDir))
+	assert.Equal(t, "backup/b1/binlogs/insert_log/1/2/3/100/1",
+		RebaseBinlogPath("files/insert_log/1/2/3/100/1", "files/", backupBinlogDir+SEPERATOR))
+	assert.Equal(t, "backup/b1/binlogs/insert_log/1/2/3/100/1",
+		RebaseBinlogPath("insert_log/1/2/3/100/1", "", backupBinlogDir))
+	// only the rootPath prefix is replaced
+	assert.Equal(t, "backup/b1/binlogs/insert_log/1/files/3/100/1",
+		RebaseBinlogPath("files/insert_log/1/files/3/100/1", "files", backupBinlogDir))
+	// rootPath is a prefix of the first dir but not a dir of the path
+	assert.Equal(t, "backup/b1/binlogs/files2/insert_log/1/2/3/100/1",
+		RebaseBinlogPath("files2/insert_log/1/2/3/100/1", "files", backupBinlogDir))
+}
+
+func TestBackupSegmentBinlogPath(t *testing.T) {
+	backupBinlogDir := BackupBinlogDirPath("backup", "b1")
+	assert.Equal(t, "backup/b1/binlogs/insert_log/1/2/3/100/1",
+		BackupSegmentBinlogPath("files/insert_log/1/2/3/100/1", "files", backupBinlogDir, 2, 0))
+	assert.Equal(t, "backup/b1/binlogs/insert_log/1/2/4/3/100/1",
+		BackupSegmentBinlogPath("files/insert_log/1/2/3/100/1", "files", backupBinlogDir, 2, 4))
+	// the partition id appears in the root paths and collection id
+	assert.Equal(t, "backup2/b2/binlogs/insert_log/12/2/4/3/100/1",
+		BackupSegmentBinlogPath("files2/insert_log/12/2/3/100/1", "files2", BackupBinlogDirPath("backup2", "b2"), 2, 4))
+	// same bucket, the backup root path is under the milvus root path
+	assert.Equal(t, "files/backup/b1/binlogs/delta_log/1/2/4/3/100/1",
+		BackupSegmentBinlogPath("files/delta_log/1/2/3/100/1", "files", BackupBinlogDirPath("files/backup", "b1"), 2, 4))
+	// index files keep the layout of milvus
+	assert.Equal(t, "backup/b1/binlogs/index_files/10/2/2/100/HNSW",
+		BackupSegmentBinlogPath("files/index_files/10/2/2/100/HNSW", "files", backupBinlogDir, 2, 4))
+}
+
+func TestSegmentMetaShards(t *testing.T) {
+	segments := make([]*backuppb.SegmentBackupInfo, 0)
+	for i := int64(0); i < 5; i++ {
+		segments = append(segments, &backuppb.SegmentBackupInfo{SegmentId: i, CollectionId: 1, PartitionId: 2, Size: 10})
+	}
+	backup := &backuppb.BackupInfo{
+		Name: "backup",
+		CollectionBackups: []*backuppb.CollectionBackupInfo{{
+			CollectionId: 1,
+			PartitionBackups: []*backuppb.PartitionBackupInfo{{
+				CollectionId:   1,
+				PartitionId:    2,
+				SegmentBackups: segments,
+			}},
+		}},
+	}
+
+	output, err := serializeWithSegmentShards(backup, 2)
+	assert.NoError(t, err)
+	assert.Nil(t, output.SegmentMetaBytes)
+	assert.Len(t, output.SegmentMetaShards, 3)
+	// the full meta doesn't embed the sharded segments
+	fullMeta := &backuppb.BackupInfo{}
+	assert.NoError(t, json.Unmarshal(output.FullMetaBytes, fullMeta))
+	assert.Equal(t, int32(3), fullMeta.GetSegmentMetaShards())
+	assert.Empty(t, fullMeta.GetCollectionBackups()[0].GetPartitionBackups()[0].GetSegmentBackups())
+	assert.Len(t, backup.GetCollectionBackups()[0].GetPartitionBackups()[0].GetSegmentBackups(), 5)
+	files := backupMetaFiles("root", "backup", output)
+	assert.Equal(t, SegmentMetaShardPath("root", "backup", 2), files[3].path)
+	assert.Equal(t, BackupMetaPath("root", "backup"), files[len(files)-1].path)
+
+	restored, err := deserialize(output)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), restored.GetSegmentMetaShards())
+	assert.Len(t, restored.GetCollectionBackups()[0].GetPartitionBackups()[0].GetSegmentBackups(), 5)
+	assert.Equal(t, int64(50), restored.GetSize())
+
+	output.SegmentMetaShards = output.SegmentMetaShards[:2]
+	_, err = deserialize(output)
+	assert.Error(t, err)
+
+	// no split if the segments fit in one file
+	output, err = serializeWithSegmentShards(backup, 5)
+	assert.NoError(t, err)
+	assert.NotNil(t, output.SegmentMetaBytes)
+	assert.Empty(t, output.SegmentMetaShards)
+}
+
+func TestSegmentMetaShardsRoundTrip(t *testing.T) {
+	backup := &backuppb.BackupInfo{Name: "backup"}
+	segmentIDs := make([]int64, 0)
+	for c := int64(1); c <= 3; c++ {
+		partitions := make([]*backuppb.PartitionBackupInfo, 0)
+		for p := int64(1); p <= 4; p++ {
+			segments := make([]*backuppb.SegmentBackupInfo, 0)
+			for s := int64(0); s < 50; s++ {
+				segmentID := c*10000 + p*100 + s
+				segments = append(segments, &backuppb.SegmentBackupInfo{SegmentId: segmentID, CollectionId: c, PartitionId: c*10 + p, Size: 1})
+				segmentIDs = append(segmentIDs, segmentID)
+			}
+			partitions = append(partitions, &backuppb.PartitionBackupInfo{CollectionId: c, PartitionId: c*10 + p, SegmentBackups: segments})
+		}
+		backup.CollectionBackups = append(backup.CollectionBackups, &backuppb.CollectionBackupInfo{CollectionId: c, PartitionBackups: partitions})
+	}
+
+	output, err := serializeWithSegmentShards(backup, 7)
+	assert.NoError(t, err)
+	assert.Len(t, output.SegmentMetaShards, (len(segmentIDs)+6)/7)
+	restored, err := deserialize(output)
+	assert.NoError(t, err)
+
+	restoredIDs := make([]int64, 0)
+	for _, collection := range restored.GetCollectionBackups() {
+		for _, partition := range collection.GetPartitionBackups() {
+			for _, segment := range partition.GetSegmentBackups() {
+				assert.Equal(t, partition.GetPartitionId(), segment.GetPartitionId())
+				restoredIDs = append(restoredIDs, segment.GetSegmentId())
+			}
+		}
+	}
+	assert.Equal(t, segmentIDs, restoredIDs)
+	assert.Equal(t, int64(len(segmentIDs)), restored.GetSize())
+}
+
+func TestFullMetaSize(t *testing.T) {
+	meta := newMetaManager()
+	meta.AddBackup(&backuppb.BackupInfo{Id: "backup"})
+	meta.AddCollection(&backuppb.CollectionBackupInfo{Id: "backup", CollectionId: 1})
+	// segments are added without size in prepare, and filled later
+	for partitionID := int64(10); partitionID < 12; partitionID++ {
+		meta.AddSegment(&backuppb.SegmentBackupInfo{CollectionId: 1, PartitionId: partitionID, SegmentId: partitionID * 10})
+		meta.AddPartition(&backuppb.PartitionBackupInfo{CollectionId: 1, PartitionId: partitionID})
+	}
+	meta.UpdateSegment(10, 100, setSegmentSize(100), setSegmentBackuped(true))
+	meta.UpdateSegment(11, 110, setSegmentSize(50))
+	// sizes kept in meta, e.g. set by a retried prepare, are not summed again
+	meta.UpdateCollection("backup", 1, setCollectionSize(150))
+	meta.UpdatePartition(1, 10, setPartitionSize(100))
+
+	for i := 0; i < 2; i++ {
+		backup := meta.GetFullMeta("backup")
+		assert.Equal(t, int64(150), backup.GetSize())
+		assert.Equal(t, int64(150), backup.GetCollectionBackups()[0].GetSize())
+		assert.Equal(t, int32(66), backup.GetProgress())
+		assert.Len(t, meta.GetPartitions(1), 2)
+	}
+}
+
+func TestBackupSummary(t *testing.T) {
+	backup := &backuppb.BackupInfo{
+		Id:            "id",
+		Name:          "backup",
+		StateCode:     backuppb.BackupTaskStateCode_BACKUP_SUCCESS,
+		StartTime:     1700000000000,
+		MilvusVersion: "v2.4.0",
+		BinlogTypes:   []string{BINLOG_TYPE_INSERT},
+		CollectionBackups: []*backuppb.CollectionBackupInfo{
+			{DbName: "default", CollectionName: "c2", RowCount: 10},
+			{
+				DbName:         "default",
+				CollectionName: "c1",
+				Size:           100,
+				PartitionBackups: []*backuppb.PartitionBackupInfo{
+					{PartitionName: "_default", SegmentBackups: []*backuppb.SegmentBackupInfo{{SegmentId: 1}, {SegmentId: 2}}},
+				},
+				IndexInfos: []*backuppb.IndexInfo{{FieldName: "vec", IndexName: "idx", IndexType: "HNSW"}},
+			},
+		},
+	}
+	summaryBytes, err := backupSummaryBytes(backup)
+	assert.NoError(t, err)
+	var summary backupSummary
+	assert.NoError(t, jsoniter.Unmarshal(summaryBytes, &summary))
+	assert.Equal(t, "backup", summary.BackupName)
+	assert.Equal(t, "BACKUP_SUCCESS", summary.State)
+	assert.Equal(t, "2023-11-14T22:13:20Z", summary.StartTime)
+	assert.Empty(t, summary.EndTime)
+	assert.Equal(t, []string{BINLOG_TYPE_INSERT}, summary.Options.BinlogTypes)
+	assert.Len(t, summary.Collections, 2)
+	assert.Equal(t, "c1", summary.Collections[0].CollectionName)
+	assert.Equal(t, 2, summary.Collections[0].SegmentNum)
+	assert.Equal(t, []string{"idx(vec:HNSW)"}, summary.Collections[0].Indexes)
+	assert.Equal(t, int64(10), summary.Collections[1].RowCount)
+}
+
+func TestEscapePathName(t *testing.T) {
+	// valid names keep their paths
+	assert.Equal(t, "backup/my_backup_1/", BackupDirPath("backup", "my_backup_1"))
+	assert.Equal(t, "my_backup_1", BackupPathToName("backup", "backup/my_backup_1/"))
+
+	for _, name := range []string{"my backup", "备份_1", "a/b", "50%", "a\\b"} {
+		dir := BackupDirPath("backup", name)
+		assert.Equal(t, 3, len(strings.Split(dir, SEPERATOR)), dir)
+		assert.Equal(t, name, BackupPathToName("backup", dir))
+		assert.True(t, strings.HasPrefix(BackupMetaPath("backup", name), dir))
+		assert.True(t, strings.HasPrefix(BackupBinlogDirPath("backup", name), dir))
+	}
+	assert.Equal(t, "my%20backup", EscapePathName("my backup"))
+	assert.Equal(t, "a%2Fb", EscapePathName("a/b"))
+	// not escaped names are taken as they are
+	assert.Equal(t, "100%", UnescapePathName("100%"))
+}
+
+func TestRestoreDbCollectionsJson(t *testing.T) {
+	var dbCollections RestoreDbCollections
+	err := jsoniter.UnmarshalFromString(`{"db1":["coll1",{"name":"coll2","shards_num":4}],"db2":[]}`, &dbCollections)
+	assert.NoError(t, err)
+	assert.Equal(t, []RestoreCollection{{Name: "coll1"}, {Name: "coll2", ShardsNum: 4}}, dbCollections["db1"])
+	assert.Empty(t, dbCollections["db2"])
+
+	assert.NoError(t, validateShardsNum(0, 16))
+	assert.NoError(t, validateShardsNum(16, 16))
+	assert.Error(t, validateShardsNum(17, 16))
+	assert.Error(t, validateShardsNum(-1, 16))
+	assert.NoError(t, validateShardsNum(64, 0))
+}
@@ -0,0 +1,122 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/zilliztech/milvus-backup/internal/log"
+)
+
+// nestedLayout returns whether backups can be in subdirectories of the backup root path, see backup.maxBackupPathDepth
+func (b *BackupContext) nestedLayout() bool {
+	return b.params.BackupCfg.MaxBackupPathDepth > 1
+}
+
+// backupPathOf is the path of a backup in the backup root path. In the nested layout, the name is the path of
+// the backup relative to the backup root path, e.g. 2024/01/backup_x
+func (b *BackupContext) backupPathOf(backupName string) string {
+	if b.nestedLayout() {
+		return NestedBackupPath(b.backupRootPath, backupName)
+	}
+	return BackupPath(b.backupRootPath, backupName)
+}
+
+// backupParentOf returns the directory holding a backup and the last element of its name, so that the path helpers of
+// the flat layout, e.g. BackupMetaPath(dir, name), resolve the paths of the backup in both layouts
+func (b *BackupContext) backupParentOf(backupName string) (string, string) {
+	i := strings.LastIndex(backupName, SEPERATOR)
+	if !b.nestedLayout() || i < 0 {
+		return b.backupRootPath, backupName
+	}
+	return NestedBackupPath(b.backupRootPath, backupName[:i]), backupName[i+1:]
+}
+
+// listNestedBackupNames searches the backups under the backup root path level by level, up to
+// backup.maxBackupPathDepth levels. A directory with a backup meta file is a backup and is not searched further.
+// The names are sorted.
+func (b *BackupContext) listNestedBackupNames(ctx context.Context) ([]string, error) {
+	names := make([]string, 0)
+	dirs := []string{b.backupRootPath + SEPERATOR}
+	for depth := 1; depth <= b.params.BackupCfg.MaxBackupPathDepth && len(dirs) > 0; depth++ {
+		subDirs := make([]string, 0)
+		for _, dir := range dirs {
+			paths, _, err := b.getStorageClient().ListWithPrefix(ctx, b.backupBucketName, dir, false)
+			if err != nil {
+				return nil, fmt.Errorf("fail to list backup directory %s, err: %w", dir, err)
+			}
+			for _, path := range paths {
+				name := NestedBackupPathToName(b.backupRootPath, path)
+				if name == "" {
+					continue
+				}
+				exist, err := b.getStorageClient().Exist(ctx, b.backupBucketName, NestedBackupPath(b.backupRootPath, name)+SEPERATOR+META_PREFIX+SEPERATOR+BACKUP_META_FILE)
+				if err != nil {
+					return nil, fmt.Errorf("fail to check backup %s exist, err: %w", name, err)
+				}
+				if exist {
+					names = append(names, name)
+				} else {
+					subDirs = append(subDirs, strings.TrimSuffix(path, SEPERATOR)+SEPERATOR)
+				}
+			}
+		}
+		dirs = subDirs
+	}
+	sort.Strings(names)
+	log.Info("found backups in the nested layout", zap.Int("count", len(names)), zap.Int("maxBackupPathDepth", b.params.BackupCfg.MaxBackupPathDepth))
+	return names, nil
+}
+
+// pageBackupNames returns the sorted names after startAfter, at most limit names if limit > 0,
+// and the last returned name as the next token if there are more
+func pageBackupNames(names []string, startAfter string, limit int) ([]string, string) {
+	page := make([]string, 0)
+	for _, name := range names {
+		if name <= startAfter {
+			continue
+		}
+		if limit > 0 && len(page) == limit {
+			return page, page[len(page)-1]
+		}
+		page = append(page, name)
+	}
+	return page, ""
+}
+
+// containsNestedBackup returns whether there is a backup in a subdirectory of the backup dir,
+// so that the dir of the nested layout is not taken as an orphan partial backup
+func (b *BackupContext) containsNestedBackup(ctx context.Context, backupDir string) (bool, error) {
+	keys, _, err := b.getStorageClient().ListWithPrefix(ctx, b.backupBucketName, backupDir, true)
+	if err != nil {
+		return false, err
+	}
+	for _, key := range keys {
+		if strings.HasSuffix(key, SEPERATOR+META_PREFIX+SEPERATOR+BACKUP_META_FILE) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// NestedBackupPath is the path of a backup named by its path relative to backupRootPath, e.g. 2024/01/backup_x,
+// each element of the name is escaped separately so that the separators are kept
+func NestedBackupPath(backupRootPath, backupName string) string {
+	elems := strings.Split(backupName, SEPERATOR)
+	for i, elem := range elems {
+		elems[i] = EscapePathName(elem)
+	}
+	return backupRootPath + SEPERATOR + strings.Join(elems, SEPERATOR)
+}
+
+// NestedBackupPathToName is the reverse of NestedBackupPath, the trailing separator of the path is ignored
+func NestedBackupPathToName(backupRootPath, path string) string {
+	elems := strings.Split(strings.Trim(strings.TrimPrefix(path, backupRootPath+SEPERATOR), SEPERATOR), SEPERATOR)
+	for i, elem := range elems {
+		elems[i] = UnescapePathName(elem)
+	}
+	return strings.Join(elems, SEPERATOR)
+}
@@ -0,0 +1,88 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+func TestNestedBackupPath(t *testing.T) {
+	assert.Equal(t, "backup/my_backup_1", NestedBackupPath("backup", "my_backup_1"))
+	assert.Equal(t, "backup/2024/01/my%20backup", NestedBackupPath("backup", "2024/01/my backup"))
+	assert.Equal(t, "2024/01/my backup", NestedBackupPathToName("backup", "backup/2024/01/my%20backup/"))
+	assert.Equal(t, "my_backup_1", NestedBackupPathToName("backup", "backup/my_backup_1"))
+
+	names := []string{"2024/01/a", "2024/01/b", "2024/02/a"}
+	page, next := pageBackupNames(names, "", 2)
+	assert.Equal(t, []string{"2024/01/a", "2024/01/b"}, page)
+	assert.Equal(t, "2024/01/b", next)
+	page, next = pageBackupNames(names, next, 2)
+	assert.Equal(t, []string{"2024/02/a"}, page)
+	assert.Equal(t, "", next)
+	page, next = pageBackupNames(names, "", 0)
+	assert.Equal(t, names, page)
+	assert.Equal(t, "", next)
+}
+
+func TestNestedBackupExportRelocate(t *testing.T) {
+	ctx := context.Background()
+	b := newLocalBackupContext(t)
+	b.started = true
+	b.params.BackupCfg.MaxBackupPathDepth = 3
+	backupInfo := &backuppb.BackupInfo{
+		Id:             "backup-id",
+		Name:           "b1",
+		StateCode:      backuppb.BackupTaskStateCode_BACKUP_SUCCESS,
+		MilvusRootPath: "files",
+		CollectionBackups: []*backuppb.CollectionBackupInfo{{
+			Id:             "backup-id",
+			CollectionId:   1,
+			CollectionName: "coll",
+			PartitionBackups: []*backuppb.PartitionBackupInfo{{
+				CollectionId: 1,
+				PartitionId:  2,
+				SegmentBackups: []*backuppb.SegmentBackupInfo{{CollectionId: 1, PartitionId: 2, SegmentId: 3,
+					Binlogs: []*backuppb.FieldBinlog{{Binlogs: []*backuppb.Binlog{{LogPath: "files/insert_log/1/2/3/100/1"}}}}}},
+			}},
+		}},
+	}
+	backupDir, name := b.backupParentOf("2024/01/b1")
+	assert.Equal(t, NestedBackupPath(b.backupRootPath, "2024/01"), backupDir)
+	assert.Equal(t, "b1", name)
+	output, err := serializeWithSegmentShards(backupInfo, 0)
+	assert.NoError(t, err)
+	for _, metaFile := range backupMetaFiles(backupDir, name, output) {
+		assert.NoError(t, b.getStorageClient().Write(ctx, b.backupBucketName, metaFile.path, metaFile.content))
+	}
+	binlogPath := BackupBinlogDirPath(backupDir, name) + "/insert_log/1/2/3/100/1"
+	assert.NoError(t, b.getStorageClient().Write(ctx, b.backupBucketName, binlogPath, []byte("binlog")))
+
+	// exported relative to its dir, imported into the backup root path by the last element of its name
+	var tarball bytes.Buffer
+	assert.NoError(t, b.ExportBackup(ctx, "2024/01/b1", &tarball, false))
+	imported, err := b.ImportBackup(ctx, bytes.NewReader(tarball.Bytes()), "")
+	assert.NoError(t, err)
+	assert.Equal(t, "b1", imported)
+	exist, err := b.getStorageClient().Exist(ctx, b.backupBucketName, BackupBinlogDirPath(b.backupRootPath, "b1")+"/insert_log/1/2/3/100/1")
+	assert.NoError(t, err)
+	assert.True(t, exist)
+
+	dir := t.TempDir()
+	assert.NoError(t, b.ExportCollectionData(ctx, "2024/01/b1", "", "coll", dir))
+	binlog, err := os.ReadFile(filepath.Join(dir, "insert_log/1/2/3/100/1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "binlog", string(binlog))
+
+	assert.NoError(t, b.RelocateBackup(ctx, "2024/01/b1", "files", "data/files"))
+	relocated, err := b.readBackup(ctx, b.backupBucketName, b.backupPathOf("2024/01/b1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "data/files", relocated.GetMilvusRootPath())
+
+	assert.ErrorContains(t, b.RenameBackup(ctx, "2024/01/b1", "b2"), "not supported")
+}
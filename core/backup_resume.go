@@ -0,0 +1,100 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+	"github.com/zilliztech/milvus-backup/internal/log"
+)
+
+// writePreparedMeta records the collections prepared by a backup before copying the data, so that the backup
+// can be resumed without flushing the collections again if it is interrupted. The backup stays invisible
+// until the backup meta file is written, the prepared meta is removed after that.
+func (b *BackupContext) writePreparedMeta(ctx context.Context, backupID string) error {
+	backupInfo := b.meta.GetFullMeta(backupID)
+	content, err := json.Marshal(backupInfo)
+	if err != nil {
+		return err
+	}
+	return b.writeBackupMetaFile(ctx, backupMetaFile{PreparedMetaPath(b.backupRootPath, backupInfo.GetName()), content})
+}
+
+// loadPreparedMeta adds the collections prepared by the interrupted backup to the resumed one,
+// it returns false if the interrupted backup has no prepared meta, i.e. it was interrupted before the copy
+func (b *BackupContext) loadPreparedMeta(ctx context.Context, backupInfo *backuppb.BackupInfo) (bool, error) {
+	preparedMetaPath := PreparedMetaPath(b.backupRootPath, backupInfo.GetName())
+	exist, err := b.getStorageClient().Exist(ctx, b.backupBucketName, preparedMetaPath)
+	if err != nil {
+		return false, fmt.Errorf("fail to check %s exist, err: %w", preparedMetaPath, err)
+	}
+	if !exist {
+		return false, nil
+	}
+	content, err := b.getStorageClient().Read(ctx, b.backupBucketName, preparedMetaPath)
+	if err != nil {
+		return false, fmt.Errorf("fail to read %s, err: %w", preparedMetaPath, err)
+	}
+	prepared := &backuppb.BackupInfo{}
+	if err := json.Unmarshal(content, prepared); err != nil {
+		return false, fmt.Errorf("fail to unmarshal %s, err: %w", preparedMetaPath, err)
+	}
+
+	b.addPreparedCollections(backupInfo.GetId(), prepared)
+	log.Info("resume the collections prepared by the interrupted backup",
+		zap.String("backupName", backupInfo.GetName()),
+		zap.Int("collections", len(prepared.GetCollectionBackups())))
+	return true, nil
+}
+
+// addPreparedCollections adds the collections, partitions and segments of the prepared meta to the backup
+func (b *BackupContext) addPreparedCollections(backupID string, prepared *backuppb.BackupInfo) {
+	for _, collection := range prepared.GetCollectionBackups() {
+		partitions := collection.GetPartitionBackups()
+		collection.Id = backupID
+		collection.PartitionBackups = nil
+		b.meta.AddCollection(collection)
+		for _, partition := range partitions {
+			for _, segment := range partition.GetSegmentBackups() {
+				b.meta.AddSegment(segment)
+			}
+			b.meta.AddPartition(partition)
+		}
+		for _, segment := range collection.GetL0Segments() {
+			b.meta.AddSegment(segment)
+		}
+	}
+	opts := []BackupOpt{
+		setDatabaseBackups(prepared.GetDatabaseBackups()),
+		addUnlocatedSegmentIDs(prepared.GetUnlocatedSegmentIds()),
+		// the binlogs already copied are encrypted with the data key of the interrupted backup
+		setEncryptionOf(prepared),
+	}
+	for _, collection := range prepared.GetSkippedCollections() {
+		opts = append(opts, addSkippedCollection(collection))
+	}
+	b.meta.UpdateBackup(backupID, opts...)
+}
+
+func (b *BackupContext) removePreparedMeta(ctx context.Context, backupName string) {
+	if err := b.getStorageClient().Remove(ctx, b.backupBucketName, PreparedMetaPath(b.backupRootPath, backupName)); err != nil {
+		log.Warn("fail to remove the prepared meta of the backup", zap.String("backupName", backupName), zap.Error(err))
+	}
+}
+
+// isBinlogCopied returns whether the binlog is in the backup bucket with the size recorded in the segment meta,
+// so that a resumed backup doesn't copy it again
+func (b *BackupContext) isBinlogCopied(ctx context.Context, targetPath string, size int64) (bool, error) {
+	copiedSize, exist, err := b.listedObjectSize(ctx, b.backupBucketName, targetPath)
+	if err != nil {
+		return false, fmt.Errorf("fail to check %s copied, err: %w", targetPath, err)
+	}
+	return exist && copiedSize == size, nil
+}
+
+func PreparedMetaPath(backupRootPath, backupName string) string {
+	return BackupMetaDirPath(backupRootPath, backupName) + SEPERATOR + PREPARED_META_FILE
+}
@@ -0,0 +1,52 @@
+package core
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+func TestAddPreparedCollections(t *testing.T) {
+	prepared := &backuppb.BackupInfo{
+		Id:                  "interrupted",
+		Name:                "backup",
+		UnlocatedSegmentIds: []int64{9},
+		CollectionBackups: []*backuppb.CollectionBackupInfo{{
+			Id:             "interrupted",
+			CollectionId:   1,
+			CollectionName: "coll",
+			PartitionBackups: []*backuppb.PartitionBackupInfo{{
+				PartitionId:  2,
+				CollectionId: 1,
+				SegmentBackups: []*backuppb.SegmentBackupInfo{
+					{SegmentId: 3, CollectionId: 1, PartitionId: 2, Size: 10},
+					{SegmentId: 4, CollectionId: 1, PartitionId: 2, Size: 20},
+				},
+			}},
+			L0Segments: []*backuppb.SegmentBackupInfo{{SegmentId: 5, CollectionId: 1, PartitionId: -1, IsL0: true}},
+		}},
+	}
+	content, err := json.Marshal(prepared)
+	assert.NoError(t, err)
+	loaded := &backuppb.BackupInfo{}
+	assert.NoError(t, json.Unmarshal(content, loaded))
+
+	meta := newMetaManager()
+	meta.AddBackup(&backuppb.BackupInfo{Id: "resumed", Name: "backup", Resumed: true})
+	b := &BackupContext{meta: meta}
+	b.addPreparedCollections("resumed", loaded)
+
+	full := meta.GetFullMeta("resumed")
+	assert.Equal(t, []int64{9}, full.GetUnlocatedSegmentIds())
+	assert.Equal(t, 1, len(full.GetCollectionBackups()))
+	collection := full.GetCollectionBackups()[0]
+	assert.Equal(t, "resumed", collection.GetId())
+	assert.Equal(t, int64(30), collection.GetSize())
+	assert.Equal(t, 2, len(collection.GetPartitionBackups()[0].GetSegmentBackups()))
+	assert.Equal(t, "backup", meta.GetBackupByCollectionID(1).GetName())
+	assert.NotNil(t, meta.GetSegment(3))
+	assert.True(t, meta.GetSegment(5).GetIsL0())
+}
@@ -0,0 +1,122 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zilliztech/milvus-backup/internal/util/retry"
+)
+
+// retryBudget limits the total time a backup spends retrying, summed across the retries of all the collections,
+// so that a collection failing again and again can't keep the backup running for hours.
+// A nil budget is unlimited.
+type retryBudget struct {
+	budget    time.Duration
+	exhausted chan struct{}
+
+	mu       sync.Mutex
+	spent    time.Duration
+	failures map[string]error // collection -> last error, removed when the collection succeeds
+}
+
+// newRetryBudget returns nil if budget is 0, i.e. the retries are only limited by their attempts
+func newRetryBudget(budget time.Duration) *retryBudget {
+	if budget <= 0 {
+		return nil
+	}
+	return &retryBudget{
+		budget:    budget,
+		exhausted: make(chan struct{}),
+		failures:  make(map[string]error),
+	}
+}
+
+// charge adds the time spent retrying to the budget and returns false once the budget is exhausted
+func (rb *retryBudget) charge(duration time.Duration) bool {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	if rb.spent >= rb.budget {
+		return false
+	}
+	rb.spent += duration
+	if rb.spent >= rb.budget {
+		close(rb.exhausted)
+		return false
+	}
+	return true
+}
+
+func (rb *retryBudget) isExhausted() bool {
+	if rb == nil {
+		return false
+	}
+	select {
+	case <-rb.exhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+// wrap returns fn charging the time after its first failure to the budget, the waits between the attempts included.
+// It stops the retries of retry.Do once the budget is exhausted.
+func (rb *retryBudget) wrap(collection string, fn func() error) func() error {
+	if rb == nil {
+		return fn
+	}
+	var lastFailure time.Time
+	return func() error {
+		if !lastFailure.IsZero() && !rb.charge(time.Since(lastFailure)) {
+			return retry.Unrecoverable(rb.err())
+		}
+		start := time.Now()
+		err := fn()
+		rb.mu.Lock()
+		if err != nil {
+			rb.failures[collection] = err
+		} else {
+			delete(rb.failures, collection)
+		}
+		rb.mu.Unlock()
+		if err != nil {
+			if !lastFailure.IsZero() && !rb.charge(time.Since(start)) {
+				return retry.Unrecoverable(rb.err())
+			}
+			lastFailure = time.Now()
+		}
+		return err
+	}
+}
+
+// context returns a context canceled once the budget is exhausted, to stop the retries waiting for the next attempt
+func (rb *retryBudget) context(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	if rb == nil {
+		return ctx, cancel
+	}
+	go func() {
+		select {
+		case <-rb.exhausted:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// err aggregates the last errors of the collections still failing
+func (rb *retryBudget) err() error {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	failures := make([]string, 0, len(rb.failures))
+	for collection, err := range rb.failures {
+		failures = append(failures, fmt.Sprintf("%s: %s", collection, err))
+	}
+	sort.Strings(failures)
+	return fmt.Errorf("backup spent more than backup.totalRetryBudgetSeconds %s retrying, failed collections: %s",
+		rb.budget, strings.Join(failures, "; "))
+}
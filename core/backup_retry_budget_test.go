@@ -0,0 +1,45 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zilliztech/milvus-backup/internal/util/retry"
+)
+
+func TestRetryBudget(t *testing.T) {
+	var unlimited *retryBudget
+	assert.False(t, unlimited.isExhausted())
+	fn := func() error { return nil }
+	assert.NoError(t, unlimited.wrap("db.c1", fn)())
+	assert.Nil(t, newRetryBudget(0))
+
+	budget := newRetryBudget(50 * time.Millisecond)
+	ctx, cancel := budget.context(context.Background())
+	defer cancel()
+
+	// the collection succeeding after a retry is not listed
+	attempts := 0
+	err := retry.Do(ctx, budget.wrap("db.c1", func() error {
+		attempts++
+		if attempts < 2 {
+			return errors.New("flush failed")
+		}
+		return nil
+	}), retry.Sleep(time.Millisecond), retry.Attempts(10))
+	assert.NoError(t, err)
+	assert.False(t, budget.isExhausted())
+
+	err = retry.Do(ctx, budget.wrap("db.c2", func() error {
+		return errors.New("collection not loaded")
+	}), retry.Sleep(10*time.Millisecond), retry.MaxSleepTime(10*time.Millisecond), retry.Attempts(1000))
+	assert.Error(t, err)
+	assert.True(t, budget.isExhausted())
+	<-ctx.Done()
+	assert.Contains(t, budget.err().Error(), "db.c2: collection not loaded")
+	assert.NotContains(t, budget.err().Error(), "db.c1")
+}
@@ -11,6 +11,7 @@ import (
 	"go.uber.org/zap"
 	"net/http"
 	"net/http/pprof"
+	"strconv"
 )
 
 const (
@@ -18,9 +19,12 @@ const (
 	CREATE_BACKUP_API  = "/create"
 	LIST_BACKUPS_API   = "/list"
 	GET_BACKUP_API     = "/get_backup"
+	HAS_BACKUP_API     = "/has_backup"
 	DELETE_BACKUP_API  = "/delete"
 	RESTORE_BACKUP_API = "/restore"
 	GET_RESTORE_API    = "/get_restore"
+	GET_EVENTS_API     = "/get_events"
+	CLEANUP_API        = "/cleanup"
 
 	API_V1_PREFIX = "/api/v1"
 
@@ -109,9 +113,12 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.POST(CREATE_BACKUP_API, wrapHandler(h.handleCreateBackup))
 	router.GET(LIST_BACKUPS_API, wrapHandler(h.handleListBackups))
 	router.GET(GET_BACKUP_API, wrapHandler(h.handleGetBackup))
+	router.GET(HAS_BACKUP_API, wrapHandler(h.handleHasBackup))
 	router.DELETE(DELETE_BACKUP_API, wrapHandler(h.handleDeleteBackup))
 	router.POST(RESTORE_BACKUP_API, wrapHandler(h.handleRestoreBackup))
 	router.GET(GET_RESTORE_API, wrapHandler(h.handleGetRestore))
+	router.GET(GET_EVENTS_API, wrapHandler(h.handleGetEvents))
+	router.POST(CLEANUP_API, wrapHandler(h.handleCleanupOrphans))
 	router.GET(CHECK_API, wrapHandler(h.handleCheck))
 	router.GET(DOCS_API, ginSwagger.WrapHandler(swaggerFiles.Handler))
 }
@@ -158,12 +165,21 @@ func (h *Handlers) handleCreateBackup(c *gin.Context) (interface{}, error) {
 // @Produce application/json
 // @Param request_id header string false "request_id"
 // @Param collection_name query string true "collection_name"
+// @Param continuation_token query string false "next_continuation_token of the last page"
+// @Param limit query int false "max number of backup dirs to list in one page"
 // @Success 200 {object} backuppb.ListBackupsResponse
 // @Router /list [get]
 func (h *Handlers) handleListBackups(c *gin.Context) (interface{}, error) {
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "0"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+		return nil, nil
+	}
 	req := backuppb.ListBackupsRequest{
-		RequestId:      c.GetHeader("request_id"),
-		CollectionName: c.Query("collection_name"),
+		RequestId:         c.GetHeader("request_id"),
+		CollectionName:    c.Query("collection_name"),
+		ContinuationToken: c.Query("continuation_token"),
+		Limit:             int32(limit),
 	}
 	resp := h.backupContext.ListBackups(h.backupContext.ctx, &req)
 	if h.backupContext.params.HTTPCfg.SimpleResponse {
@@ -197,6 +213,29 @@ func (h *Handlers) handleGetBackup(c *gin.Context) (interface{}, error) {
 	return nil, nil
 }
 
+// HasBackup Has backup interface
+// @Summary Has backup interface
+// @Description Check whether a backup with the given name exists, without reading the backup
+// @Tags Backup
+// @Produce application/json
+// @Param backup_name query string true "backup_name"
+// @Success 200 {object} map[string]interface{}
+// @Router /has_backup [get]
+func (h *Handlers) handleHasBackup(c *gin.Context) (interface{}, error) {
+	backupName := c.Query("backup_name")
+	if backupName == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "empty backup name"})
+		return nil, nil
+	}
+	exist, err := h.backupContext.HasBackup(h.backupContext.ctx, backupName)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, nil
+	}
+	c.JSON(http.StatusOK, gin.H{"backup_name": backupName, "exist": exist})
+	return nil, nil
+}
+
 // DeleteBackup Delete backup interface
 // @Summary Delete backup interface
 // @Description Delete a backup with the given name
@@ -204,12 +243,14 @@ func (h *Handlers) handleGetBackup(c *gin.Context) (interface{}, error) {
 // @Produce application/json
 // @Param request_id header string false "request_id"
 // @Param backup_name query string true "backup_name"
+// @Param force query bool false "delete the base of incremental backups"
 // @Success 200 {object} backuppb.DeleteBackupResponse
 // @Router /delete [delete]
 func (h *Handlers) handleDeleteBackup(c *gin.Context) (interface{}, error) {
 	req := backuppb.DeleteBackupRequest{
 		RequestId:  c.GetHeader("request_id"),
 		BackupName: c.Query("backup_name"),
+		Force:      c.Query("force") == "true",
 	}
 	resp := h.backupContext.DeleteBackup(h.backupContext.ctx, &req)
 	c.JSON(http.StatusOK, resp)
@@ -268,6 +309,62 @@ func (h *Handlers) handleGetRestore(c *gin.Context) (interface{}, error) {
 	return nil, nil
 }
 
+// GetEvents Get events interface
+// @Summary Get events interface
+// @Description Long poll the events of a backup or restore with the given id
+// @Tags Backup
+// @Produce application/json
+// @Param request_id header string false "request_id"
+// @param id query string true "backup id or restore id"
+// @param after_seq query int false "only return events after this seq"
+// @param wait_seconds query int false "seconds to wait for new events"
+// @Success 200 {object} backuppb.GetEventsResponse
+// @Router /get_events [get]
+func (h *Handlers) handleGetEvents(c *gin.Context) (interface{}, error) {
+	afterSeq, err := strconv.ParseInt(c.DefaultQuery("after_seq", "0"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid after_seq"})
+		return nil, nil
+	}
+	waitSeconds, err := strconv.ParseInt(c.DefaultQuery("wait_seconds", "0"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid wait_seconds"})
+		return nil, nil
+	}
+	req := backuppb.GetEventsRequest{
+		RequestId:   c.GetHeader("request_id"),
+		Id:          c.Query("id"),
+		AfterSeq:    afterSeq,
+		WaitSeconds: int32(waitSeconds),
+	}
+	// stop waiting once the client goes away
+	resp := h.backupContext.GetEvents(c.Request.Context(), &req)
+	c.JSON(http.StatusOK, resp)
+	return nil, nil
+}
+
+// CleanupOrphans Cleanup orphans interface
+// @Summary Cleanup orphans interface
+// @Description Remove partial backups without backup meta, which are left by crashed backups
+// @Tags Backup
+// @Accept application/json
+// @Produce application/json
+// @Param request_id header string false "request_id"
+// @Param object body backuppb.CleanupOrphansRequest   true  "CleanupOrphansRequest JSON"
+// @Success 200 {object} backuppb.CleanupOrphansResponse
+// @Router /cleanup [post]
+func (h *Handlers) handleCleanupOrphans(c *gin.Context) (interface{}, error) {
+	requestBody := backuppb.CleanupOrphansRequest{}
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return nil, nil
+	}
+	requestBody.RequestId = c.GetHeader("request_id")
+	resp := h.backupContext.CleanupOrphans(h.backupContext.ctx, &requestBody)
+	c.JSON(http.StatusOK, resp)
+	return nil, nil
+}
+
 func (h *Handlers) handleCheck(c *gin.Context) (interface{}, error) {
 	resp := h.backupContext.Check(h.backupContext.ctx)
 	c.JSON(http.StatusOK, resp)
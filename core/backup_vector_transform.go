@@ -0,0 +1,154 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/samber/lo"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+// VectorTransformer converts the values of a float vector field during restore, e.g. re-embedding or changing the dimension,
+// so that a backup can be restored into a collection with another vector dimension.
+//
+// Applying a transformer is expensive: bulkinsert imports the backup binlogs as they are, so every insert binlog
+// of the transformed fields has to be read, decoded, transformed and written back to the staging dir, the whole
+// data goes through the backup process instead of a server side copy. So it's never applied by default,
+// NoopVectorTransformer is used for the fields not opted in by vector_transformers of the restore request.
+//
+// The binlog rewrite stage is not built in yet, insert binlogs are parquet payloads and there is no codec for them
+// in the dependencies. So a transformer changing the vectors fails the restore with errBinlogRewriteNotSupported,
+// only the transformers and their dimension rules are defined here for the stage to plug in.
+type VectorTransformer interface {
+	// Name identifies the transformer to opt in
+	Name() string
+	// TargetDim returns the dimension of the transformed vectors of the field
+	TargetDim(field *backuppb.FieldSchema) (int, error)
+	// Transform converts the vectors of one binlog, which are in row order with dim values each,
+	// the result has TargetDim values each in the same order
+	Transform(field *backuppb.FieldSchema, vectors []float32, dim int) ([]float32, error)
+}
+
+const (
+	NoopVectorTransformerName        = "noop"
+	PadTruncateVectorTransformerName = "pad_truncate"
+)
+
+// vectorFieldDim reads the dim type param of a vector field
+func vectorFieldDim(field *backuppb.FieldSchema) (int, error) {
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == "dim" {
+			dim, err := strconv.Atoi(kv.GetValue())
+			if err != nil {
+				return 0, fmt.Errorf("illegal dim %s of field %s, err: %w", kv.GetValue(), field.GetName(), err)
+			}
+			return dim, nil
+		}
+	}
+	return 0, fmt.Errorf("field %s has no dim", field.GetName())
+}
+
+// NoopVectorTransformer keeps the vectors as they are, it is the default of all the fields
+type NoopVectorTransformer struct{}
+
+func (NoopVectorTransformer) Name() string { return NoopVectorTransformerName }
+
+func (NoopVectorTransformer) TargetDim(field *backuppb.FieldSchema) (int, error) {
+	return vectorFieldDim(field)
+}
+
+func (NoopVectorTransformer) Transform(field *backuppb.FieldSchema, vectors []float32, dim int) ([]float32, error) {
+	return vectors, nil
+}
+
+// PadTruncateVectorTransformer changes the dimension to Dim, zeros are appended to shorter vectors and longer vectors are cut.
+// Truncating only keeps the search quality for embeddings trained to be truncated, e.g. matryoshka embeddings.
+type PadTruncateVectorTransformer struct {
+	Dim int
+}
+
+func (t PadTruncateVectorTransformer) Name() string { return PadTruncateVectorTransformerName }
+
+func (t PadTruncateVectorTransformer) TargetDim(field *backuppb.FieldSchema) (int, error) {
+	if t.Dim <= 0 {
+		return 0, fmt.Errorf("illegal target dim %d of field %s", t.Dim, field.GetName())
+	}
+	return t.Dim, nil
+}
+
+func (t PadTruncateVectorTransformer) Transform(field *backuppb.FieldSchema, vectors []float32, dim int) ([]float32, error) {
+	if dim <= 0 || len(vectors)%dim != 0 {
+		return nil, fmt.Errorf("%d values of field %s are not vectors of dim %d", len(vectors), field.GetName(), dim)
+	}
+	if t.Dim <= 0 {
+		return nil, fmt.Errorf("illegal target dim %d of field %s", t.Dim, field.GetName())
+	}
+	if dim == t.Dim {
+		return vectors, nil
+	}
+	rows := len(vectors) / dim
+	transformed := make([]float32, rows*t.Dim)
+	copyDim := dim
+	if t.Dim < dim {
+		copyDim = t.Dim
+	}
+	for i := 0; i < rows; i++ {
+		copy(transformed[i*t.Dim:i*t.Dim+copyDim], vectors[i*dim:i*dim+copyDim])
+	}
+	return transformed, nil
+}
+
+var errBinlogRewriteNotSupported = errors.New("binlog rewrite not supported")
+
+// newVectorTransformer builds the transformer of an option of the restore request
+func newVectorTransformer(option *backuppb.VectorTransformerOption) (VectorTransformer, error) {
+	switch option.GetName() {
+	case NoopVectorTransformerName:
+		return NoopVectorTransformer{}, nil
+	case PadTruncateVectorTransformerName:
+		return PadTruncateVectorTransformer{Dim: int(option.GetDim())}, nil
+	}
+	return nil, fmt.Errorf("unknown vector transformer %s, should be %s or %s", option.GetName(), NoopVectorTransformerName, PadTruncateVectorTransformerName)
+}
+
+// checkVectorTransformers validates the transformers opted in for the fields of a collection in backup.
+// Any transformer but noop needs the insert binlogs rewritten and fails with errBinlogRewriteNotSupported.
+func checkVectorTransformers(collection *backuppb.CollectionBackupInfo, options []*backuppb.VectorTransformerOption, defaultDB string) error {
+	fullCollectionName := collection.GetDbName() + "." + collection.GetCollectionName()
+	for _, option := range options {
+		optionCollectionName := option.GetCollectionName()
+		if optionCollectionName != "" {
+			optionCollectionName = FullCollectionName(optionCollectionName, defaultDB)
+		}
+		if optionCollectionName != "" && optionCollectionName != fullCollectionName {
+			continue
+		}
+
+		field, found := lo.Find(collection.GetSchema().GetFields(), func(field *backuppb.FieldSchema) bool {
+			return field.GetName() == option.GetFieldName()
+		})
+		if !found {
+			if optionCollectionName == "" {
+				continue
+			}
+			return fmt.Errorf("field %s not found", option.GetFieldName())
+		}
+		if field.GetDataType() != backuppb.DataType_FloatVector {
+			return fmt.Errorf("field %s is not a float vector field", option.GetFieldName())
+		}
+		transformer, err := newVectorTransformer(option)
+		if err != nil {
+			return err
+		}
+		if _, err := transformer.TargetDim(field); err != nil {
+			return err
+		}
+		if transformer.Name() != NoopVectorTransformerName {
+			return fmt.Errorf("vector transformer %s of field %s needs the insert binlogs rewritten: %w",
+				transformer.Name(), option.GetFieldName(), errBinlogRewriteNotSupported)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+func TestPadTruncateVectorTransformer(t *testing.T) {
+	field := &backuppb.FieldSchema{
+		Name:       "vec",
+		DataType:   backuppb.DataType_FloatVector,
+		TypeParams: []*backuppb.KeyValuePair{{Key: "dim", Value: "3"}},
+	}
+	vectors := []float32{1, 2, 3, 4, 5, 6}
+
+	dim, err := NoopVectorTransformer{}.TargetDim(field)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, dim)
+
+	padded, err := PadTruncateVectorTransformer{Dim: 4}.Transform(field, vectors, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{1, 2, 3, 0, 4, 5, 6, 0}, padded)
+
+	truncated, err := PadTruncateVectorTransformer{Dim: 2}.Transform(field, vectors, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{1, 2, 4, 5}, truncated)
+
+	_, err = PadTruncateVectorTransformer{Dim: 2}.Transform(field, vectors, 4)
+	assert.Error(t, err)
+	_, err = PadTruncateVectorTransformer{}.TargetDim(field)
+	assert.Error(t, err)
+}
+
+func TestCheckVectorTransformers(t *testing.T) {
+	collection := &backuppb.CollectionBackupInfo{
+		DbName:         "default",
+		CollectionName: "c1",
+		Schema: &backuppb.CollectionSchema{Fields: []*backuppb.FieldSchema{
+			{Name: "id", DataType: backuppb.DataType_Int64},
+			{Name: "vec", DataType: backuppb.DataType_FloatVector, TypeParams: []*backuppb.KeyValuePair{{Key: "dim", Value: "3"}}},
+		}},
+	}
+	assert.NoError(t, checkVectorTransformers(collection, nil, "default"))
+	assert.NoError(t, checkVectorTransformers(collection, []*backuppb.VectorTransformerOption{{FieldName: "vec", Name: NoopVectorTransformerName}}, "default"))
+	// options of other collections or of fields not in all the collections are ignored
+	assert.NoError(t, checkVectorTransformers(collection, []*backuppb.VectorTransformerOption{
+		{CollectionName: "c2", FieldName: "vec", Name: PadTruncateVectorTransformerName, Dim: 4},
+		{FieldName: "other", Name: PadTruncateVectorTransformerName, Dim: 4},
+	}, "default"))
+
+	err := checkVectorTransformers(collection, []*backuppb.VectorTransformerOption{{CollectionName: "c1", FieldName: "vec", Name: PadTruncateVectorTransformerName, Dim: 4}}, "default")
+	assert.ErrorIs(t, err, errBinlogRewriteNotSupported)
+	err = checkVectorTransformers(collection, []*backuppb.VectorTransformerOption{{CollectionName: "c1", FieldName: "other", Name: NoopVectorTransformerName}}, "default")
+	assert.ErrorContains(t, err, "not found")
+	err = checkVectorTransformers(collection, []*backuppb.VectorTransformerOption{{FieldName: "id", Name: NoopVectorTransformerName}}, "default")
+	assert.ErrorContains(t, err, "not a float vector field")
+	err = checkVectorTransformers(collection, []*backuppb.VectorTransformerOption{{FieldName: "vec", Name: "reembed"}}, "default")
+	assert.ErrorContains(t, err, "unknown vector transformer")
+	err = checkVectorTransformers(collection, []*backuppb.VectorTransformerOption{{FieldName: "vec", Name: PadTruncateVectorTransformerName}}, "default")
+	assert.ErrorContains(t, err, "illegal target dim")
+}
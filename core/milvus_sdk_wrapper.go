@@ -2,13 +2,21 @@ package core
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	gomilvus "github.com/milvus-io/milvus-sdk-go/v2/client"
 	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/encoding/protowire"
 
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
 	"github.com/zilliztech/milvus-backup/internal/util/retry"
 )
 
@@ -26,14 +34,26 @@ func (m *MilvusClient) GetVersion(ctx context.Context) (string, error) {
 	return m.client.GetVersion(ctx)
 }
 
-func (m *MilvusClient) CreateDatabase(ctx context.Context, dbName string) error {
-	return m.client.CreateDatabase(ctx, dbName)
+func (m *MilvusClient) CreateDatabase(ctx context.Context, dbName string, opts ...gomilvus.CreateDatabaseOption) error {
+	return m.client.CreateDatabase(ctx, dbName, opts...)
 }
 
 func (m *MilvusClient) ListDatabases(ctx context.Context) ([]entity.Database, error) {
 	return m.client.ListDatabases(ctx)
 }
 
+func (m *MilvusClient) DescribeDatabase(ctx context.Context, dbName string) (*entity.Database, error) {
+	return m.client.DescribeDatabase(ctx, dbName)
+}
+
+func (m *MilvusClient) DescribeUser(ctx context.Context, username string) (entity.UserDescription, error) {
+	return m.client.DescribeUser(ctx, username)
+}
+
+func (m *MilvusClient) ListGrants(ctx context.Context, role string, db string) ([]entity.RoleGrants, error) {
+	return m.client.ListGrants(ctx, role, db)
+}
+
 func (m *MilvusClient) DescribeCollection(ctx context.Context, db, collName string) (*entity.Collection, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -44,6 +64,195 @@ func (m *MilvusClient) DescribeCollection(ctx context.Context, db, collName stri
 	return m.client.DescribeCollection(ctx, collName)
 }
 
+var errRawDescribeNotSupported = errors.New("num partitions, aliases, functions and nullable fields are not supported by the milvus client")
+
+// describeCollectionRaw returns the describe response of milvus, which has fields not in the collection of the sdk
+func (m *MilvusClient) describeCollectionRaw(ctx context.Context, db, collName string) (*milvuspb.DescribeCollectionResponse, error) {
+	grpcClient, ok := m.client.(*gomilvus.GrpcClient)
+	if !ok {
+		return nil, errRawDescribeNotSupported
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.client.UsingDatabase(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := grpcClient.Service.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{DbName: db, CollectionName: collName})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	return resp, nil
+}
+
+// AllocTimestamp returns a fresh hybrid timestamp allocated by milvus, supported since milvus v2.4
+func (m *MilvusClient) AllocTimestamp(ctx context.Context) (uint64, error) {
+	grpcClient, ok := m.client.(*gomilvus.GrpcClient)
+	if !ok {
+		return 0, errors.New("alloc timestamp is not supported by the milvus client")
+	}
+	resp, err := grpcClient.Service.AllocTimestamp(ctx, &milvuspb.AllocTimestampRequest{})
+	if err != nil {
+		return 0, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return 0, errors.New(resp.GetStatus().GetReason())
+	}
+	return resp.GetTimestamp(), nil
+}
+
+// GetNumPartitions returns the num_partitions of a partition key collection
+func (m *MilvusClient) GetNumPartitions(ctx context.Context, db, collName string) (int64, error) {
+	resp, err := m.describeCollectionRaw(ctx, db, collName)
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetNumPartitions(), nil
+}
+
+// GetAliases returns the aliases of a collection
+func (m *MilvusClient) GetAliases(ctx context.Context, db, collName string) ([]string, error) {
+	resp, err := m.describeCollectionRaw(ctx, db, collName)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetAliases(), nil
+}
+
+// GetFunctions returns the functions of a collection. They are in the schema of milvus 2.5, which the milvus-proto
+// in use doesn't know, so they are parsed from the unknown fields of the schema.
+func (m *MilvusClient) GetFunctions(ctx context.Context, db, collName string) ([]*backuppb.FunctionSchema, error) {
+	resp, err := m.describeCollectionRaw(ctx, db, collName)
+	if err != nil {
+		return nil, err
+	}
+	return parseSchemaFunctions(resp.GetSchema().XXX_unrecognized)
+}
+
+// field number of the functions in the collection schema of milvus 2.5
+const schemaFunctionsFieldNumber = 7
+
+// parseSchemaFunctions reads the functions from the unknown fields of a collection schema,
+// backuppb.FunctionSchema has the same field numbers as the function schema of milvus 2.5
+func parseSchemaFunctions(unknown []byte) ([]*backuppb.FunctionSchema, error) {
+	functions := make([]*backuppb.FunctionSchema, 0)
+	for len(unknown) > 0 {
+		num, typ, n := protowire.ConsumeTag(unknown)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		unknown = unknown[n:]
+		if num == schemaFunctionsFieldNumber && typ == protowire.BytesType {
+			value, n := protowire.ConsumeBytes(unknown)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			function := &backuppb.FunctionSchema{}
+			if err := proto.Unmarshal(value, function); err != nil {
+				return nil, fmt.Errorf("fail to parse the collection function, err: %w", err)
+			}
+			functions = append(functions, function)
+			unknown = unknown[n:]
+			continue
+		}
+		n = protowire.ConsumeFieldValue(num, typ, unknown)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		unknown = unknown[n:]
+	}
+	return functions, nil
+}
+
+// field number of is_function_output in the field schema of milvus 2.5
+const fieldFunctionOutputFieldNumber = 16
+
+// marshalSchemaWithFunctions marshals the schema with the functions of milvus 2.5, which the milvus-proto in use
+// doesn't know. They are appended to the unknown fields of the schema the same way parseSchemaFunctions reads them,
+// and the output fields of the functions are marked as function output.
+func marshalSchemaWithFunctions(schema *entity.Schema, functions []*backuppb.FunctionSchema) ([]byte, error) {
+	schemaPb := schema.ProtoMessage()
+	outputFields := lo.FlatMap(functions, func(function *backuppb.FunctionSchema, _ int) []string {
+		return function.GetOutputFieldNames()
+	})
+	for _, field := range schemaPb.GetFields() {
+		if lo.Contains(outputFields, field.GetName()) {
+			field.XXX_unrecognized = protowire.AppendTag(field.XXX_unrecognized, fieldFunctionOutputFieldNumber, protowire.VarintType)
+			field.XXX_unrecognized = protowire.AppendVarint(field.XXX_unrecognized, protowire.EncodeBool(true))
+		}
+	}
+	for _, function := range functions {
+		value, err := proto.Marshal(function)
+		if err != nil {
+			return nil, fmt.Errorf("fail to marshal the collection function %s, err: %w", function.GetName(), err)
+		}
+		schemaPb.XXX_unrecognized = protowire.AppendTag(schemaPb.XXX_unrecognized, schemaFunctionsFieldNumber, protowire.BytesType)
+		schemaPb.XXX_unrecognized = protowire.AppendBytes(schemaPb.XXX_unrecognized, value)
+	}
+	return proto.Marshal(schemaPb)
+}
+
+// field number of nullable in the field schema of milvus 2.4.10+
+const fieldNullableFieldNumber = 15
+
+// GetFillableFields returns whether the fields of a collection are filled by milvus when an import has no data of them,
+// that is the field is nullable or has a default value. nullable is parsed from the unknown fields of the schema.
+func (m *MilvusClient) GetFillableFields(ctx context.Context, db, collName string) (map[string]bool, error) {
+	resp, err := m.describeCollectionRaw(ctx, db, collName)
+	if err != nil {
+		return nil, err
+	}
+	fillable := make(map[string]bool)
+	for _, field := range resp.GetSchema().GetFields() {
+		nullable, err := parseUnknownBool(field.XXX_unrecognized, fieldNullableFieldNumber)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse nullable of field %s, err: %w", field.GetName(), err)
+		}
+		fillable[field.GetName()] = nullable || field.GetDefaultValue() != nil
+	}
+	return fillable, nil
+}
+
+// parseUnknownBool reads a bool field from the unknown fields of a message, false if absent
+func parseUnknownBool(unknown []byte, fieldNumber protowire.Number) (bool, error) {
+	value := false
+	for len(unknown) > 0 {
+		num, typ, n := protowire.ConsumeTag(unknown)
+		if n < 0 {
+			return false, protowire.ParseError(n)
+		}
+		unknown = unknown[n:]
+		if num == fieldNumber && typ == protowire.VarintType {
+			v, n := protowire.ConsumeVarint(unknown)
+			if n < 0 {
+				return false, protowire.ParseError(n)
+			}
+			value = protowire.DecodeBool(v)
+			unknown = unknown[n:]
+			continue
+		}
+		n = protowire.ConsumeFieldValue(num, typ, unknown)
+		if n < 0 {
+			return false, protowire.ParseError(n)
+		}
+		unknown = unknown[n:]
+	}
+	return value, nil
+}
+
+func (m *MilvusClient) CreateAlias(ctx context.Context, db, collName string, alias string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.client.UsingDatabase(ctx, db)
+	if err != nil {
+		return err
+	}
+	return m.client.CreateAlias(ctx, collName, alias)
+}
+
 func (m *MilvusClient) DescribeIndex(ctx context.Context, db, collName, fieldName string) ([]entity.Index, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -54,6 +263,26 @@ func (m *MilvusClient) DescribeIndex(ctx context.Context, db, collName, fieldNam
 	return m.client.DescribeIndex(ctx, collName, fieldName)
 }
 
+func (m *MilvusClient) GetIndexState(ctx context.Context, db, collName, fieldName, indexName string) (entity.IndexState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.client.UsingDatabase(ctx, db)
+	if err != nil {
+		return entity.IndexState(commonpb.IndexState_IndexStateNone), err
+	}
+	return m.client.GetIndexState(ctx, collName, fieldName, gomilvus.WithIndexName(indexName))
+}
+
+func (m *MilvusClient) GetCollectionStatistics(ctx context.Context, db, collName string) (map[string]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.client.UsingDatabase(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return m.client.GetCollectionStatistics(ctx, collName)
+}
+
 func (m *MilvusClient) ShowPartitions(ctx context.Context, db, collName string) ([]*entity.Partition, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -94,6 +323,119 @@ func (m *MilvusClient) FlushV2(ctx context.Context, db, collName string, async b
 	return m.client.FlushV2(ctx, collName, async)
 }
 
+// FlushResult is the result of FlushV2 for one collection
+type FlushResult struct {
+	NewSealedSegmentIDs []int64
+	FlushedSegmentIDs   []int64
+	TimeOfSeal          int64
+	ChannelCPs          map[string]msgpb.MsgPosition
+}
+
+var errBatchFlushNotSupported = errors.New("batch flush is not supported by the milvus client")
+
+// max time to wait for the collections of a batch flush to be flushed, the collections are flushed one by one after it
+const FlushBatchWaitTimeout = 10 * time.Minute
+
+// FlushBatch flushes the collections of one db in one Flush call and waits until all of them are flushed.
+// Collections without seal time in the response, e.g. by milvus not supporting it, are not in the result.
+// The channel checkpoints of the response are split to the collections by their virtual channels.
+// The client is only locked for the calls depending on the database in use, not while waiting for the flush.
+func (m *MilvusClient) FlushBatch(ctx context.Context, db string, collNames []string) (map[string]FlushResult, error) {
+	grpcClient, ok := m.client.(*gomilvus.GrpcClient)
+	if !ok {
+		return nil, errBatchFlushNotSupported
+	}
+	resp, err := m.flushCollections(ctx, grpcClient, db, collNames)
+	if err != nil {
+		return nil, err
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, FlushBatchWaitTimeout)
+	defer cancel()
+	results := make(map[string]FlushResult, len(collNames))
+	for _, collName := range collNames {
+		timeOfSeal, ok := resp.GetCollSealTimes()[collName]
+		if !ok {
+			continue
+		}
+		if ids := resp.GetCollSegIDs()[collName].GetData(); len(ids) > 0 {
+			err := waitBatchFlushed(waitCtx, grpcClient.Service, &milvuspb.GetFlushStateRequest{
+				SegmentIDs:     ids,
+				FlushTs:        resp.GetCollFlushTs()[collName],
+				DbName:         db,
+				CollectionName: collName,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		coll, err := m.DescribeCollection(ctx, db, collName)
+		if err != nil {
+			return nil, err
+		}
+		channelCPs := make(map[string]msgpb.MsgPosition, len(coll.VirtualChannels))
+		for _, vch := range coll.VirtualChannels {
+			if cp, ok := resp.GetChannelCps()[vch]; ok {
+				channelCPs[vch] = msgpb.MsgPosition{
+					ChannelName: cp.GetChannelName(),
+					MsgID:       cp.GetMsgID(),
+					MsgGroup:    cp.GetMsgGroup(),
+					Timestamp:   cp.GetTimestamp(),
+				}
+			}
+		}
+		results[collName] = FlushResult{
+			NewSealedSegmentIDs: resp.GetCollSegIDs()[collName].GetData(),
+			FlushedSegmentIDs:   resp.GetFlushCollSegIDs()[collName].GetData(),
+			TimeOfSeal:          timeOfSeal,
+			ChannelCPs:          channelCPs,
+		}
+	}
+	return results, nil
+}
+
+func (m *MilvusClient) flushCollections(ctx context.Context, grpcClient *gomilvus.GrpcClient, db string, collNames []string) (*milvuspb.FlushResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.client.UsingDatabase(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := grpcClient.Service.Flush(ctx, &milvuspb.FlushRequest{DbName: db, CollectionNames: collNames})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	return resp, nil
+}
+
+// waitBatchFlushed polls the flush state of the segments of one collection in a batch flush until they are flushed,
+// the request has the database, so it doesn't need the lock of the client
+func waitBatchFlushed(ctx context.Context, service milvuspb.MilvusServiceClient, req *milvuspb.GetFlushStateRequest) error {
+	var lastErr error
+	for {
+		stateResp, err := service.GetFlushState(ctx, req)
+		switch {
+		case err != nil:
+			lastErr = err
+		case stateResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success:
+			lastErr = errors.New(stateResp.GetStatus().GetReason())
+		case stateResp.GetFlushed():
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return fmt.Errorf("wait for the flush of collection %s: %w, last error: %s", req.GetCollectionName(), ctx.Err(), lastErr)
+			}
+			return fmt.Errorf("wait for the flush of collection %s: %w", req.GetCollectionName(), ctx.Err())
+		case <-time.After(200 * time.Millisecond):
+		}
+	}
+}
+
 func (m *MilvusClient) ListCollections(ctx context.Context, db string) ([]*entity.Collection, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -104,6 +446,16 @@ func (m *MilvusClient) ListCollections(ctx context.Context, db string) ([]*entit
 	return m.client.ListCollections(ctx)
 }
 
+func (m *MilvusClient) Insert(ctx context.Context, db, collName string, columns ...entity.Column) (entity.Column, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.client.UsingDatabase(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return m.client.Insert(ctx, collName, "", columns...)
+}
+
 func (m *MilvusClient) HasCollection(ctx context.Context, db, collName string) (bool, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -141,6 +493,46 @@ func (m *MilvusClient) CreateCollection(ctx context.Context, db string, schema *
 	}, retry.Sleep(2*time.Second), retry.Attempts(10))
 }
 
+// CreateCollectionWithFunctions creates a collection with the functions of milvus 2.5 in its schema, the sdk in use
+// can't create them, so the create request is sent to milvus directly
+func (m *MilvusClient) CreateCollectionWithFunctions(ctx context.Context, db string, schema *entity.Schema, shardsNum int32,
+	consistencyLevel entity.ConsistencyLevel, numPartitions int64, properties map[string]string, functions []*backuppb.FunctionSchema) error {
+	grpcClient, ok := m.client.(*gomilvus.GrpcClient)
+	if !ok {
+		return errors.New("collection functions are not supported by the milvus client")
+	}
+	schemaBytes, err := marshalSchemaWithFunctions(schema, functions)
+	if err != nil {
+		return err
+	}
+	req := &milvuspb.CreateCollectionRequest{
+		DbName:           db,
+		CollectionName:   schema.CollectionName,
+		Schema:           schemaBytes,
+		ShardsNum:        shardsNum,
+		ConsistencyLevel: consistencyLevel.CommonConsistencyLevel(),
+		NumPartitions:    numPartitions,
+		Properties:       entity.MapKvPairs(properties),
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err = m.client.UsingDatabase(ctx, db)
+	if err != nil {
+		return err
+	}
+	// add retry to make sure won't be block by rate control
+	return retry.Do(ctx, func() error {
+		resp, err := grpcClient.Service.CreateCollection(ctx, req)
+		if err != nil {
+			return err
+		}
+		if resp.GetErrorCode() != commonpb.ErrorCode_Success {
+			return errors.New(resp.GetReason())
+		}
+		return nil
+	}, retry.Sleep(2*time.Second), retry.Attempts(10))
+}
+
 func (m *MilvusClient) DropCollection(ctx context.Context, db string, collectionName string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -186,6 +578,26 @@ func (m *MilvusClient) CreateIndex(ctx context.Context, db, collName string, fie
 	return m.client.CreateIndex(ctx, collName, fieldName, idx, async, gomilvus.WithIndexName(idx.Name()))
 }
 
+func (m *MilvusClient) LoadCollection(ctx context.Context, db, collName string, async bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.client.UsingDatabase(ctx, db)
+	if err != nil {
+		return err
+	}
+	return m.client.LoadCollection(ctx, collName, async)
+}
+
+func (m *MilvusClient) LoadPartitions(ctx context.Context, db, collName string, partitionNames []string, async bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.client.UsingDatabase(ctx, db)
+	if err != nil {
+		return err
+	}
+	return m.client.LoadPartitions(ctx, collName, partitionNames, async)
+}
+
 func (m *MilvusClient) DropIndex(ctx context.Context, db, collName string, indexName string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -0,0 +1,92 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+func TestParseSchemaFunctions(t *testing.T) {
+	functions := []*backuppb.FunctionSchema{
+		{Name: "bm25", Id: 100, Type: backuppb.FunctionType_BM25, InputFieldNames: []string{"text"}, OutputFieldNames: []string{"sparse"}},
+		{Name: "embed", Type: backuppb.FunctionType_TextEmbedding, Params: []*backuppb.KeyValuePair{{Key: "provider", Value: "openai"}}},
+	}
+	// a schema of milvus 2.5 read by the milvus-proto in use keeps the functions as unknown fields
+	data, err := proto.Marshal(&backuppb.CollectionSchema{
+		Name:      "coll",
+		Fields:    []*backuppb.FieldSchema{{Name: "text"}},
+		Functions: functions,
+	})
+	assert.NoError(t, err)
+	schema := &schemapb.CollectionSchema{}
+	assert.NoError(t, proto.Unmarshal(data, schema))
+	assert.Equal(t, "coll", schema.GetName())
+
+	parsed, err := parseSchemaFunctions(schema.XXX_unrecognized)
+	assert.NoError(t, err)
+	assert.Len(t, parsed, 2)
+	for i := range functions {
+		assert.True(t, proto.Equal(functions[i], parsed[i]))
+	}
+
+	parsed, err = parseSchemaFunctions(nil)
+	assert.NoError(t, err)
+	assert.Len(t, parsed, 0)
+	_, err = parseSchemaFunctions([]byte{0x3a, 0x05, 0x01})
+	assert.Error(t, err)
+}
+
+func TestMarshalSchemaWithFunctions(t *testing.T) {
+	functions := []*backuppb.FunctionSchema{
+		{Name: "bm25", Type: backuppb.FunctionType_BM25, InputFieldNames: []string{"text"}, OutputFieldNames: []string{"sparse"}},
+	}
+	schema := &entity.Schema{
+		CollectionName: "coll",
+		Fields: []*entity.Field{
+			{Name: "id", DataType: entity.FieldTypeInt64, PrimaryKey: true},
+			{Name: "text", DataType: entity.FieldTypeVarChar},
+			{Name: "sparse", DataType: entity.FieldTypeSparseVector},
+		},
+	}
+	data, err := marshalSchemaWithFunctions(schema, functions)
+	assert.NoError(t, err)
+
+	// milvus 2.5 reads them as the functions and the function output flag of the schema
+	parsedSchema := &schemapb.CollectionSchema{}
+	assert.NoError(t, proto.Unmarshal(data, parsedSchema))
+	assert.Equal(t, "coll", parsedSchema.GetName())
+	assert.Len(t, parsedSchema.GetFields(), 3)
+	parsed, err := parseSchemaFunctions(parsedSchema.XXX_unrecognized)
+	assert.NoError(t, err)
+	assert.Len(t, parsed, 1)
+	assert.True(t, proto.Equal(functions[0], parsed[0]))
+	for _, field := range parsedSchema.GetFields() {
+		output, err := parseUnknownBool(field.XXX_unrecognized, fieldFunctionOutputFieldNumber)
+		assert.NoError(t, err)
+		assert.Equal(t, field.GetName() == "sparse", output)
+	}
+}
+
+func TestParseUnknownBool(t *testing.T) {
+	// a nullable field of milvus 2.4.10+ read by the milvus-proto in use
+	data := protowire.AppendTag(nil, 2, protowire.BytesType)
+	data = protowire.AppendString(data, "tag")
+	data = protowire.AppendTag(data, fieldNullableFieldNumber, protowire.VarintType)
+	data = protowire.AppendVarint(data, 1)
+	field := &schemapb.FieldSchema{}
+	assert.NoError(t, proto.Unmarshal(data, field))
+	assert.Equal(t, "tag", field.GetName())
+	nullable, err := parseUnknownBool(field.XXX_unrecognized, fieldNullableFieldNumber)
+	assert.NoError(t, err)
+	assert.True(t, nullable)
+
+	nullable, err = parseUnknownBool(nil, fieldNullableFieldNumber)
+	assert.NoError(t, err)
+	assert.False(t, nullable)
+}
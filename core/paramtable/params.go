@@ -1,7 +1,9 @@
 package paramtable
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 )
 
 // BackupParams
@@ -29,31 +31,182 @@ func (p *BackupParams) Init() {
 	p.BackupCfg.init(&p.BaseTable)
 }
 
+const (
+	MaxObjectSizeActionFail = "fail"
+	MaxObjectSizeActionWarn = "warn"
+
+	UnexpectedBinlogFileActionSkip = "skip"
+	UnexpectedBinlogFileActionFail = "fail"
+
+	CopyDataParallelismAuto = "auto"
+)
+
 type BackupConfig struct {
 	Base *BaseTable
 
 	MaxSegmentGroupSize int64
 
+	// 0 means no limit
+	MaxObjectSize       int64
+	MaxObjectSizeAction string
+
+	UnexpectedBinlogFileAction string
+
 	BackupCollectionParallelism int
 	BackupCopyDataParallelism   int
+	BackupListMetaParallelism   int
 	RestoreParallelism          int
+	FlushParallelism            int
+
+	// backup.parallelism.copydata is auto, the copy data pool is sized by the segments of each backup
+	// within the min and max, BackupCopyDataParallelism is the max
+	BackupCopyDataParallelismAuto bool
+	BackupCopyDataMinParallelism  int
+	BackupCopyDataMaxParallelism  int
+
+	FlushMode string
+	// max collections in one flush call of batch flush mode
+	FlushBatchSize int
+
+	// 0 means no per collection limit
+	BackupCopyDataPerCollectionParallelism int
+	// bytes copied per second by all the copy data workers together, 0 means unlimited
+	CopyDataMaxBytesPerSec int64
+	// objects checked at the same time by the verify of backup
+	BackupVerifyParallelism int
+	// partitions queried at the same time to detect the load states of a partially loaded collection
+	LoadStateParallelism int
+	// 0 means deleting a backup by one RemoveWithPrefix call
+	DeleteBackupParallelism int
+	// 1 means writing the meta files one by one
+	WriteMetaParallelism int
+	// 0 means always detect the load state of each partition
+	MaxPartitionsForLoadState int
 
 	KeepTempFiles bool
 
+	BinlogTypes []string
+
+	RetryJitter float64
+	// total seconds of retries of a backup across the collections, 0 means unlimited
+	TotalRetryBudgetSeconds int64
+
+	MetaWriteRetryAttempts int
+
+	// 0 means all segments in one segment meta file
+	MaxSegmentsPerMetaFile int
+
+	// 0 means no check
+	MaxSnapshotSpreadSeconds int
+	// 0 means no check
+	MaxClockSkewSeconds int
+
+	// fail the backup if segments returned by flush can't be found
+	StrictSegmentCheck bool
+
+	// record the milvus version as unknown instead of failing the backup if GetVersion fails
+	IgnoreVersionError bool
+
+	// reject the operations modifying milvus or the backup storage
+	ReadOnly bool
+
+	// refuse to start if the milvus storage path and the backup path overlap
+	CheckPathOverlap bool
+
+	// compare the sha256 of the copied binlogs with the source binlogs
+	VerifyChecksum bool
+
+	// record the virtual and physical channels of the shards in the collection meta
+	CaptureShardChannels bool
+
+	// move deleted backups to the trash instead of removing them
+	SoftDelete bool
+	// 0 means the trash is only purged by purge-trash --all
+	TrashRetentionSeconds int64
+
+	// 1 means the flat layout, backups are the direct subdirectories of the backup root path
+	MaxBackupPathDepth int
+
+	// 0 means no limit
+	RestoreTimeoutSeconds int
+	// 0 means no limit
+	CollectionCopyTimeoutSeconds int
+	// 0 means no retry
+	RestoreBulkinsertRetries int
+	// 0 means no check
+	RestoreMaxShardsNum int
+
+	// database of the collection names without a db prefix in backup and restore requests
+	DefaultDatabase string
+
+	// empty means backup_<time>_<nanosecond>
+	NameTemplate string
+	ClusterName  string
+
+	// in the milvus bucket, which bulkinsert reads the staged files from
+	RestoreStagingPath string
+
 	GcPauseEnable  bool
 	GcPauseSeconds int
 	GcPauseAddress string
+
+	// encrypt the binlogs of the new backups
+	EncryptionEnable     bool
+	EncryptionPassphrase string
+	// recorded in the meta of the encrypted backups, e.g. the name of the secret of the passphrase
+	EncryptionKeyID string
 }
 
 func (p *BackupConfig) init(base *BaseTable) {
 	p.Base = base
 
 	p.initMaxSegmentGroupSize()
+	p.initMaxObjectSize()
+	p.initMaxObjectSizeAction()
+	p.initUnexpectedBinlogFileAction()
 	p.initBackupCollectionParallelism()
 	p.initRestoreParallelism()
 	p.initBackupCopyDataParallelism()
+	p.initBackupListMetaParallelism()
+	p.initBackupCopyDataPerCollectionParallelism()
+	p.initCopyDataMaxBytesPerSec()
+	p.initBackupVerifyParallelism()
+	p.initLoadStateParallelism()
+	p.initDeleteBackupParallelism()
+	p.initWriteMetaParallelism()
+	p.initMaxPartitionsForLoadState()
+	p.initFlushParallelism()
+	p.initFlushMode()
+	p.initFlushBatchSize()
 	p.initKeepTempFiles()
+	p.initBinlogTypes()
+	p.initRetryJitter()
+	p.initTotalRetryBudgetSeconds()
+	p.initMetaWriteRetryAttempts()
+	p.initMaxSegmentsPerMetaFile()
+	p.initMaxSnapshotSpreadSeconds()
+	p.initMaxClockSkewSeconds()
+	p.initStrictSegmentCheck()
+	p.initIgnoreVersionError()
+	p.initReadOnly()
+	p.initCheckPathOverlap()
+	p.initVerifyChecksum()
+	p.initCaptureShardChannels()
+	p.initSoftDelete()
+	p.initTrashRetentionSeconds()
+	p.initMaxBackupPathDepth()
+	p.initRestoreTimeoutSeconds()
+	p.initCollectionCopyTimeoutSeconds()
+	p.initRestoreBulkinsertRetries()
+	p.initRestoreMaxShardsNum()
+	p.initDefaultDatabase()
+	p.initNameTemplate()
+	p.initClusterName()
+	p.initRestoreStagingPath()
 	p.initGcPauseEnable()
+	p.initEncryptionEnable()
+	p.initEncryptionPassphrase()
+	p.initEncryptionKeyID()
 	p.initGcPauseSeconds()
 	p.initGcPauseAddress()
 }
@@ -66,6 +219,31 @@ func (p *BackupConfig) initMaxSegmentGroupSize() {
 	p.MaxSegmentGroupSize = size
 }
 
+func (p *BackupConfig) initMaxObjectSize() {
+	size, err := p.Base.ParseDataSizeWithDefault("backup.maxObjectSize", "0")
+	if err != nil {
+		panic(err)
+	}
+	p.MaxObjectSize = size
+}
+
+func (p *BackupConfig) initMaxObjectSizeAction() {
+	action := strings.ToLower(p.Base.LoadWithDefault("backup.maxObjectSizeAction", MaxObjectSizeActionFail))
+	if action != MaxObjectSizeActionFail && action != MaxObjectSizeActionWarn {
+		panic(fmt.Sprintf("illegal backup.maxObjectSizeAction %s, should be %s or %s", action, MaxObjectSizeActionFail, MaxObjectSizeActionWarn))
+	}
+	p.MaxObjectSizeAction = action
+}
+
+// binlogs are named by their numeric log ids, other files in the binlog dirs are skipped with a warning, or fail the backup
+func (p *BackupConfig) initUnexpectedBinlogFileAction() {
+	action := strings.ToLower(p.Base.LoadWithDefault("backup.unexpectedBinlogFileAction", UnexpectedBinlogFileActionSkip))
+	if action != UnexpectedBinlogFileActionSkip && action != UnexpectedBinlogFileActionFail {
+		panic(fmt.Sprintf("illegal backup.unexpectedBinlogFileAction %s, should be %s or %s", action, UnexpectedBinlogFileActionSkip, UnexpectedBinlogFileActionFail))
+	}
+	p.UnexpectedBinlogFileAction = action
+}
+
 func (p *BackupConfig) initBackupCollectionParallelism() {
 	size := p.Base.ParseIntWithDefault("backup.parallelism.backupCollection", 1)
 	p.BackupCollectionParallelism = size
@@ -77,15 +255,282 @@ func (p *BackupConfig) initRestoreParallelism() {
 }
 
 func (p *BackupConfig) initBackupCopyDataParallelism() {
+	if strings.ToLower(p.Base.LoadWithDefault("backup.parallelism.copydata", "128")) == CopyDataParallelismAuto {
+		p.BackupCopyDataParallelismAuto = true
+		p.BackupCopyDataMinParallelism = p.Base.ParseIntWithDefault("backup.parallelism.copydataMin", 4)
+		p.BackupCopyDataMaxParallelism = p.Base.ParseIntWithDefault("backup.parallelism.copydataMax", 128)
+		if p.BackupCopyDataMinParallelism <= 0 {
+			p.BackupCopyDataMinParallelism = 1
+		}
+		if p.BackupCopyDataMaxParallelism < p.BackupCopyDataMinParallelism {
+			panic(fmt.Sprintf("illegal backup.parallelism.copydataMax %d, should not be less than backup.parallelism.copydataMin %d",
+				p.BackupCopyDataMaxParallelism, p.BackupCopyDataMinParallelism))
+		}
+		p.BackupCopyDataParallelism = p.BackupCopyDataMaxParallelism
+		return
+	}
 	size := p.Base.ParseIntWithDefault("backup.parallelism.copydata", 128)
 	p.BackupCopyDataParallelism = size
 }
 
+func (p *BackupConfig) initBackupListMetaParallelism() {
+	size := p.Base.ParseIntWithDefault("backup.parallelism.listMeta", 256)
+	p.BackupListMetaParallelism = size
+}
+
+func (p *BackupConfig) initBackupCopyDataPerCollectionParallelism() {
+	size := p.Base.ParseIntWithDefault("backup.parallelism.copydataPerCollection", 0)
+	if size < 0 {
+		size = 0
+	}
+	p.BackupCopyDataPerCollectionParallelism = size
+}
+
+func (p *BackupConfig) initCopyDataMaxBytesPerSec() {
+	maxBytesPerSec := p.Base.ParseIntWithDefault("backup.copydata.maxBytesPerSec", 0)
+	if maxBytesPerSec < 0 {
+		maxBytesPerSec = 0
+	}
+	p.CopyDataMaxBytesPerSec = int64(maxBytesPerSec)
+}
+
+func (p *BackupConfig) initBackupVerifyParallelism() {
+	size := p.Base.ParseIntWithDefault("backup.parallelism.verify", 64)
+	if size <= 0 {
+		size = 1
+	}
+	p.BackupVerifyParallelism = size
+}
+
+func (p *BackupConfig) initLoadStateParallelism() {
+	size := p.Base.ParseIntWithDefault("backup.parallelism.loadState", 16)
+	if size <= 0 {
+		size = 1
+	}
+	p.LoadStateParallelism = size
+}
+
+func (p *BackupConfig) initDeleteBackupParallelism() {
+	size := p.Base.ParseIntWithDefault("backup.parallelism.deleteBackup", 0)
+	if size < 0 {
+		size = 0
+	}
+	p.DeleteBackupParallelism = size
+}
+
+func (p *BackupConfig) initWriteMetaParallelism() {
+	size := p.Base.ParseIntWithDefault("backup.parallelism.writeMeta", 1)
+	if size <= 0 {
+		size = 1
+	}
+	p.WriteMetaParallelism = size
+}
+
+func (p *BackupConfig) initMaxPartitionsForLoadState() {
+	size := p.Base.ParseIntWithDefault("backup.maxPartitionsForLoadState", 0)
+	if size < 0 {
+		size = 0
+	}
+	p.MaxPartitionsForLoadState = size
+}
+
+// default to backupCollection parallelism, which is the flush concurrency without this limit.
+// the collections are prepared by max(flushParallelism, backupCollection) workers, so it can be larger than backupCollection
+func (p *BackupConfig) initFlushParallelism() {
+	size := p.Base.ParseIntWithDefault("backup.flushParallelism", p.BackupCollectionParallelism)
+	if size <= 0 {
+		size = 1
+	}
+	p.FlushParallelism = size
+}
+
+func (p *BackupConfig) initFlushMode() {
+	mode := strings.ToLower(p.Base.LoadWithDefault("backup.flushMode", FlushModeCollection))
+	if mode != FlushModeCollection && mode != FlushModeBatch {
+		panic(fmt.Sprintf("illegal backup.flushMode %s, should be %s or %s", mode, FlushModeCollection, FlushModeBatch))
+	}
+	p.FlushMode = mode
+}
+
+func (p *BackupConfig) initFlushBatchSize() {
+	size := p.Base.ParseIntWithDefault("backup.flushBatchSize", 32)
+	if size <= 0 {
+		size = 1
+	}
+	p.FlushBatchSize = size
+}
+
 func (p *BackupConfig) initKeepTempFiles() {
 	keepTempFiles := p.Base.LoadWithDefault("backup.keepTempFiles", "false")
 	p.KeepTempFiles, _ = strconv.ParseBool(keepTempFiles)
 }
 
+func (p *BackupConfig) initStrictSegmentCheck() {
+	strictSegmentCheck := p.Base.LoadWithDefault("backup.strictSegmentCheck", "false")
+	p.StrictSegmentCheck, _ = strconv.ParseBool(strictSegmentCheck)
+}
+
+func (p *BackupConfig) initIgnoreVersionError() {
+	ignoreVersionError := p.Base.LoadWithDefault("backup.ignoreVersionError", "false")
+	p.IgnoreVersionError, _ = strconv.ParseBool(ignoreVersionError)
+}
+
+func (p *BackupConfig) initReadOnly() {
+	readOnly := p.Base.LoadWithDefault("backup.readOnly", "false")
+	p.ReadOnly, _ = strconv.ParseBool(readOnly)
+}
+
+func (p *BackupConfig) initCheckPathOverlap() {
+	check := p.Base.LoadWithDefault("backup.checkPathOverlap", "true")
+	p.CheckPathOverlap, _ = strconv.ParseBool(check)
+}
+
+func (p *BackupConfig) initVerifyChecksum() {
+	verifyChecksum := p.Base.LoadWithDefault("backup.verifyChecksum", "false")
+	p.VerifyChecksum, _ = strconv.ParseBool(verifyChecksum)
+}
+
+func (p *BackupConfig) initCaptureShardChannels() {
+	captureShardChannels := p.Base.LoadWithDefault("backup.captureShardChannels", "false")
+	p.CaptureShardChannels, _ = strconv.ParseBool(captureShardChannels)
+}
+
+func (p *BackupConfig) initSoftDelete() {
+	softDelete := p.Base.LoadWithDefault("backup.softDelete", "false")
+	p.SoftDelete, _ = strconv.ParseBool(softDelete)
+}
+
+func (p *BackupConfig) initTrashRetentionSeconds() {
+	retention := p.Base.ParseIntWithDefault("backup.trashRetentionSeconds", 7*24*3600)
+	if retention < 0 {
+		retention = 0
+	}
+	p.TrashRetentionSeconds = int64(retention)
+}
+
+func (p *BackupConfig) initMaxBackupPathDepth() {
+	depth := p.Base.ParseIntWithDefault("backup.maxBackupPathDepth", 1)
+	if depth < 1 {
+		depth = 1
+	}
+	p.MaxBackupPathDepth = depth
+}
+
+// validated when creating backup, empty means the default types
+func (p *BackupConfig) initBinlogTypes() {
+	binlogTypes := p.Base.LoadWithDefault("backup.binlogTypes", "")
+	p.BinlogTypes = make([]string, 0)
+	for _, binlogType := range strings.Split(binlogTypes, ",") {
+		if strings.TrimSpace(binlogType) != "" {
+			p.BinlogTypes = append(p.BinlogTypes, strings.TrimSpace(binlogType))
+		}
+	}
+}
+
+// jitter ratio of retry intervals, limited to [0, 1], 0 means fixed intervals
+func (p *BackupConfig) initRetryJitter() {
+	jitter := p.Base.ParseFloatWithDefault("backup.retryJitter", 0.2)
+	if jitter < 0 {
+		jitter = 0
+	}
+	if jitter > 1 {
+		jitter = 1
+	}
+	p.RetryJitter = jitter
+}
+
+func (p *BackupConfig) initTotalRetryBudgetSeconds() {
+	budget := p.Base.ParseIntWithDefault("backup.totalRetryBudgetSeconds", 0)
+	if budget < 0 {
+		budget = 0
+	}
+	p.TotalRetryBudgetSeconds = int64(budget)
+}
+
+func (p *BackupConfig) initMetaWriteRetryAttempts() {
+	attempts := p.Base.ParseIntWithDefault("backup.metaWriteRetryAttempts", 5)
+	if attempts < 1 {
+		attempts = 1
+	}
+	p.MetaWriteRetryAttempts = attempts
+}
+
+func (p *BackupConfig) initMaxSegmentsPerMetaFile() {
+	size := p.Base.ParseIntWithDefault("backup.maxSegmentsPerMetaFile", 0)
+	if size < 0 {
+		size = 0
+	}
+	p.MaxSegmentsPerMetaFile = size
+}
+
+func (p *BackupConfig) initMaxSnapshotSpreadSeconds() {
+	seconds := p.Base.ParseIntWithDefault("backup.maxSnapshotSpreadSeconds", 0)
+	p.MaxSnapshotSpreadSeconds = seconds
+}
+
+func (p *BackupConfig) initMaxClockSkewSeconds() {
+	seconds := p.Base.ParseIntWithDefault("backup.maxClockSkewSeconds", 0)
+	p.MaxClockSkewSeconds = seconds
+}
+
+func (p *BackupConfig) initRestoreTimeoutSeconds() {
+	seconds := p.Base.ParseIntWithDefault("backup.restoreTimeoutSeconds", 0)
+	p.RestoreTimeoutSeconds = seconds
+}
+
+func (p *BackupConfig) initRestoreBulkinsertRetries() {
+	p.RestoreBulkinsertRetries = p.Base.ParseIntWithDefault("backup.restoreBulkinsertRetries", 0)
+}
+
+func (p *BackupConfig) initRestoreMaxShardsNum() {
+	num := p.Base.ParseIntWithDefault("backup.restoreMaxShardsNum", 16)
+	if num < 0 {
+		num = 0
+	}
+	p.RestoreMaxShardsNum = num
+}
+
+func (p *BackupConfig) initCollectionCopyTimeoutSeconds() {
+	p.CollectionCopyTimeoutSeconds = p.Base.ParseIntWithDefault("backup.collectionCopyTimeoutSeconds", 0)
+}
+
+func (p *BackupConfig) initDefaultDatabase() {
+	p.DefaultDatabase = p.Base.LoadWithDefault("backup.defaultDatabase", "default")
+	if p.DefaultDatabase == "" {
+		p.DefaultDatabase = "default"
+	}
+}
+
+func (p *BackupConfig) initNameTemplate() {
+	template := p.Base.LoadWithDefault("backup.nameTemplate", "")
+	p.NameTemplate = template
+}
+
+// cluster name used by {cluster} of the name template, default to milvus address
+func (p *BackupConfig) initClusterName() {
+	name := p.Base.LoadWithDefault("backup.clusterName", p.Base.LoadWithDefault("milvus.address", "localhost"))
+	p.ClusterName = name
+}
+
+func (p *BackupConfig) initRestoreStagingPath() {
+	path := p.Base.LoadWithDefault("backup.restoreStaging.path",
+		p.Base.LoadWithDefault("minio.backupRootPath", DefaultMinioBackupRootPath)+"/restore-staging")
+	p.RestoreStagingPath = path
+}
+
+func (p *BackupConfig) initEncryptionEnable() {
+	enable := p.Base.LoadWithDefault("backup.encryption.enable", "false")
+	p.EncryptionEnable, _ = strconv.ParseBool(enable)
+}
+
+func (p *BackupConfig) initEncryptionPassphrase() {
+	p.EncryptionPassphrase = p.Base.LoadWithDefault("backup.encryption.passphrase", "")
+}
+
+func (p *BackupConfig) initEncryptionKeyID() {
+	p.EncryptionKeyID = p.Base.LoadWithDefault("backup.encryption.keyId", "")
+}
+
 func (p *BackupConfig) initGcPauseEnable() {
 	enable := p.Base.LoadWithDefault("backup.gcPause.enable", "false")
 	p.GcPauseEnable, _ = strconv.ParseBool(enable)
@@ -96,8 +541,9 @@ func (p *BackupConfig) initGcPauseSeconds() {
 	p.GcPauseSeconds = seconds
 }
 
+// empty to discover the management address from milvus.address
 func (p *BackupConfig) initGcPauseAddress() {
-	address := p.Base.LoadWithDefault("backup.gcPause.address", "http://localhost:9091")
+	address := p.Base.LoadWithDefault("backup.gcPause.address", "")
 	p.GcPauseAddress = address
 }
 
@@ -178,6 +624,22 @@ const (
 	CloudProviderTencentShort = "tc"
 )
 
+const (
+	// one flush call for each collection
+	FlushModeCollection = "collection"
+	// collections of the same database flushing at the same time share flush calls
+	FlushModeBatch = "batch"
+)
+
+const (
+	// server-side copy first, client-side copy if server-side copy is not implemented
+	CopyModeAuto = "auto"
+	// server-side copy only, e.g. CopyObject of S3
+	CopyModeServer = "server"
+	// download and upload through the backup tool
+	CopyModeClient = "client"
+)
+
 var supportedStorageType = map[string]bool{
 	Local:                     true,
 	Minio:                     true,
@@ -205,12 +667,32 @@ type MinioConfig struct {
 	CloudProvider   string
 	IAMEndpoint     string
 
+	EndpointOverride   string
+	InsecureSkipVerify bool
+
+	Region             string
+	DetectBucketRegion bool
+
+	CopyMode string
+
+	RequestTimeoutSeconds int
+	DialTimeoutSeconds    int
+	MaxIdleConns          int
+	MaxConcurrentRequests int
+
 	BackupAccessKeyID     string
 	BackupSecretAccessKey string
 	BackupBucketName      string
 	BackupRootPath        string
 
 	StorageType string
+
+	// storage of the backup bucket, the same as the milvus storage by default
+	BackupStorageType string
+	BackupAddress     string
+	BackupPort        string
+	BackupUseSSL      bool
+	BackupUseIAM      bool
 }
 
 func (p *MinioConfig) init(base *BaseTable) {
@@ -227,11 +709,26 @@ func (p *MinioConfig) init(base *BaseTable) {
 	p.initUseIAM()
 	p.initCloudProvider()
 	p.initIAMEndpoint()
+	p.initEndpointOverride()
+	p.initInsecureSkipVerify()
+	p.initRegion()
+	p.initDetectBucketRegion()
+	p.initCopyMode()
+	p.initRequestTimeoutSeconds()
+	p.initDialTimeoutSeconds()
+	p.initMaxIdleConns()
+	p.initMaxConcurrentRequests()
 
 	p.initBackupAccessKeyID()
 	p.initBackupSecretAccessKey()
 	p.initBackupBucketName()
 	p.initBackupRootPath()
+
+	p.initBackupStorageType()
+	p.initBackupAddress()
+	p.initBackupPort()
+	p.initBackupUseSSL()
+	p.initBackupUseIAM()
 }
 
 func (p *MinioConfig) initAddress() {
@@ -290,6 +787,55 @@ func (p *MinioConfig) initIAMEndpoint() {
 	p.IAMEndpoint = iamEndpoint
 }
 
+// host[:port] to connect instead of the resolved address, e.g. an internal proxy of the object store
+func (p *MinioConfig) initEndpointOverride() {
+	p.EndpointOverride = p.Base.LoadWithDefault("minio.endpointOverride", "")
+}
+
+func (p *MinioConfig) initInsecureSkipVerify() {
+	insecureSkipVerify := p.Base.LoadWithDefault("minio.insecureSkipVerify", "false")
+	p.InsecureSkipVerify, _ = strconv.ParseBool(insecureSkipVerify)
+}
+
+func (p *MinioConfig) initRegion() {
+	p.Region = p.Base.LoadWithDefault("minio.region", "")
+}
+
+// the region of bucket is detected for aws and minio, disable it for the s3 compatible stores not supporting GetBucketLocation
+func (p *MinioConfig) initDetectBucketRegion() {
+	detectBucketRegion := p.Base.LoadWithDefault("minio.detectBucketRegion", "true")
+	p.DetectBucketRegion, _ = strconv.ParseBool(detectBucketRegion)
+}
+
+// auto tries server-side copy and falls back to client-side copy if the object store doesn't implement it
+func (p *MinioConfig) initCopyMode() {
+	mode := strings.ToLower(p.Base.LoadWithDefault("minio.copyMode", CopyModeAuto))
+	if mode != CopyModeAuto && mode != CopyModeServer && mode != CopyModeClient {
+		panic(fmt.Sprintf("illegal minio.copyMode %s, should be %s, %s or %s", mode, CopyModeAuto, CopyModeServer, CopyModeClient))
+	}
+	p.CopyMode = mode
+}
+
+// 0 means no limit on the time waiting for the response of an object store request
+func (p *MinioConfig) initRequestTimeoutSeconds() {
+	p.RequestTimeoutSeconds = p.Base.ParseIntWithDefault("minio.requestTimeoutSeconds", 0)
+}
+
+// 0 means the default dial timeout of the client
+func (p *MinioConfig) initDialTimeoutSeconds() {
+	p.DialTimeoutSeconds = p.Base.ParseIntWithDefault("minio.dialTimeoutSeconds", 0)
+}
+
+// 0 means the default connection pool size of the client
+func (p *MinioConfig) initMaxIdleConns() {
+	p.MaxIdleConns = p.Base.ParseIntWithDefault("minio.maxIdleConns", 0)
+}
+
+// 0 means no limit on the concurrent requests of all the worker pools
+func (p *MinioConfig) initMaxConcurrentRequests() {
+	p.MaxConcurrentRequests = p.Base.ParseIntWithDefault("minio.maxConcurrentRequests", 0)
+}
+
 func (p *MinioConfig) initBackupAccessKeyID() {
 	keyID := p.Base.LoadWithDefault("minio.backupAccessKeyID", DefaultMinioAccessKey)
 	p.BackupAccessKeyID = keyID
@@ -320,6 +866,41 @@ func (p *MinioConfig) initStorageType() {
 	p.StorageType = engine
 }
 
+func (p *MinioConfig) initBackupStorageType() {
+	engine := p.Base.LoadWithDefault("minio.backupStorageType", p.StorageType)
+	if !supportedStorageType[engine] {
+		panic("unsupported backup storage type:" + engine)
+	}
+	p.BackupStorageType = engine
+}
+
+func (p *MinioConfig) initBackupAddress() {
+	p.BackupAddress = p.Base.LoadWithDefault("minio.backupAddress", p.Address)
+}
+
+func (p *MinioConfig) initBackupPort() {
+	p.BackupPort = p.Base.LoadWithDefault("minio.backupPort", p.Port)
+}
+
+func (p *MinioConfig) initBackupUseSSL() {
+	useSSL := p.Base.LoadWithDefault("minio.backupUseSSL", strconv.FormatBool(p.UseSSL))
+	p.BackupUseSSL, _ = strconv.ParseBool(useSSL)
+}
+
+func (p *MinioConfig) initBackupUseIAM() {
+	useIAM := p.Base.LoadWithDefault("minio.backupUseIAM", strconv.FormatBool(p.UseIAM))
+	var err error
+	p.BackupUseIAM, err = strconv.ParseBool(useIAM)
+	if err != nil {
+		panic("parse bool backupUseIAM:" + err.Error())
+	}
+}
+
+// HasSeparateBackupStorage returns whether the backup bucket is in another storage than the milvus bucket
+func (p *MinioConfig) HasSeparateBackupStorage() bool {
+	return p.BackupStorageType != p.StorageType || p.BackupAddress != p.Address || p.BackupPort != p.Port
+}
+
 type HTTPConfig struct {
 	Base *BaseTable
 
@@ -267,6 +267,34 @@ func (FieldState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_65240d19de191688, []int{5}
 }
 
+type FunctionType int32
+
+const (
+	FunctionType_Unknown       FunctionType = 0
+	FunctionType_BM25          FunctionType = 1
+	FunctionType_TextEmbedding FunctionType = 2
+)
+
+var FunctionType_name = map[int32]string{
+	0: "Unknown",
+	1: "BM25",
+	2: "TextEmbedding",
+}
+
+var FunctionType_value = map[string]int32{
+	"Unknown":       0,
+	"BM25":          1,
+	"TextEmbedding": 2,
+}
+
+func (x FunctionType) String() string {
+	return proto.EnumName(FunctionType_name, int32(x))
+}
+
+func (FunctionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{6}
+}
+
 type IndexInfo struct {
 	FieldName            string            `protobuf:"bytes,1,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
 	IndexName            string            `protobuf:"bytes,2,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
@@ -351,14 +379,27 @@ type CollectionBackupInfo struct {
 	Size            int64        `protobuf:"varint,15,opt,name=size,proto3" json:"size"`
 	HasIndex        bool         `protobuf:"varint,16,opt,name=has_index,json=hasIndex,proto3" json:"has_index"`
 	IndexInfos      []*IndexInfo `protobuf:"bytes,17,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
-	LoadState       string       `protobuf:"bytes,18,opt,name=load_state,json=loadState,proto3" json:"load_state,omitempty"`
+	// NotLoad, Loading or Loaded at backup time
+	LoadState string `protobuf:"bytes,18,opt,name=load_state,json=loadState,proto3" json:"load_state,omitempty"`
 	// physical unix time of backup
 	BackupPhysicalTimestamp uint64               `protobuf:"varint,19,opt,name=backup_physical_timestamp,json=backupPhysicalTimestamp,proto3" json:"backup_physical_timestamp,omitempty"`
 	ChannelCheckpoints      map[string]string    `protobuf:"bytes,20,rep,name=channel_checkpoints,json=channelCheckpoints,proto3" json:"channel_checkpoints,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	L0Segments              []*SegmentBackupInfo `protobuf:"bytes,21,rep,name=l0_segments,json=l0Segments,proto3" json:"l0_segments,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}             `json:"-"`
-	XXX_unrecognized        []byte               `json:"-"`
-	XXX_sizecache           int32                `json:"-"`
+	// row count of the collection from GetCollectionStatistics at backup time
+	RowCount   int64             `protobuf:"varint,22,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Properties map[string]string `protobuf:"bytes,23,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// backup_timestamp in UTC, RFC3339 with milliseconds
+	BackupTime string `protobuf:"bytes,24,opt,name=backup_time,json=backupTime,proto3" json:"backup_time,omitempty"`
+	// num_partitions of a partition key collection set at creation, 0 if unknown or no partition key
+	NumPartitions int64 `protobuf:"varint,25,opt,name=num_partitions,json=numPartitions,proto3" json:"num_partitions,omitempty"`
+	// aliases of the collection in its database
+	Aliases []string `protobuf:"bytes,26,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// virtual and physical channels of the shards at backup time, set if backup.captureShardChannels is enabled.
+	// only for tracing the backup data to the shards, restore doesn't use them
+	ShardChannels        []*ShardChannel `protobuf:"bytes,27,rep,name=shard_channels,json=shardChannels,proto3" json:"shard_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *CollectionBackupInfo) Reset()         { *m = CollectionBackupInfo{} }
@@ -533,6 +574,48 @@ func (m *CollectionBackupInfo) GetL0Segments() []*SegmentBackupInfo {
 	return nil
 }
 
+func (m *CollectionBackupInfo) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *CollectionBackupInfo) GetProperties() map[string]string {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *CollectionBackupInfo) GetBackupTime() string {
+	if m != nil {
+		return m.BackupTime
+	}
+	return ""
+}
+
+func (m *CollectionBackupInfo) GetNumPartitions() int64 {
+	if m != nil {
+		return m.NumPartitions
+	}
+	return 0
+}
+
+func (m *CollectionBackupInfo) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func (m *CollectionBackupInfo) GetShardChannels() []*ShardChannel {
+	if m != nil {
+		return m.ShardChannels
+	}
+	return nil
+}
+
 type PartitionBackupInfo struct {
 	PartitionId   int64  `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
 	PartitionName string `protobuf:"bytes,2,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
@@ -627,9 +710,13 @@ type SegmentBackupInfo struct {
 	// separate segments into multi groups by size,
 	// segments in one group will be copied into one directory during backup
 	// and will bulkinsert in one call during restore
-	GroupId              int64    `protobuf:"varint,9,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Backuped             bool     `protobuf:"varint,10,opt,name=backuped,proto3" json:"backuped,omitempty"`
-	IsL0                 bool     `protobuf:"varint,11,opt,name=is_l0,json=isL0,proto3" json:"is_l0,omitempty"`
+	GroupId  int64 `protobuf:"varint,9,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Backuped bool  `protobuf:"varint,10,opt,name=backuped,proto3" json:"backuped,omitempty"`
+	IsL0     bool  `protobuf:"varint,11,opt,name=is_l0,json=isL0,proto3" json:"is_l0,omitempty"`
+	// index files built by milvus for the segment, only copied with the binlog type index
+	IndexFiles []*SegmentIndexFiles `protobuf:"bytes,12,rep,name=index_files,json=indexFiles,proto3" json:"index_files,omitempty"`
+	// name of the backup holding the binlogs of the segment, set if the segment is reused from the base of an incremental backup
+	BaseBackupName       string   `protobuf:"bytes,13,opt,name=base_backup_name,json=baseBackupName,proto3" json:"base_backup_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -737,6 +824,76 @@ func (m *SegmentBackupInfo) GetIsL0() bool {
 	return false
 }
 
+func (m *SegmentBackupInfo) GetIndexFiles() []*SegmentIndexFiles {
+	if m != nil {
+		return m.IndexFiles
+	}
+	return nil
+}
+
+func (m *SegmentBackupInfo) GetBaseBackupName() string {
+	if m != nil {
+		return m.BaseBackupName
+	}
+	return ""
+}
+
+// files of one index build of a segment, under index_files/build_id/index_version/partition_id/segment_id/ of milvus
+type SegmentIndexFiles struct {
+	BuildId              int64     `protobuf:"varint,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	IndexVersion         int64     `protobuf:"varint,2,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
+	Files                []*Binlog `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SegmentIndexFiles) Reset()         { *m = SegmentIndexFiles{} }
+func (m *SegmentIndexFiles) String() string { return proto.CompactTextString(m) }
+func (*SegmentIndexFiles) ProtoMessage()    {}
+func (*SegmentIndexFiles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{4}
+}
+
+func (m *SegmentIndexFiles) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentIndexFiles.Unmarshal(m, b)
+}
+func (m *SegmentIndexFiles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentIndexFiles.Marshal(b, m, deterministic)
+}
+func (m *SegmentIndexFiles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentIndexFiles.Merge(m, src)
+}
+func (m *SegmentIndexFiles) XXX_Size() int {
+	return xxx_messageInfo_SegmentIndexFiles.Size(m)
+}
+func (m *SegmentIndexFiles) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentIndexFiles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentIndexFiles proto.InternalMessageInfo
+
+func (m *SegmentIndexFiles) GetBuildId() int64 {
+	if m != nil {
+		return m.BuildId
+	}
+	return 0
+}
+
+func (m *SegmentIndexFiles) GetIndexVersion() int64 {
+	if m != nil {
+		return m.IndexVersion
+	}
+	return 0
+}
+
+func (m *SegmentIndexFiles) GetFiles() []*Binlog {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
 // *
 // root of backup
 type BackupInfo struct {
@@ -747,22 +904,55 @@ type BackupInfo struct {
 	EndTime      int64               `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	Progress     int32               `protobuf:"varint,6,opt,name=progress,proto3" json:"progress"`
 	Name         string              `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
-	// backup timestamp
+	// latest backup timestamp of the collections, a hybrid timestamp of milvus.
+	// each collection contains the data before its own backup timestamp
 	BackupTimestamp uint64 `protobuf:"varint,8,opt,name=backup_timestamp,json=backupTimestamp,proto3" json:"backup_timestamp,omitempty"`
 	// array of collection backup
-	CollectionBackups    []*CollectionBackupInfo `protobuf:"bytes,9,rep,name=collection_backups,json=collectionBackups,proto3" json:"collection_backups,omitempty"`
-	Size                 int64                   `protobuf:"varint,10,opt,name=size,proto3" json:"size"`
-	MilvusVersion        string                  `protobuf:"bytes,11,opt,name=milvus_version,json=milvusVersion,proto3" json:"milvus_version,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	CollectionBackups []*CollectionBackupInfo `protobuf:"bytes,9,rep,name=collection_backups,json=collectionBackups,proto3" json:"collection_backups,omitempty"`
+	Size              int64                   `protobuf:"varint,10,opt,name=size,proto3" json:"size"`
+	MilvusVersion     string                  `protobuf:"bytes,11,opt,name=milvus_version,json=milvusVersion,proto3" json:"milvus_version,omitempty"`
+	// rootPath of the source milvus, binlog paths in the backup meta are under it
+	MilvusRootPath string `protobuf:"bytes,12,opt,name=milvus_root_path,json=milvusRootPath,proto3" json:"milvus_root_path,omitempty"`
+	// schema template backup, only contains schema, index and properties of collections
+	SchemaTemplateOnly bool `protobuf:"varint,13,opt,name=schema_template_only,json=schemaTemplateOnly,proto3" json:"schema_template_only,omitempty"`
+	// binlog types copied in the backup
+	BinlogTypes []string `protobuf:"bytes,14,rep,name=binlog_types,json=binlogTypes,proto3" json:"binlog_types,omitempty"`
+	// max difference between backup timestamps of the collections in milliseconds
+	SnapshotSpreadMs int64 `protobuf:"varint,15,opt,name=snapshot_spread_ms,json=snapshotSpreadMs,proto3" json:"snapshot_spread_ms,omitempty"`
+	// databases of the source cluster, only set if backup_databases in the request
+	DatabaseBackups []*DatabaseBackupInfo `protobuf:"bytes,16,rep,name=database_backups,json=databaseBackups,proto3" json:"database_backups,omitempty"`
+	// segments returned by flush but not found in the collections, they are not in the backup
+	UnlocatedSegmentIds []int64 `protobuf:"varint,17,rep,packed,name=unlocated_segment_ids,json=unlocatedSegmentIds,proto3" json:"unlocated_segment_ids,omitempty"`
+	// number of the segment_meta_<i>.json files the segment meta is split into, 0 means a single segment_meta.json
+	SegmentMetaShards int32 `protobuf:"varint,18,opt,name=segment_meta_shards,json=segmentMetaShards,proto3" json:"segment_meta_shards,omitempty"`
+	// collections dropped or timed out during the backup and skipped because of continue_on_error, format db.collection
+	SkippedCollections []string `protobuf:"bytes,19,rep,name=skipped_collections,json=skippedCollections,proto3" json:"skipped_collections,omitempty"`
+	// latest backup timestamp of the collections in UTC, RFC3339 with milliseconds, the time of backup_timestamp
+	BackupTime string `protobuf:"bytes,20,opt,name=backup_time,json=backupTime,proto3" json:"backup_time,omitempty"`
+	// throughput of the binlog copies of the backup, not set for meta only backups
+	CopyStats *CopyStats `protobuf:"bytes,21,opt,name=copy_stats,json=copyStats,proto3" json:"copy_stats,omitempty"`
+	// the backup is resumed from an interrupted one by resume of the request
+	Resumed bool `protobuf:"varint,22,opt,name=resumed,proto3" json:"resumed,omitempty"`
+	// client side encryption of the binlogs, AES-256-GCM, empty if the binlogs are not encrypted
+	EncryptionScheme string `protobuf:"bytes,23,opt,name=encryption_scheme,json=encryptionScheme,proto3" json:"encryption_scheme,omitempty"`
+	// backup.encryption.keyId when the backup is created, which passphrase the backup needs
+	EncryptionKeyId string `protobuf:"bytes,24,opt,name=encryption_key_id,json=encryptionKeyId,proto3" json:"encryption_key_id,omitempty"`
+	// salt of the scrypt derivation of the key encrypting the data key from the passphrase
+	EncryptionSalt []byte `protobuf:"bytes,25,opt,name=encryption_salt,json=encryptionSalt,proto3" json:"encryption_salt,omitempty"`
+	// random key of the binlogs of the backup, encrypted by the key derived from the passphrase
+	EncryptedDataKey []byte `protobuf:"bytes,26,opt,name=encrypted_data_key,json=encryptedDataKey,proto3" json:"encrypted_data_key,omitempty"`
+	// base backup of an incremental backup, the segments unchanged since the base are not copied again and are restored from it
+	BaseBackupName       string   `protobuf:"bytes,27,opt,name=base_backup_name,json=baseBackupName,proto3" json:"base_backup_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *BackupInfo) Reset()         { *m = BackupInfo{} }
 func (m *BackupInfo) String() string { return proto.CompactTextString(m) }
 func (*BackupInfo) ProtoMessage()    {}
 func (*BackupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{4}
+	return fileDescriptor_65240d19de191688, []int{5}
 }
 
 func (m *BackupInfo) XXX_Unmarshal(b []byte) error {
@@ -860,6 +1050,262 @@ func (m *BackupInfo) GetMilvusVersion() string {
 	return ""
 }
 
+func (m *BackupInfo) GetMilvusRootPath() string {
+	if m != nil {
+		return m.MilvusRootPath
+	}
+	return ""
+}
+
+func (m *BackupInfo) GetSchemaTemplateOnly() bool {
+	if m != nil {
+		return m.SchemaTemplateOnly
+	}
+	return false
+}
+
+func (m *BackupInfo) GetBinlogTypes() []string {
+	if m != nil {
+		return m.BinlogTypes
+	}
+	return nil
+}
+
+func (m *BackupInfo) GetSnapshotSpreadMs() int64 {
+	if m != nil {
+		return m.SnapshotSpreadMs
+	}
+	return 0
+}
+
+func (m *BackupInfo) GetDatabaseBackups() []*DatabaseBackupInfo {
+	if m != nil {
+		return m.DatabaseBackups
+	}
+	return nil
+}
+
+func (m *BackupInfo) GetUnlocatedSegmentIds() []int64 {
+	if m != nil {
+		return m.UnlocatedSegmentIds
+	}
+	return nil
+}
+
+func (m *BackupInfo) GetSegmentMetaShards() int32 {
+	if m != nil {
+		return m.SegmentMetaShards
+	}
+	return 0
+}
+
+func (m *BackupInfo) GetSkippedCollections() []string {
+	if m != nil {
+		return m.SkippedCollections
+	}
+	return nil
+}
+
+func (m *BackupInfo) GetBackupTime() string {
+	if m != nil {
+		return m.BackupTime
+	}
+	return ""
+}
+
+func (m *BackupInfo) GetCopyStats() *CopyStats {
+	if m != nil {
+		return m.CopyStats
+	}
+	return nil
+}
+
+func (m *BackupInfo) GetResumed() bool {
+	if m != nil {
+		return m.Resumed
+	}
+	return false
+}
+
+func (m *BackupInfo) GetEncryptionScheme() string {
+	if m != nil {
+		return m.EncryptionScheme
+	}
+	return ""
+}
+
+func (m *BackupInfo) GetEncryptionKeyId() string {
+	if m != nil {
+		return m.EncryptionKeyId
+	}
+	return ""
+}
+
+func (m *BackupInfo) GetEncryptionSalt() []byte {
+	if m != nil {
+		return m.EncryptionSalt
+	}
+	return nil
+}
+
+func (m *BackupInfo) GetEncryptedDataKey() []byte {
+	if m != nil {
+		return m.EncryptedDataKey
+	}
+	return nil
+}
+
+func (m *BackupInfo) GetBaseBackupName() string {
+	if m != nil {
+		return m.BaseBackupName
+	}
+	return ""
+}
+
+// *
+// Throughput of the binlog copies of a backup
+type CopyStats struct {
+	// storage types of the milvus bucket and the backup bucket, like minio->aws
+	Provider      string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	CopiedBytes   int64  `protobuf:"varint,2,opt,name=copied_bytes,json=copiedBytes,proto3" json:"copied_bytes,omitempty"`
+	CopiedObjects int64  `protobuf:"varint,3,opt,name=copied_objects,json=copiedObjects,proto3" json:"copied_objects,omitempty"`
+	// wall time of the copy phase in milliseconds, including the list of binlogs
+	ElapsedMs int64 `protobuf:"varint,4,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`
+	// time spent in the copy calls of all workers in milliseconds, much less than elapsed_ms * parallelism
+	// means the backup waits on listing binlogs or milvus instead of the storage
+	CopyTimeMs int64 `protobuf:"varint,5,opt,name=copy_time_ms,json=copyTimeMs,proto3" json:"copy_time_ms,omitempty"`
+	// copied_bytes over elapsed_ms
+	AvgMbPerSecond       float64  `protobuf:"fixed64,6,opt,name=avg_mb_per_second,json=avgMbPerSecond,proto3" json:"avg_mb_per_second,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CopyStats) Reset()         { *m = CopyStats{} }
+func (m *CopyStats) String() string { return proto.CompactTextString(m) }
+func (*CopyStats) ProtoMessage()    {}
+func (*CopyStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{6}
+}
+
+func (m *CopyStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CopyStats.Unmarshal(m, b)
+}
+func (m *CopyStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CopyStats.Marshal(b, m, deterministic)
+}
+func (m *CopyStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyStats.Merge(m, src)
+}
+func (m *CopyStats) XXX_Size() int {
+	return xxx_messageInfo_CopyStats.Size(m)
+}
+func (m *CopyStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CopyStats proto.InternalMessageInfo
+
+func (m *CopyStats) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *CopyStats) GetCopiedBytes() int64 {
+	if m != nil {
+		return m.CopiedBytes
+	}
+	return 0
+}
+
+func (m *CopyStats) GetCopiedObjects() int64 {
+	if m != nil {
+		return m.CopiedObjects
+	}
+	return 0
+}
+
+func (m *CopyStats) GetElapsedMs() int64 {
+	if m != nil {
+		return m.ElapsedMs
+	}
+	return 0
+}
+
+func (m *CopyStats) GetCopyTimeMs() int64 {
+	if m != nil {
+		return m.CopyTimeMs
+	}
+	return 0
+}
+
+func (m *CopyStats) GetAvgMbPerSecond() float64 {
+	if m != nil {
+		return m.AvgMbPerSecond
+	}
+	return 0
+}
+
+// *
+// Database of the source cluster
+type DatabaseBackupInfo struct {
+	DbName     string            `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	Properties map[string]string `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// all collections in the database, including the ones not in the backup
+	CollectionNames      []string `protobuf:"bytes,3,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatabaseBackupInfo) Reset()         { *m = DatabaseBackupInfo{} }
+func (m *DatabaseBackupInfo) String() string { return proto.CompactTextString(m) }
+func (*DatabaseBackupInfo) ProtoMessage()    {}
+func (*DatabaseBackupInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{7}
+}
+
+func (m *DatabaseBackupInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseBackupInfo.Unmarshal(m, b)
+}
+func (m *DatabaseBackupInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseBackupInfo.Marshal(b, m, deterministic)
+}
+func (m *DatabaseBackupInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseBackupInfo.Merge(m, src)
+}
+func (m *DatabaseBackupInfo) XXX_Size() int {
+	return xxx_messageInfo_DatabaseBackupInfo.Size(m)
+}
+func (m *DatabaseBackupInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseBackupInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseBackupInfo proto.InternalMessageInfo
+
+func (m *DatabaseBackupInfo) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *DatabaseBackupInfo) GetProperties() map[string]string {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *DatabaseBackupInfo) GetCollectionNames() []string {
+	if m != nil {
+		return m.CollectionNames
+	}
+	return nil
+}
+
 // *
 // For level storage
 type CollectionLevelBackupInfo struct {
@@ -873,7 +1319,7 @@ func (m *CollectionLevelBackupInfo) Reset()         { *m = CollectionLevelBackup
 func (m *CollectionLevelBackupInfo) String() string { return proto.CompactTextString(m) }
 func (*CollectionLevelBackupInfo) ProtoMessage()    {}
 func (*CollectionLevelBackupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{5}
+	return fileDescriptor_65240d19de191688, []int{8}
 }
 
 func (m *CollectionLevelBackupInfo) XXX_Unmarshal(b []byte) error {
@@ -914,7 +1360,7 @@ func (m *PartitionLevelBackupInfo) Reset()         { *m = PartitionLevelBackupIn
 func (m *PartitionLevelBackupInfo) String() string { return proto.CompactTextString(m) }
 func (*PartitionLevelBackupInfo) ProtoMessage()    {}
 func (*PartitionLevelBackupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{6}
+	return fileDescriptor_65240d19de191688, []int{9}
 }
 
 func (m *PartitionLevelBackupInfo) XXX_Unmarshal(b []byte) error {
@@ -955,7 +1401,7 @@ func (m *SegmentLevelBackupInfo) Reset()         { *m = SegmentLevelBackupInfo{}
 func (m *SegmentLevelBackupInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentLevelBackupInfo) ProtoMessage()    {}
 func (*SegmentLevelBackupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{7}
+	return fileDescriptor_65240d19de191688, []int{10}
 }
 
 func (m *SegmentLevelBackupInfo) XXX_Unmarshal(b []byte) error {
@@ -995,6 +1441,7 @@ type CreateBackupRequest struct {
 	// async or not
 	Async bool `protobuf:"varint,4,opt,name=async,proto3" json:"async,omitempty"`
 	// database and collections to backup. A json string. To support database. 2023.7.7
+	// a collection can also be {"name": "coll", "force": true} to skip flush of only this collection
 	DbCollections *_struct.Value `protobuf:"bytes,5,opt,name=db_collections,json=dbCollections,proto3" json:"db_collections,omitempty"`
 	// force backup skip flush, Should make sure data has been stored into disk when using it
 	Force bool `protobuf:"varint,6,opt,name=force,proto3" json:"force,omitempty"`
@@ -1005,7 +1452,35 @@ type CreateBackupRequest struct {
 	// gc pause seconds, set it larger than the time cost of backup
 	GcPauseSeconds int32 `protobuf:"varint,9,opt,name=gc_pause_seconds,json=gcPauseSeconds,proto3" json:"gc_pause_seconds,omitempty"`
 	// gc pause API address
-	GcPauseAddress       string   `protobuf:"bytes,10,opt,name=gc_pause_address,json=gcPauseAddress,proto3" json:"gc_pause_address,omitempty"`
+	GcPauseAddress string `protobuf:"bytes,10,opt,name=gc_pause_address,json=gcPauseAddress,proto3" json:"gc_pause_address,omitempty"`
+	// only backup schema, index and properties of collections, without flush and segments
+	SchemaTemplateOnly bool `protobuf:"varint,11,opt,name=schema_template_only,json=schemaTemplateOnly,proto3" json:"schema_template_only,omitempty"`
+	// binlog types to copy, support insert, delta, stats and index. insert is required. empty to use backup.binlogTypes in config
+	BinlogTypes []string `protobuf:"bytes,12,rep,name=binlog_types,json=binlogTypes,proto3" json:"binlog_types,omitempty"`
+	// fail the backup if backup timestamps of the collections differ by more than it, 0 to use backup.maxSnapshotSpreadSeconds in config
+	MaxSnapshotSpreadSeconds int64 `protobuf:"varint,13,opt,name=max_snapshot_spread_seconds,json=maxSnapshotSpreadSeconds,proto3" json:"max_snapshot_spread_seconds,omitempty"`
+	// after backup, check all the segments existing at the flush of the collections are backed up
+	Verify bool `protobuf:"varint,14,opt,name=verify,proto3" json:"verify,omitempty"`
+	// backup all databases of the cluster with their properties and collection names, to recreate them in restore
+	BackupDatabases bool `protobuf:"varint,15,opt,name=backup_databases,json=backupDatabases,proto3" json:"backup_databases,omitempty"`
+	// partitions of the collections to backup: all, default_only or exclude_default. empty means all.
+	// partition key collections only support all
+	PartitionScope string `protobuf:"bytes,16,opt,name=partition_scope,json=partitionScope,proto3" json:"partition_scope,omitempty"`
+	// ids of the collections to backup, resolved to the current names when the backup starts.
+	// can not be used with collection_names or db_collections
+	CollectionIds []int64 `protobuf:"varint,17,rep,packed,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
+	// if true, skip the collections dropped during the backup or exceeding backup.collectionCopyTimeoutSeconds
+	// and record them in skipped_collections of the backup, otherwise the backup fails on them
+	ContinueOnError bool `protobuf:"varint,18,opt,name=continue_on_error,json=continueOnError,proto3" json:"continue_on_error,omitempty"`
+	// only backup the collections having all the properties, among the collections selected by the other fields,
+	// e.g. {"tier": "gold"} with no collections set backups all the collections with property tier=gold
+	PropertySelector map[string]string `protobuf:"bytes,19,rep,name=property_selector,json=propertySelector,proto3" json:"property_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// resume the interrupted backup with the name instead of failing because it exists, the collections prepared by it
+	// are not flushed again and the binlogs already copied with the recorded sizes are not copied again
+	Resume bool `protobuf:"varint,20,opt,name=resume,proto3" json:"resume,omitempty"`
+	// create an incremental backup on top of the backup with the name, only the segments new or changed since it are copied.
+	// The base must be a complete backup with data, and is needed to restore the incremental backup.
+	BaseBackupName       string   `protobuf:"bytes,21,opt,name=base_backup_name,json=baseBackupName,proto3" json:"base_backup_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1015,7 +1490,7 @@ func (m *CreateBackupRequest) Reset()         { *m = CreateBackupRequest{} }
 func (m *CreateBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateBackupRequest) ProtoMessage()    {}
 func (*CreateBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{8}
+	return fileDescriptor_65240d19de191688, []int{11}
 }
 
 func (m *CreateBackupRequest) XXX_Unmarshal(b []byte) error {
@@ -1106,6 +1581,83 @@ func (m *CreateBackupRequest) GetGcPauseAddress() string {
 	return ""
 }
 
+func (m *CreateBackupRequest) GetSchemaTemplateOnly() bool {
+	if m != nil {
+		return m.SchemaTemplateOnly
+	}
+	return false
+}
+
+func (m *CreateBackupRequest) GetBinlogTypes() []string {
+	if m != nil {
+		return m.BinlogTypes
+	}
+	return nil
+}
+
+func (m *CreateBackupRequest) GetMaxSnapshotSpreadSeconds() int64 {
+	if m != nil {
+		return m.MaxSnapshotSpreadSeconds
+	}
+	return 0
+}
+
+func (m *CreateBackupRequest) GetVerify() bool {
+	if m != nil {
+		return m.Verify
+	}
+	return false
+}
+
+func (m *CreateBackupRequest) GetBackupDatabases() bool {
+	if m != nil {
+		return m.BackupDatabases
+	}
+	return false
+}
+
+func (m *CreateBackupRequest) GetPartitionScope() string {
+	if m != nil {
+		return m.PartitionScope
+	}
+	return ""
+}
+
+func (m *CreateBackupRequest) GetCollectionIds() []int64 {
+	if m != nil {
+		return m.CollectionIds
+	}
+	return nil
+}
+
+func (m *CreateBackupRequest) GetContinueOnError() bool {
+	if m != nil {
+		return m.ContinueOnError
+	}
+	return false
+}
+
+func (m *CreateBackupRequest) GetPropertySelector() map[string]string {
+	if m != nil {
+		return m.PropertySelector
+	}
+	return nil
+}
+
+func (m *CreateBackupRequest) GetResume() bool {
+	if m != nil {
+		return m.Resume
+	}
+	return false
+}
+
+func (m *CreateBackupRequest) GetBaseBackupName() string {
+	if m != nil {
+		return m.BaseBackupName
+	}
+	return ""
+}
+
 // *
 // BackupInfoResponse
 type BackupInfoResponse struct {
@@ -1126,7 +1678,7 @@ func (m *BackupInfoResponse) Reset()         { *m = BackupInfoResponse{} }
 func (m *BackupInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*BackupInfoResponse) ProtoMessage()    {}
 func (*BackupInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{9}
+	return fileDescriptor_65240d19de191688, []int{12}
 }
 
 func (m *BackupInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -1197,7 +1749,7 @@ func (m *GetBackupRequest) Reset()         { *m = GetBackupRequest{} }
 func (m *GetBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBackupRequest) ProtoMessage()    {}
 func (*GetBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{10}
+	return fileDescriptor_65240d19de191688, []int{13}
 }
 
 func (m *GetBackupRequest) XXX_Unmarshal(b []byte) error {
@@ -1264,7 +1816,11 @@ type ListBackupsRequest struct {
 	// uuid of request, will generate one if not set
 	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
 	// if collection_name is set, will only return backups contains this collection
-	CollectionName       string   `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionName string `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// continue listing after the backup dirs of the last page, got from next_continuation_token
+	ContinuationToken string `protobuf:"bytes,3,opt,name=continuation_token,json=continuationToken,proto3" json:"continuation_token,omitempty"`
+	// max number of backup dirs to list in one page, list all if not set
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1274,7 +1830,7 @@ func (m *ListBackupsRequest) Reset()         { *m = ListBackupsRequest{} }
 func (m *ListBackupsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListBackupsRequest) ProtoMessage()    {}
 func (*ListBackupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{11}
+	return fileDescriptor_65240d19de191688, []int{14}
 }
 
 func (m *ListBackupsRequest) XXX_Unmarshal(b []byte) error {
@@ -1309,6 +1865,20 @@ func (m *ListBackupsRequest) GetCollectionName() string {
 	return ""
 }
 
+func (m *ListBackupsRequest) GetContinuationToken() string {
+	if m != nil {
+		return m.ContinuationToken
+	}
+	return ""
+}
+
+func (m *ListBackupsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 type ListBackupsResponse struct {
 	// uuid of the request to response
 	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
@@ -1317,17 +1887,19 @@ type ListBackupsResponse struct {
 	// error msg if fail
 	Msg string `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
 	// backup info entities
-	Data                 []*BackupInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Data []*BackupInfo `protobuf:"bytes,4,rep,name=data,proto3" json:"data"`
+	// pass it as continuation_token to list the next page, empty if all backups are listed
+	NextContinuationToken string   `protobuf:"bytes,5,opt,name=next_continuation_token,json=nextContinuationToken,proto3" json:"next_continuation_token,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *ListBackupsResponse) Reset()         { *m = ListBackupsResponse{} }
 func (m *ListBackupsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListBackupsResponse) ProtoMessage()    {}
 func (*ListBackupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{12}
+	return fileDescriptor_65240d19de191688, []int{15}
 }
 
 func (m *ListBackupsResponse) XXX_Unmarshal(b []byte) error {
@@ -1376,11 +1948,20 @@ func (m *ListBackupsResponse) GetData() []*BackupInfo {
 	return nil
 }
 
+func (m *ListBackupsResponse) GetNextContinuationToken() string {
+	if m != nil {
+		return m.NextContinuationToken
+	}
+	return ""
+}
+
 type DeleteBackupRequest struct {
 	// uuid of request, will generate one if not set
 	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
 	// backup name
-	BackupName           string   `protobuf:"bytes,2,opt,name=backup_name,json=backupName,proto3" json:"backup_name,omitempty"`
+	BackupName string `protobuf:"bytes,2,opt,name=backup_name,json=backupName,proto3" json:"backup_name,omitempty"`
+	// delete a backup even if it is the base of incremental backups, they can't be restored after it
+	Force                bool     `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1390,7 +1971,7 @@ func (m *DeleteBackupRequest) Reset()         { *m = DeleteBackupRequest{} }
 func (m *DeleteBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteBackupRequest) ProtoMessage()    {}
 func (*DeleteBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{13}
+	return fileDescriptor_65240d19de191688, []int{16}
 }
 
 func (m *DeleteBackupRequest) XXX_Unmarshal(b []byte) error {
@@ -1425,6 +2006,13 @@ func (m *DeleteBackupRequest) GetBackupName() string {
 	return ""
 }
 
+func (m *DeleteBackupRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
 type DeleteBackupResponse struct {
 	// uuid of the request to response
 	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
@@ -1441,7 +2029,7 @@ func (m *DeleteBackupResponse) Reset()         { *m = DeleteBackupResponse{} }
 func (m *DeleteBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteBackupResponse) ProtoMessage()    {}
 func (*DeleteBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{14}
+	return fileDescriptor_65240d19de191688, []int{17}
 }
 
 func (m *DeleteBackupResponse) XXX_Unmarshal(b []byte) error {
@@ -1483,6 +2071,158 @@ func (m *DeleteBackupResponse) GetMsg() string {
 	return ""
 }
 
+type CleanupOrphansRequest struct {
+	// uuid of request, will generate one if not set
+	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	// only list the orphan backups, don't remove them
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// orphan backups modified within the grace period are kept, default 24h if not set
+	GracePeriodSeconds int64 `protobuf:"varint,3,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3" json:"grace_period_seconds,omitempty"`
+	// continue cleanup after the backup dirs of the last page, got from next_continuation_token
+	ContinuationToken string `protobuf:"bytes,4,opt,name=continuation_token,json=continuationToken,proto3" json:"continuation_token,omitempty"`
+	// max number of backup dirs to check in one page, check all if not set
+	Limit                int32    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CleanupOrphansRequest) Reset()         { *m = CleanupOrphansRequest{} }
+func (m *CleanupOrphansRequest) String() string { return proto.CompactTextString(m) }
+func (*CleanupOrphansRequest) ProtoMessage()    {}
+func (*CleanupOrphansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{18}
+}
+
+func (m *CleanupOrphansRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CleanupOrphansRequest.Unmarshal(m, b)
+}
+func (m *CleanupOrphansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CleanupOrphansRequest.Marshal(b, m, deterministic)
+}
+func (m *CleanupOrphansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CleanupOrphansRequest.Merge(m, src)
+}
+func (m *CleanupOrphansRequest) XXX_Size() int {
+	return xxx_messageInfo_CleanupOrphansRequest.Size(m)
+}
+func (m *CleanupOrphansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CleanupOrphansRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CleanupOrphansRequest proto.InternalMessageInfo
+
+func (m *CleanupOrphansRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *CleanupOrphansRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *CleanupOrphansRequest) GetGracePeriodSeconds() int64 {
+	if m != nil {
+		return m.GracePeriodSeconds
+	}
+	return 0
+}
+
+func (m *CleanupOrphansRequest) GetContinuationToken() string {
+	if m != nil {
+		return m.ContinuationToken
+	}
+	return ""
+}
+
+func (m *CleanupOrphansRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type CleanupOrphansResponse struct {
+	// uuid of the request to response
+	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	// response code. 0 means success. others are fail
+	Code ResponseCode `protobuf:"varint,2,opt,name=code,proto3,enum=milvus.proto.backup.ResponseCode" json:"code,omitempty"`
+	// error msg if fail
+	Msg string `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	// names of the orphan backups, removed if not dry run
+	Orphans []string `protobuf:"bytes,4,rep,name=orphans,proto3" json:"orphans,omitempty"`
+	// pass it as continuation_token to cleanup the next page, empty if all backups are checked
+	NextContinuationToken string   `protobuf:"bytes,5,opt,name=next_continuation_token,json=nextContinuationToken,proto3" json:"next_continuation_token,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *CleanupOrphansResponse) Reset()         { *m = CleanupOrphansResponse{} }
+func (m *CleanupOrphansResponse) String() string { return proto.CompactTextString(m) }
+func (*CleanupOrphansResponse) ProtoMessage()    {}
+func (*CleanupOrphansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{19}
+}
+
+func (m *CleanupOrphansResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CleanupOrphansResponse.Unmarshal(m, b)
+}
+func (m *CleanupOrphansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CleanupOrphansResponse.Marshal(b, m, deterministic)
+}
+func (m *CleanupOrphansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CleanupOrphansResponse.Merge(m, src)
+}
+func (m *CleanupOrphansResponse) XXX_Size() int {
+	return xxx_messageInfo_CleanupOrphansResponse.Size(m)
+}
+func (m *CleanupOrphansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CleanupOrphansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CleanupOrphansResponse proto.InternalMessageInfo
+
+func (m *CleanupOrphansResponse) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *CleanupOrphansResponse) GetCode() ResponseCode {
+	if m != nil {
+		return m.Code
+	}
+	return ResponseCode_Success
+}
+
+func (m *CleanupOrphansResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *CleanupOrphansResponse) GetOrphans() []string {
+	if m != nil {
+		return m.Orphans
+	}
+	return nil
+}
+
+func (m *CleanupOrphansResponse) GetNextContinuationToken() string {
+	if m != nil {
+		return m.NextContinuationToken
+	}
+	return ""
+}
+
 type RestoreBackupRequest struct {
 	// uuid of request, will generate one if not set
 	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
@@ -1503,6 +2243,7 @@ type RestoreBackupRequest struct {
 	// if bucket_name and path is set. will override bucket/path in config.
 	Path string `protobuf:"bytes,8,opt,name=path,proto3" json:"path,omitempty"`
 	// database and collections to restore. A json string. for example: {"db1":["collection1"],"db2":["collection2","collection3"]}
+	// a collection can also be {"name": "coll", "shards_num": 4} to create this collection with another shards num
 	DbCollections *_struct.Value `protobuf:"bytes,9,opt,name=db_collections,json=dbCollections,proto3" json:"db_collections,omitempty"`
 	// if true only restore meta, not restore data
 	MetaOnly bool `protobuf:"varint,10,opt,name=metaOnly,proto3" json:"metaOnly,omitempty"`
@@ -1518,17 +2259,62 @@ type RestoreBackupRequest struct {
 	SkipCreateCollection bool   `protobuf:"varint,15,opt,name=skipCreateCollection,proto3" json:"skipCreateCollection,omitempty"`
 	Id                   string `protobuf:"bytes,16,opt,name=id,proto3" json:"id,omitempty"`
 	// if true, skip the diskQuota in Import
-	SkipImportDiskQuotaCheck bool     `protobuf:"varint,17,opt,name=skipImportDiskQuotaCheck,proto3" json:"skipImportDiskQuotaCheck,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
-	XXX_unrecognized         []byte   `json:"-"`
-	XXX_sizecache            int32    `json:"-"`
+	SkipImportDiskQuotaCheck bool `protobuf:"varint,17,opt,name=skipImportDiskQuotaCheck,proto3" json:"skipImportDiskQuotaCheck,omitempty"`
+	// if true, keep restoring the remaining collections when one collection fails
+	ContinueOnError bool `protobuf:"varint,18,opt,name=continueOnError,proto3" json:"continueOnError,omitempty"`
+	// override index params in backup when restoreIndex, e.g. change nlist or metric type
+	IndexOverrides []*IndexParamOverride `protobuf:"bytes,19,rep,name=index_overrides,json=indexOverrides,proto3" json:"index_overrides,omitempty"`
+	// if true, check the milvus user has the privileges needed by the restore before starting, only for clusters with RBAC
+	CheckPrivileges bool `protobuf:"varint,20,opt,name=checkPrivileges,proto3" json:"checkPrivileges,omitempty"`
+	// timeout of the whole restore in seconds, 0 to use backup.restoreTimeoutSeconds in config
+	TimeoutSeconds int64 `protobuf:"varint,21,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// if true, create all the databases in the backup with their properties before restoring collections, for full cluster restore
+	RestoreDatabases bool `protobuf:"varint,22,opt,name=restore_databases,json=restoreDatabases,proto3" json:"restore_databases,omitempty"`
+	// if true load the collections and partitions which were loaded or loading at backup time after restore, index is needed to load
+	AutoReloadPreviouslyLoaded bool `protobuf:"varint,23,opt,name=auto_reload_previously_loaded,json=autoReloadPreviouslyLoaded,proto3" json:"auto_reload_previously_loaded,omitempty"`
+	// if true only import the delta logs of the backup as deletions into the existing collections, which must have the schema
+	// and primary key of the backup. For the case the data was restored separately but the deletions were lost.
+	// Needs skipCreateCollection and a milvus supporting l0 import.
+	DeltaOnly bool `protobuf:"varint,24,opt,name=delta_only,json=deltaOnly,proto3" json:"delta_only,omitempty"`
+	// if true create the target databases which don't exist, otherwise the restore fails on them
+	CreateMissingDatabase bool `protobuf:"varint,25,opt,name=create_missing_database,json=createMissingDatabase,proto3" json:"create_missing_database,omitempty"`
+	// if true auto_reload_previously_loaded only loads the restored partitions which were loaded, instead of the whole collection,
+	// for restoring a partition scoped backup into an existing collection
+	LoadRestoredPartitionsOnly bool `protobuf:"varint,26,opt,name=load_restored_partitions_only,json=loadRestoredPartitionsOnly,proto3" json:"load_restored_partitions_only,omitempty"`
+	// if true create the indexes before importing the data, otherwise after the import, which is usually faster.
+	// only works with restoreIndex
+	BuildIndexBeforeImport bool `protobuf:"varint,27,opt,name=build_index_before_import,json=buildIndexBeforeImport,proto3" json:"build_index_before_import,omitempty"`
+	// if true, target collection names invalid under the naming rules of milvus are sanitized instead of failing the restore,
+	// illegal characters are replaced by '_' and too long names are truncated, the renames are returned in the restore task
+	SanitizeCollectionNames bool `protobuf:"varint,28,opt,name=sanitize_collection_names,json=sanitizeCollectionNames,proto3" json:"sanitize_collection_names,omitempty"`
+	// if true, create the aliases of the collections in the backup after all the collections are restored,
+	// the aliases are created in the target databases and point to the target collections
+	RestoreAliases bool `protobuf:"varint,29,opt,name=restore_aliases,json=restoreAliases,proto3" json:"restore_aliases,omitempty"`
+	// dynamic field of the created collections: empty keeps the one of the backup, disable drops the dynamic field
+	// and its data, enable adds an empty dynamic field to the collections without it, only with meta_only if they have data
+	DynamicField string `protobuf:"bytes,30,opt,name=dynamic_field,json=dynamicField,proto3" json:"dynamic_field,omitempty"`
+	// if backup_name is "latest", restore the complete backup with the latest start time whose name matches this glob pattern,
+	// e.g. "daily_*", all the backups match if not set. Only the backups containing all the collection_names are considered.
+	LatestNamePattern string `protobuf:"bytes,31,opt,name=latest_name_pattern,json=latestNamePattern,proto3" json:"latest_name_pattern,omitempty"`
+	// with skipCreateCollection, what to do with an existing collection having rows: empty imports the data beside them,
+	// fail fails the restore. An existing empty collection is always restored into.
+	ExistingCollectionPolicy string `protobuf:"bytes,32,opt,name=existing_collection_policy,json=existingCollectionPolicy,proto3" json:"existing_collection_policy,omitempty"`
+	// if true, create the collections with the properties of the backup, e.g. collection.ttl.seconds,
+	// otherwise they are created with the default properties of the target milvus
+	RestoreCollectionProperties bool `protobuf:"varint,33,opt,name=restore_collection_properties,json=restoreCollectionProperties,proto3" json:"restore_collection_properties,omitempty"`
+	// opt in transforming the vectors of float vector fields before bulkinsert, e.g. to restore into another dimension.
+	// It needs the insert binlogs rewritten, which is very expensive and not supported yet, so only noop is accepted.
+	VectorTransformers   []*VectorTransformerOption `protobuf:"bytes,34,rep,name=vector_transformers,json=vectorTransformers,proto3" json:"vector_transformers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
 func (m *RestoreBackupRequest) Reset()         { *m = RestoreBackupRequest{} }
 func (m *RestoreBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreBackupRequest) ProtoMessage()    {}
 func (*RestoreBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{15}
+	return fileDescriptor_65240d19de191688, []int{20}
 }
 
 func (m *RestoreBackupRequest) XXX_Unmarshal(b []byte) error {
@@ -1668,94 +2454,379 @@ func (m *RestoreBackupRequest) GetSkipImportDiskQuotaCheck() bool {
 	return false
 }
 
-type RestorePartitionTask struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	StateCode            RestoreTaskStateCode `protobuf:"varint,2,opt,name=state_code,json=stateCode,proto3,enum=milvus.proto.backup.RestoreTaskStateCode" json:"state_code"`
-	ErrorMessage         string               `protobuf:"bytes,3,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
-	StartTime            int64                `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime              int64                `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Progress             int32                `protobuf:"varint,6,opt,name=progress,proto3" json:"progress"`
-	PartBackup           *PartitionBackupInfo `protobuf:"bytes,7,opt,name=part_backup,json=partBackup,proto3" json:"part_backup,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *RestoreBackupRequest) GetContinueOnError() bool {
+	if m != nil {
+		return m.ContinueOnError
+	}
+	return false
 }
 
-func (m *RestorePartitionTask) Reset()         { *m = RestorePartitionTask{} }
-func (m *RestorePartitionTask) String() string { return proto.CompactTextString(m) }
-func (*RestorePartitionTask) ProtoMessage()    {}
-func (*RestorePartitionTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{16}
+func (m *RestoreBackupRequest) GetIndexOverrides() []*IndexParamOverride {
+	if m != nil {
+		return m.IndexOverrides
+	}
+	return nil
 }
 
-func (m *RestorePartitionTask) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RestorePartitionTask.Unmarshal(m, b)
-}
-func (m *RestorePartitionTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RestorePartitionTask.Marshal(b, m, deterministic)
-}
-func (m *RestorePartitionTask) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RestorePartitionTask.Merge(m, src)
-}
-func (m *RestorePartitionTask) XXX_Size() int {
-	return xxx_messageInfo_RestorePartitionTask.Size(m)
-}
-func (m *RestorePartitionTask) XXX_DiscardUnknown() {
-	xxx_messageInfo_RestorePartitionTask.DiscardUnknown(m)
+func (m *RestoreBackupRequest) GetCheckPrivileges() bool {
+	if m != nil {
+		return m.CheckPrivileges
+	}
+	return false
 }
 
-var xxx_messageInfo_RestorePartitionTask proto.InternalMessageInfo
+func (m *RestoreBackupRequest) GetTimeoutSeconds() int64 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
 
-func (m *RestorePartitionTask) GetId() string {
+func (m *RestoreBackupRequest) GetRestoreDatabases() bool {
 	if m != nil {
-		return m.Id
+		return m.RestoreDatabases
 	}
-	return ""
+	return false
 }
 
-func (m *RestorePartitionTask) GetStateCode() RestoreTaskStateCode {
+func (m *RestoreBackupRequest) GetAutoReloadPreviouslyLoaded() bool {
 	if m != nil {
-		return m.StateCode
+		return m.AutoReloadPreviouslyLoaded
 	}
-	return RestoreTaskStateCode_INITIAL
+	return false
 }
 
-func (m *RestorePartitionTask) GetErrorMessage() string {
+func (m *RestoreBackupRequest) GetDeltaOnly() bool {
 	if m != nil {
-		return m.ErrorMessage
+		return m.DeltaOnly
 	}
-	return ""
+	return false
 }
 
-func (m *RestorePartitionTask) GetStartTime() int64 {
+func (m *RestoreBackupRequest) GetCreateMissingDatabase() bool {
 	if m != nil {
-		return m.StartTime
+		return m.CreateMissingDatabase
 	}
-	return 0
+	return false
 }
 
-func (m *RestorePartitionTask) GetEndTime() int64 {
+func (m *RestoreBackupRequest) GetLoadRestoredPartitionsOnly() bool {
 	if m != nil {
-		return m.EndTime
+		return m.LoadRestoredPartitionsOnly
 	}
-	return 0
+	return false
 }
 
-func (m *RestorePartitionTask) GetProgress() int32 {
+func (m *RestoreBackupRequest) GetBuildIndexBeforeImport() bool {
 	if m != nil {
-		return m.Progress
+		return m.BuildIndexBeforeImport
 	}
-	return 0
+	return false
 }
 
-func (m *RestorePartitionTask) GetPartBackup() *PartitionBackupInfo {
+func (m *RestoreBackupRequest) GetSanitizeCollectionNames() bool {
 	if m != nil {
-		return m.PartBackup
+		return m.SanitizeCollectionNames
 	}
-	return nil
+	return false
 }
 
-type RestoreCollectionTask struct {
+func (m *RestoreBackupRequest) GetRestoreAliases() bool {
+	if m != nil {
+		return m.RestoreAliases
+	}
+	return false
+}
+
+func (m *RestoreBackupRequest) GetDynamicField() string {
+	if m != nil {
+		return m.DynamicField
+	}
+	return ""
+}
+
+func (m *RestoreBackupRequest) GetLatestNamePattern() string {
+	if m != nil {
+		return m.LatestNamePattern
+	}
+	return ""
+}
+
+func (m *RestoreBackupRequest) GetExistingCollectionPolicy() string {
+	if m != nil {
+		return m.ExistingCollectionPolicy
+	}
+	return ""
+}
+
+func (m *RestoreBackupRequest) GetRestoreCollectionProperties() bool {
+	if m != nil {
+		return m.RestoreCollectionProperties
+	}
+	return false
+}
+
+func (m *RestoreBackupRequest) GetVectorTransformers() []*VectorTransformerOption {
+	if m != nil {
+		return m.VectorTransformers
+	}
+	return nil
+}
+
+type VectorTransformerOption struct {
+	// collection in backup, format db.collection, db can be omitted for default db. empty means all collections
+	CollectionName string `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// float vector field to transform
+	FieldName string `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// transformer name, noop or pad_truncate
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// target dimension of pad_truncate
+	Dim                  int64    `protobuf:"varint,4,opt,name=dim,proto3" json:"dim,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VectorTransformerOption) Reset()         { *m = VectorTransformerOption{} }
+func (m *VectorTransformerOption) String() string { return proto.CompactTextString(m) }
+func (*VectorTransformerOption) ProtoMessage()    {}
+func (*VectorTransformerOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{21}
+}
+
+func (m *VectorTransformerOption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VectorTransformerOption.Unmarshal(m, b)
+}
+func (m *VectorTransformerOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VectorTransformerOption.Marshal(b, m, deterministic)
+}
+func (m *VectorTransformerOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VectorTransformerOption.Merge(m, src)
+}
+func (m *VectorTransformerOption) XXX_Size() int {
+	return xxx_messageInfo_VectorTransformerOption.Size(m)
+}
+func (m *VectorTransformerOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_VectorTransformerOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VectorTransformerOption proto.InternalMessageInfo
+
+func (m *VectorTransformerOption) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *VectorTransformerOption) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
+}
+
+func (m *VectorTransformerOption) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VectorTransformerOption) GetDim() int64 {
+	if m != nil {
+		return m.Dim
+	}
+	return 0
+}
+
+type IndexParamOverride struct {
+	// collection in backup, format db.collection, db can be omitted for default db. empty means all collections
+	CollectionName string `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// field of the index
+	FieldName string `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// new index type, keep the original one if empty
+	IndexType string `protobuf:"bytes,3,opt,name=index_type,json=indexType,proto3" json:"index_type,omitempty"`
+	// params merged into the original index params, e.g. nlist, metric_type
+	Params               map[string]string `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *IndexParamOverride) Reset()         { *m = IndexParamOverride{} }
+func (m *IndexParamOverride) String() string { return proto.CompactTextString(m) }
+func (*IndexParamOverride) ProtoMessage()    {}
+func (*IndexParamOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{22}
+}
+
+func (m *IndexParamOverride) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexParamOverride.Unmarshal(m, b)
+}
+func (m *IndexParamOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexParamOverride.Marshal(b, m, deterministic)
+}
+func (m *IndexParamOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexParamOverride.Merge(m, src)
+}
+func (m *IndexParamOverride) XXX_Size() int {
+	return xxx_messageInfo_IndexParamOverride.Size(m)
+}
+func (m *IndexParamOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexParamOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexParamOverride proto.InternalMessageInfo
+
+func (m *IndexParamOverride) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *IndexParamOverride) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
+}
+
+func (m *IndexParamOverride) GetIndexType() string {
+	if m != nil {
+		return m.IndexType
+	}
+	return ""
+}
+
+func (m *IndexParamOverride) GetParams() map[string]string {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type RestorePartitionTask struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StateCode            RestoreTaskStateCode `protobuf:"varint,2,opt,name=state_code,json=stateCode,proto3,enum=milvus.proto.backup.RestoreTaskStateCode" json:"state_code"`
+	ErrorMessage         string               `protobuf:"bytes,3,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	StartTime            int64                `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              int64                `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Progress             int32                `protobuf:"varint,6,opt,name=progress,proto3" json:"progress"`
+	PartBackup           *PartitionBackupInfo `protobuf:"bytes,7,opt,name=part_backup,json=partBackup,proto3" json:"part_backup,omitempty"`
+	PartitionName        string               `protobuf:"bytes,8,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	PartitionId          int64                `protobuf:"varint,9,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	RestoredSize         int64                `protobuf:"varint,10,opt,name=restored_size,json=restoredSize,proto3" json:"restored_size"`
+	ToRestoreSize        int64                `protobuf:"varint,11,opt,name=to_restore_size,json=toRestoreSize,proto3" json:"to_restore_size"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RestorePartitionTask) Reset()         { *m = RestorePartitionTask{} }
+func (m *RestorePartitionTask) String() string { return proto.CompactTextString(m) }
+func (*RestorePartitionTask) ProtoMessage()    {}
+func (*RestorePartitionTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{23}
+}
+
+func (m *RestorePartitionTask) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestorePartitionTask.Unmarshal(m, b)
+}
+func (m *RestorePartitionTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestorePartitionTask.Marshal(b, m, deterministic)
+}
+func (m *RestorePartitionTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestorePartitionTask.Merge(m, src)
+}
+func (m *RestorePartitionTask) XXX_Size() int {
+	return xxx_messageInfo_RestorePartitionTask.Size(m)
+}
+func (m *RestorePartitionTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestorePartitionTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestorePartitionTask proto.InternalMessageInfo
+
+func (m *RestorePartitionTask) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RestorePartitionTask) GetStateCode() RestoreTaskStateCode {
+	if m != nil {
+		return m.StateCode
+	}
+	return RestoreTaskStateCode_INITIAL
+}
+
+func (m *RestorePartitionTask) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *RestorePartitionTask) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *RestorePartitionTask) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *RestorePartitionTask) GetProgress() int32 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *RestorePartitionTask) GetPartBackup() *PartitionBackupInfo {
+	if m != nil {
+		return m.PartBackup
+	}
+	return nil
+}
+
+func (m *RestorePartitionTask) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *RestorePartitionTask) GetPartitionId() int64 {
+	if m != nil {
+		return m.PartitionId
+	}
+	return 0
+}
+
+func (m *RestorePartitionTask) GetRestoredSize() int64 {
+	if m != nil {
+		return m.RestoredSize
+	}
+	return 0
+}
+
+func (m *RestorePartitionTask) GetToRestoreSize() int64 {
+	if m != nil {
+		return m.ToRestoreSize
+	}
+	return 0
+}
+
+type RestoreCollectionTask struct {
 	Id                    string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	StateCode             RestoreTaskStateCode    `protobuf:"varint,2,opt,name=state_code,json=stateCode,proto3,enum=milvus.proto.backup.RestoreTaskStateCode" json:"state_code"`
 	ErrorMessage          string                  `protobuf:"bytes,3,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
@@ -1779,18 +2850,42 @@ type RestoreCollectionTask struct {
 	// if true drop index info
 	DropExistIndex bool `protobuf:"varint,17,opt,name=dropExistIndex,proto3" json:"dropExistIndex,omitempty"`
 	// if true will skip create collections
-	SkipCreateCollection bool     `protobuf:"varint,18,opt,name=skipCreateCollection,proto3" json:"skipCreateCollection,omitempty"`
-	SkipDiskQuotaCheck   bool     `protobuf:"varint,19,opt,name=skipDiskQuotaCheck,proto3" json:"skipDiskQuotaCheck,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SkipCreateCollection bool `protobuf:"varint,18,opt,name=skipCreateCollection,proto3" json:"skipCreateCollection,omitempty"`
+	SkipDiskQuotaCheck   bool `protobuf:"varint,19,opt,name=skipDiskQuotaCheck,proto3" json:"skipDiskQuotaCheck,omitempty"`
+	// index overrides matching this collection
+	IndexOverrides []*IndexParamOverride `protobuf:"bytes,20,rep,name=index_overrides,json=indexOverrides,proto3" json:"index_overrides,omitempty"`
+	// if true load the collection or partitions loaded at backup time after restore
+	AutoReloadPreviouslyLoaded bool `protobuf:"varint,21,opt,name=auto_reload_previously_loaded,json=autoReloadPreviouslyLoaded,proto3" json:"auto_reload_previously_loaded,omitempty"`
+	// if true only import the delta logs as deletions
+	DeltaOnly bool `protobuf:"varint,22,opt,name=delta_only,json=deltaOnly,proto3" json:"delta_only,omitempty"`
+	// the source collection in the backup and the id of the restored collection, to trace the restored collection back
+	SourceDbName         string `protobuf:"bytes,23,opt,name=source_db_name,json=sourceDbName,proto3" json:"source_db_name,omitempty"`
+	SourceCollectionName string `protobuf:"bytes,24,opt,name=source_collection_name,json=sourceCollectionName,proto3" json:"source_collection_name,omitempty"`
+	SourceCollectionId   int64  `protobuf:"varint,25,opt,name=source_collection_id,json=sourceCollectionId,proto3" json:"source_collection_id,omitempty"`
+	// set once the target collection is created or found, 0 before that
+	TargetCollectionId int64 `protobuf:"varint,26,opt,name=target_collection_id,json=targetCollectionId,proto3" json:"target_collection_id,omitempty"`
+	// if true only load the restored partitions when auto_reload_previously_loaded
+	LoadRestoredPartitionsOnly bool `protobuf:"varint,27,opt,name=load_restored_partitions_only,json=loadRestoredPartitionsOnly,proto3" json:"load_restored_partitions_only,omitempty"`
+	// if true create the indexes before importing the data
+	BuildIndexBeforeImport bool `protobuf:"varint,28,opt,name=build_index_before_import,json=buildIndexBeforeImport,proto3" json:"build_index_before_import,omitempty"`
+	// if true create the aliases of the collection after all collections of the restore are done
+	RestoreAliases bool `protobuf:"varint,29,opt,name=restore_aliases,json=restoreAliases,proto3" json:"restore_aliases,omitempty"`
+	// shards num to create the collection with, 0 means the shards num of the backup
+	ShardsNum int32 `protobuf:"varint,30,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	// dynamic_field of the restore request
+	DynamicField string `protobuf:"bytes,31,opt,name=dynamic_field,json=dynamicField,proto3" json:"dynamic_field,omitempty"`
+	// if true create the collection with the properties of the backup
+	RestoreCollectionProperties bool     `protobuf:"varint,32,opt,name=restore_collection_properties,json=restoreCollectionProperties,proto3" json:"restore_collection_properties,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
+	XXX_unrecognized            []byte   `json:"-"`
+	XXX_sizecache               int32    `json:"-"`
 }
 
 func (m *RestoreCollectionTask) Reset()         { *m = RestoreCollectionTask{} }
 func (m *RestoreCollectionTask) String() string { return proto.CompactTextString(m) }
 func (*RestoreCollectionTask) ProtoMessage()    {}
 func (*RestoreCollectionTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{17}
+	return fileDescriptor_65240d19de191688, []int{24}
 }
 
 func (m *RestoreCollectionTask) XXX_Unmarshal(b []byte) error {
@@ -1944,36 +3039,131 @@ func (m *RestoreCollectionTask) GetSkipDiskQuotaCheck() bool {
 	return false
 }
 
-type RestoreBackupTask struct {
-	Id                     string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	StateCode              RestoreTaskStateCode     `protobuf:"varint,2,opt,name=state_code,json=stateCode,proto3,enum=milvus.proto.backup.RestoreTaskStateCode" json:"state_code"`
-	ErrorMessage           string                   `protobuf:"bytes,3,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
-	StartTime              int64                    `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime                int64                    `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	CollectionRestoreTasks []*RestoreCollectionTask `protobuf:"bytes,6,rep,name=collection_restore_tasks,json=collectionRestoreTasks,proto3" json:"collection_restore_tasks,omitempty"`
-	RestoredSize           int64                    `protobuf:"varint,7,opt,name=restored_size,json=restoredSize,proto3" json:"restored_size"`
-	ToRestoreSize          int64                    `protobuf:"varint,8,opt,name=to_restore_size,json=toRestoreSize,proto3" json:"to_restore_size"`
-	Progress               int32                    `protobuf:"varint,9,opt,name=progress,proto3" json:"progress"`
-	XXX_NoUnkeyedLiteral   struct{}                 `json:"-"`
-	XXX_unrecognized       []byte                   `json:"-"`
-	XXX_sizecache          int32                    `json:"-"`
+func (m *RestoreCollectionTask) GetIndexOverrides() []*IndexParamOverride {
+	if m != nil {
+		return m.IndexOverrides
+	}
+	return nil
 }
 
-func (m *RestoreBackupTask) Reset()         { *m = RestoreBackupTask{} }
-func (m *RestoreBackupTask) String() string { return proto.CompactTextString(m) }
-func (*RestoreBackupTask) ProtoMessage()    {}
-func (*RestoreBackupTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{18}
+func (m *RestoreCollectionTask) GetAutoReloadPreviouslyLoaded() bool {
+	if m != nil {
+		return m.AutoReloadPreviouslyLoaded
+	}
+	return false
 }
 
-func (m *RestoreBackupTask) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RestoreBackupTask.Unmarshal(m, b)
-}
-func (m *RestoreBackupTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RestoreBackupTask.Marshal(b, m, deterministic)
+func (m *RestoreCollectionTask) GetDeltaOnly() bool {
+	if m != nil {
+		return m.DeltaOnly
+	}
+	return false
 }
-func (m *RestoreBackupTask) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RestoreBackupTask.Merge(m, src)
+
+func (m *RestoreCollectionTask) GetSourceDbName() string {
+	if m != nil {
+		return m.SourceDbName
+	}
+	return ""
+}
+
+func (m *RestoreCollectionTask) GetSourceCollectionName() string {
+	if m != nil {
+		return m.SourceCollectionName
+	}
+	return ""
+}
+
+func (m *RestoreCollectionTask) GetSourceCollectionId() int64 {
+	if m != nil {
+		return m.SourceCollectionId
+	}
+	return 0
+}
+
+func (m *RestoreCollectionTask) GetTargetCollectionId() int64 {
+	if m != nil {
+		return m.TargetCollectionId
+	}
+	return 0
+}
+
+func (m *RestoreCollectionTask) GetLoadRestoredPartitionsOnly() bool {
+	if m != nil {
+		return m.LoadRestoredPartitionsOnly
+	}
+	return false
+}
+
+func (m *RestoreCollectionTask) GetBuildIndexBeforeImport() bool {
+	if m != nil {
+		return m.BuildIndexBeforeImport
+	}
+	return false
+}
+
+func (m *RestoreCollectionTask) GetRestoreAliases() bool {
+	if m != nil {
+		return m.RestoreAliases
+	}
+	return false
+}
+
+func (m *RestoreCollectionTask) GetShardsNum() int32 {
+	if m != nil {
+		return m.ShardsNum
+	}
+	return 0
+}
+
+func (m *RestoreCollectionTask) GetDynamicField() string {
+	if m != nil {
+		return m.DynamicField
+	}
+	return ""
+}
+
+func (m *RestoreCollectionTask) GetRestoreCollectionProperties() bool {
+	if m != nil {
+		return m.RestoreCollectionProperties
+	}
+	return false
+}
+
+type RestoreBackupTask struct {
+	Id                     string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StateCode              RestoreTaskStateCode     `protobuf:"varint,2,opt,name=state_code,json=stateCode,proto3,enum=milvus.proto.backup.RestoreTaskStateCode" json:"state_code"`
+	ErrorMessage           string                   `protobuf:"bytes,3,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	StartTime              int64                    `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime                int64                    `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	CollectionRestoreTasks []*RestoreCollectionTask `protobuf:"bytes,6,rep,name=collection_restore_tasks,json=collectionRestoreTasks,proto3" json:"collection_restore_tasks,omitempty"`
+	RestoredSize           int64                    `protobuf:"varint,7,opt,name=restored_size,json=restoredSize,proto3" json:"restored_size"`
+	ToRestoreSize          int64                    `protobuf:"varint,8,opt,name=to_restore_size,json=toRestoreSize,proto3" json:"to_restore_size"`
+	Progress               int32                    `protobuf:"varint,9,opt,name=progress,proto3" json:"progress"`
+	// db.collection of the invalid target names -> db.collection sanitized by sanitize_collection_names
+	SanitizedCollectionNames map[string]string `protobuf:"bytes,10,rep,name=sanitized_collection_names,json=sanitizedCollectionNames,proto3" json:"sanitized_collection_names,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// name of the restored backup, the resolved one if the request asked for the latest backup
+	BackupName           string   `protobuf:"bytes,11,opt,name=backup_name,json=backupName,proto3" json:"backup_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreBackupTask) Reset()         { *m = RestoreBackupTask{} }
+func (m *RestoreBackupTask) String() string { return proto.CompactTextString(m) }
+func (*RestoreBackupTask) ProtoMessage()    {}
+func (*RestoreBackupTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{25}
+}
+
+func (m *RestoreBackupTask) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreBackupTask.Unmarshal(m, b)
+}
+func (m *RestoreBackupTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreBackupTask.Marshal(b, m, deterministic)
+}
+func (m *RestoreBackupTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreBackupTask.Merge(m, src)
 }
 func (m *RestoreBackupTask) XXX_Size() int {
 	return xxx_messageInfo_RestoreBackupTask.Size(m)
@@ -2019,150 +3209,380 @@ func (m *RestoreBackupTask) GetEndTime() int64 {
 	return 0
 }
 
-func (m *RestoreBackupTask) GetCollectionRestoreTasks() []*RestoreCollectionTask {
+func (m *RestoreBackupTask) GetCollectionRestoreTasks() []*RestoreCollectionTask {
+	if m != nil {
+		return m.CollectionRestoreTasks
+	}
+	return nil
+}
+
+func (m *RestoreBackupTask) GetRestoredSize() int64 {
+	if m != nil {
+		return m.RestoredSize
+	}
+	return 0
+}
+
+func (m *RestoreBackupTask) GetToRestoreSize() int64 {
+	if m != nil {
+		return m.ToRestoreSize
+	}
+	return 0
+}
+
+func (m *RestoreBackupTask) GetProgress() int32 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *RestoreBackupTask) GetSanitizedCollectionNames() map[string]string {
+	if m != nil {
+		return m.SanitizedCollectionNames
+	}
+	return nil
+}
+
+func (m *RestoreBackupTask) GetBackupName() string {
+	if m != nil {
+		return m.BackupName
+	}
+	return ""
+}
+
+type RestoreBackupResponse struct {
+	// uuid of the request to response
+	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	// response code. 0 means success. others are fail
+	Code ResponseCode `protobuf:"varint,2,opt,name=code,proto3,enum=milvus.proto.backup.ResponseCode" json:"code,omitempty"`
+	// error msg if fail
+	Msg string `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	// restore task info entity
+	Data                 *RestoreBackupTask `protobuf:"bytes,4,opt,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *RestoreBackupResponse) Reset()         { *m = RestoreBackupResponse{} }
+func (m *RestoreBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreBackupResponse) ProtoMessage()    {}
+func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{26}
+}
+
+func (m *RestoreBackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreBackupResponse.Unmarshal(m, b)
+}
+func (m *RestoreBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreBackupResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreBackupResponse.Merge(m, src)
+}
+func (m *RestoreBackupResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreBackupResponse.Size(m)
+}
+func (m *RestoreBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreBackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreBackupResponse proto.InternalMessageInfo
+
+func (m *RestoreBackupResponse) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *RestoreBackupResponse) GetCode() ResponseCode {
+	if m != nil {
+		return m.Code
+	}
+	return ResponseCode_Success
+}
+
+func (m *RestoreBackupResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *RestoreBackupResponse) GetData() *RestoreBackupTask {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type GetRestoreStateRequest struct {
+	// uuid of request, will generate one if not set
+	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	// restore task id to query
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRestoreStateRequest) Reset()         { *m = GetRestoreStateRequest{} }
+func (m *GetRestoreStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRestoreStateRequest) ProtoMessage()    {}
+func (*GetRestoreStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{27}
+}
+
+func (m *GetRestoreStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRestoreStateRequest.Unmarshal(m, b)
+}
+func (m *GetRestoreStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRestoreStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRestoreStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRestoreStateRequest.Merge(m, src)
+}
+func (m *GetRestoreStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRestoreStateRequest.Size(m)
+}
+func (m *GetRestoreStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRestoreStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRestoreStateRequest proto.InternalMessageInfo
+
+func (m *GetRestoreStateRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *GetRestoreStateRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type OperationEvent struct {
+	// sequence number of the event in an operation, starts from 1
+	Seq int64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// unix time of the event
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// event type, state/collection_start/collection_finish/collection_fail/progress
+	Type                 string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	DbName               string   `protobuf:"bytes,5,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string   `protobuf:"bytes,6,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OperationEvent) Reset()         { *m = OperationEvent{} }
+func (m *OperationEvent) String() string { return proto.CompactTextString(m) }
+func (*OperationEvent) ProtoMessage()    {}
+func (*OperationEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{28}
+}
+
+func (m *OperationEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperationEvent.Unmarshal(m, b)
+}
+func (m *OperationEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperationEvent.Marshal(b, m, deterministic)
+}
+func (m *OperationEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationEvent.Merge(m, src)
+}
+func (m *OperationEvent) XXX_Size() int {
+	return xxx_messageInfo_OperationEvent.Size(m)
+}
+func (m *OperationEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationEvent proto.InternalMessageInfo
+
+func (m *OperationEvent) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *OperationEvent) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *OperationEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *OperationEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *OperationEvent) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *OperationEvent) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type GetEventsRequest struct {
+	// uuid of request, will generate one if not set
+	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	// backup id or restore task id
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// only return events with seq greater than after_seq
+	AfterSeq int64 `protobuf:"varint,3,opt,name=after_seq,json=afterSeq,proto3" json:"after_seq,omitempty"`
+	// seconds to wait when there is no new event, 0 means return immediately
+	WaitSeconds          int32    `protobuf:"varint,4,opt,name=wait_seconds,json=waitSeconds,proto3" json:"wait_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetEventsRequest) Reset()         { *m = GetEventsRequest{} }
+func (m *GetEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEventsRequest) ProtoMessage()    {}
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{29}
+}
+
+func (m *GetEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEventsRequest.Unmarshal(m, b)
+}
+func (m *GetEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEventsRequest.Merge(m, src)
+}
+func (m *GetEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEventsRequest.Size(m)
+}
+func (m *GetEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEventsRequest proto.InternalMessageInfo
+
+func (m *GetEventsRequest) GetRequestId() string {
 	if m != nil {
-		return m.CollectionRestoreTasks
+		return m.RequestId
 	}
-	return nil
+	return ""
 }
 
-func (m *RestoreBackupTask) GetRestoredSize() int64 {
+func (m *GetEventsRequest) GetId() string {
 	if m != nil {
-		return m.RestoredSize
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *RestoreBackupTask) GetToRestoreSize() int64 {
+func (m *GetEventsRequest) GetAfterSeq() int64 {
 	if m != nil {
-		return m.ToRestoreSize
+		return m.AfterSeq
 	}
 	return 0
 }
 
-func (m *RestoreBackupTask) GetProgress() int32 {
+func (m *GetEventsRequest) GetWaitSeconds() int32 {
 	if m != nil {
-		return m.Progress
+		return m.WaitSeconds
 	}
 	return 0
 }
 
-type RestoreBackupResponse struct {
+type GetEventsResponse struct {
 	// uuid of the request to response
 	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
 	// response code. 0 means success. others are fail
 	Code ResponseCode `protobuf:"varint,2,opt,name=code,proto3,enum=milvus.proto.backup.ResponseCode" json:"code,omitempty"`
 	// error msg if fail
 	Msg string `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
-	// restore task info entity
-	Data                 *RestoreBackupTask `protobuf:"bytes,4,opt,name=data,proto3" json:"data"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	// events in seq order
+	Data                 []*OperationEvent `protobuf:"bytes,4,rep,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *RestoreBackupResponse) Reset()         { *m = RestoreBackupResponse{} }
-func (m *RestoreBackupResponse) String() string { return proto.CompactTextString(m) }
-func (*RestoreBackupResponse) ProtoMessage()    {}
-func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{19}
+func (m *GetEventsResponse) Reset()         { *m = GetEventsResponse{} }
+func (m *GetEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEventsResponse) ProtoMessage()    {}
+func (*GetEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{30}
 }
 
-func (m *RestoreBackupResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RestoreBackupResponse.Unmarshal(m, b)
+func (m *GetEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEventsResponse.Unmarshal(m, b)
 }
-func (m *RestoreBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RestoreBackupResponse.Marshal(b, m, deterministic)
+func (m *GetEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEventsResponse.Marshal(b, m, deterministic)
 }
-func (m *RestoreBackupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RestoreBackupResponse.Merge(m, src)
+func (m *GetEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEventsResponse.Merge(m, src)
 }
-func (m *RestoreBackupResponse) XXX_Size() int {
-	return xxx_messageInfo_RestoreBackupResponse.Size(m)
+func (m *GetEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetEventsResponse.Size(m)
 }
-func (m *RestoreBackupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RestoreBackupResponse.DiscardUnknown(m)
+func (m *GetEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEventsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RestoreBackupResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetEventsResponse proto.InternalMessageInfo
 
-func (m *RestoreBackupResponse) GetRequestId() string {
+func (m *GetEventsResponse) GetRequestId() string {
 	if m != nil {
 		return m.RequestId
 	}
 	return ""
 }
 
-func (m *RestoreBackupResponse) GetCode() ResponseCode {
+func (m *GetEventsResponse) GetCode() ResponseCode {
 	if m != nil {
 		return m.Code
 	}
 	return ResponseCode_Success
 }
 
-func (m *RestoreBackupResponse) GetMsg() string {
+func (m *GetEventsResponse) GetMsg() string {
 	if m != nil {
 		return m.Msg
 	}
 	return ""
 }
 
-func (m *RestoreBackupResponse) GetData() *RestoreBackupTask {
+func (m *GetEventsResponse) GetData() []*OperationEvent {
 	if m != nil {
 		return m.Data
 	}
 	return nil
 }
 
-type GetRestoreStateRequest struct {
-	// uuid of request, will generate one if not set
-	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
-	// restore task id to query
-	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *GetRestoreStateRequest) Reset()         { *m = GetRestoreStateRequest{} }
-func (m *GetRestoreStateRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRestoreStateRequest) ProtoMessage()    {}
-func (*GetRestoreStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{20}
-}
-
-func (m *GetRestoreStateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRestoreStateRequest.Unmarshal(m, b)
-}
-func (m *GetRestoreStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRestoreStateRequest.Marshal(b, m, deterministic)
-}
-func (m *GetRestoreStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRestoreStateRequest.Merge(m, src)
-}
-func (m *GetRestoreStateRequest) XXX_Size() int {
-	return xxx_messageInfo_GetRestoreStateRequest.Size(m)
-}
-func (m *GetRestoreStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRestoreStateRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetRestoreStateRequest proto.InternalMessageInfo
-
-func (m *GetRestoreStateRequest) GetRequestId() string {
-	if m != nil {
-		return m.RequestId
-	}
-	return ""
-}
-
-func (m *GetRestoreStateRequest) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
-
 // copied from milvus data_coord.proto
 type FieldBinlog struct {
 	FieldID              int64     `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
@@ -2176,7 +3596,7 @@ func (m *FieldBinlog) Reset()         { *m = FieldBinlog{} }
 func (m *FieldBinlog) String() string { return proto.CompactTextString(m) }
 func (*FieldBinlog) ProtoMessage()    {}
 func (*FieldBinlog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{21}
+	return fileDescriptor_65240d19de191688, []int{31}
 }
 
 func (m *FieldBinlog) XXX_Unmarshal(b []byte) error {
@@ -2212,11 +3632,13 @@ func (m *FieldBinlog) GetBinlogs() []*Binlog {
 }
 
 type Binlog struct {
-	EntriesNum           int64    `protobuf:"varint,1,opt,name=entries_num,json=entriesNum,proto3" json:"entries_num,omitempty"`
-	TimestampFrom        uint64   `protobuf:"varint,2,opt,name=timestamp_from,json=timestampFrom,proto3" json:"timestamp_from,omitempty"`
-	TimestampTo          uint64   `protobuf:"varint,3,opt,name=timestamp_to,json=timestampTo,proto3" json:"timestamp_to,omitempty"`
-	LogPath              string   `protobuf:"bytes,4,opt,name=log_path,json=logPath,proto3" json:"log_path,omitempty"`
-	LogSize              int64    `protobuf:"varint,5,opt,name=log_size,json=logSize,proto3" json:"log_size"`
+	EntriesNum    int64  `protobuf:"varint,1,opt,name=entries_num,json=entriesNum,proto3" json:"entries_num,omitempty"`
+	TimestampFrom uint64 `protobuf:"varint,2,opt,name=timestamp_from,json=timestampFrom,proto3" json:"timestamp_from,omitempty"`
+	TimestampTo   uint64 `protobuf:"varint,3,opt,name=timestamp_to,json=timestampTo,proto3" json:"timestamp_to,omitempty"`
+	LogPath       string `protobuf:"bytes,4,opt,name=log_path,json=logPath,proto3" json:"log_path,omitempty"`
+	LogSize       int64  `protobuf:"varint,5,opt,name=log_size,json=logSize,proto3" json:"log_size"`
+	// hex sha256 of the binlog, set if backup.verifyChecksum is enabled
+	Sha256               string   `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2226,7 +3648,7 @@ func (m *Binlog) Reset()         { *m = Binlog{} }
 func (m *Binlog) String() string { return proto.CompactTextString(m) }
 func (*Binlog) ProtoMessage()    {}
 func (*Binlog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{22}
+	return fileDescriptor_65240d19de191688, []int{32}
 }
 
 func (m *Binlog) XXX_Unmarshal(b []byte) error {
@@ -2282,6 +3704,13 @@ func (m *Binlog) GetLogSize() int64 {
 	return 0
 }
 
+func (m *Binlog) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
 // copied from milvus common.proto
 type KeyValuePair struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -2295,7 +3724,7 @@ func (m *KeyValuePair) Reset()         { *m = KeyValuePair{} }
 func (m *KeyValuePair) String() string { return proto.CompactTextString(m) }
 func (*KeyValuePair) ProtoMessage()    {}
 func (*KeyValuePair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{23}
+	return fileDescriptor_65240d19de191688, []int{33}
 }
 
 func (m *KeyValuePair) XXX_Unmarshal(b []byte) error {
@@ -2332,7 +3761,6 @@ func (m *KeyValuePair) GetValue() string {
 
 type ValueField struct {
 	// Types that are valid to be assigned to Data:
-	//
 	//	*ValueField_BoolData
 	//	*ValueField_IntData
 	//	*ValueField_LongData
@@ -2350,7 +3778,7 @@ func (m *ValueField) Reset()         { *m = ValueField{} }
 func (m *ValueField) String() string { return proto.CompactTextString(m) }
 func (*ValueField) ProtoMessage()    {}
 func (*ValueField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{24}
+	return fileDescriptor_65240d19de191688, []int{34}
 }
 
 func (m *ValueField) XXX_Unmarshal(b []byte) error {
@@ -2502,6 +3930,7 @@ type FieldSchema struct {
 	DefaultValue         *ValueField     `protobuf:"bytes,11,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
 	IsDynamic            bool            `protobuf:"varint,12,opt,name=is_dynamic,json=isDynamic,proto3" json:"is_dynamic,omitempty"`
 	IsPartitionKey       bool            `protobuf:"varint,13,opt,name=is_partition_key,json=isPartitionKey,proto3" json:"is_partition_key,omitempty"`
+	IsFunctionOutput     bool            `protobuf:"varint,16,opt,name=is_function_output,json=isFunctionOutput,proto3" json:"is_function_output,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -2511,7 +3940,7 @@ func (m *FieldSchema) Reset()         { *m = FieldSchema{} }
 func (m *FieldSchema) String() string { return proto.CompactTextString(m) }
 func (*FieldSchema) ProtoMessage()    {}
 func (*FieldSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{25}
+	return fileDescriptor_65240d19de191688, []int{35}
 }
 
 func (m *FieldSchema) XXX_Unmarshal(b []byte) error {
@@ -2623,24 +4052,138 @@ func (m *FieldSchema) GetIsPartitionKey() bool {
 	return false
 }
 
+func (m *FieldSchema) GetIsFunctionOutput() bool {
+	if m != nil {
+		return m.IsFunctionOutput
+	}
+	return false
+}
+
+// collection function of milvus 2.5, e.g. BM25 or text embedding
+type FunctionSchema struct {
+	Name                 string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id                   int64           `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Description          string          `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Type                 FunctionType    `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.backup.FunctionType" json:"type,omitempty"`
+	InputFieldNames      []string        `protobuf:"bytes,5,rep,name=input_field_names,json=inputFieldNames,proto3" json:"input_field_names,omitempty"`
+	InputFieldIds        []int64         `protobuf:"varint,6,rep,packed,name=input_field_ids,json=inputFieldIds,proto3" json:"input_field_ids,omitempty"`
+	OutputFieldNames     []string        `protobuf:"bytes,7,rep,name=output_field_names,json=outputFieldNames,proto3" json:"output_field_names,omitempty"`
+	OutputFieldIds       []int64         `protobuf:"varint,8,rep,packed,name=output_field_ids,json=outputFieldIds,proto3" json:"output_field_ids,omitempty"`
+	Params               []*KeyValuePair `protobuf:"bytes,9,rep,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *FunctionSchema) Reset()         { *m = FunctionSchema{} }
+func (m *FunctionSchema) String() string { return proto.CompactTextString(m) }
+func (*FunctionSchema) ProtoMessage()    {}
+func (*FunctionSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{36}
+}
+
+func (m *FunctionSchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FunctionSchema.Unmarshal(m, b)
+}
+func (m *FunctionSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FunctionSchema.Marshal(b, m, deterministic)
+}
+func (m *FunctionSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunctionSchema.Merge(m, src)
+}
+func (m *FunctionSchema) XXX_Size() int {
+	return xxx_messageInfo_FunctionSchema.Size(m)
+}
+func (m *FunctionSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunctionSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunctionSchema proto.InternalMessageInfo
+
+func (m *FunctionSchema) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FunctionSchema) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FunctionSchema) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *FunctionSchema) GetType() FunctionType {
+	if m != nil {
+		return m.Type
+	}
+	return FunctionType_Unknown
+}
+
+func (m *FunctionSchema) GetInputFieldNames() []string {
+	if m != nil {
+		return m.InputFieldNames
+	}
+	return nil
+}
+
+func (m *FunctionSchema) GetInputFieldIds() []int64 {
+	if m != nil {
+		return m.InputFieldIds
+	}
+	return nil
+}
+
+func (m *FunctionSchema) GetOutputFieldNames() []string {
+	if m != nil {
+		return m.OutputFieldNames
+	}
+	return nil
+}
+
+func (m *FunctionSchema) GetOutputFieldIds() []int64 {
+	if m != nil {
+		return m.OutputFieldIds
+	}
+	return nil
+}
+
+func (m *FunctionSchema) GetParams() []*KeyValuePair {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 // *
 // @brief Collection schema
 type CollectionSchema struct {
-	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description          string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	AutoID               bool           `protobuf:"varint,3,opt,name=autoID,proto3" json:"autoID,omitempty"`
-	Fields               []*FieldSchema `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
-	EnableDynamicField   bool           `protobuf:"varint,5,opt,name=enable_dynamic_field,json=enableDynamicField,proto3" json:"enable_dynamic_field,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Name               string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AutoID             bool           `protobuf:"varint,3,opt,name=autoID,proto3" json:"autoID,omitempty"`
+	Fields             []*FieldSchema `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	EnableDynamicField bool           `protobuf:"varint,5,opt,name=enable_dynamic_field,json=enableDynamicField,proto3" json:"enable_dynamic_field,omitempty"`
+	// functions of the collection, read from the raw describe response. the milvus sdk in use can't create them,
+	// restore rejects a backup that has functions instead of silently dropping them
+	Functions            []*FunctionSchema `protobuf:"bytes,7,rep,name=functions,proto3" json:"functions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *CollectionSchema) Reset()         { *m = CollectionSchema{} }
 func (m *CollectionSchema) String() string { return proto.CompactTextString(m) }
 func (*CollectionSchema) ProtoMessage()    {}
 func (*CollectionSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{26}
+	return fileDescriptor_65240d19de191688, []int{37}
 }
 
 func (m *CollectionSchema) XXX_Unmarshal(b []byte) error {
@@ -2696,6 +4239,13 @@ func (m *CollectionSchema) GetEnableDynamicField() bool {
 	return false
 }
 
+func (m *CollectionSchema) GetFunctions() []*FunctionSchema {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
 type CheckRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -2706,7 +4256,7 @@ func (m *CheckRequest) Reset()         { *m = CheckRequest{} }
 func (m *CheckRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckRequest) ProtoMessage()    {}
 func (*CheckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{27}
+	return fileDescriptor_65240d19de191688, []int{38}
 }
 
 func (m *CheckRequest) XXX_Unmarshal(b []byte) error {
@@ -2741,7 +4291,7 @@ func (m *CheckResponse) Reset()         { *m = CheckResponse{} }
 func (m *CheckResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckResponse) ProtoMessage()    {}
 func (*CheckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{28}
+	return fileDescriptor_65240d19de191688, []int{39}
 }
 
 func (m *CheckResponse) XXX_Unmarshal(b []byte) error {
@@ -2790,7 +4340,7 @@ func (m *MsgPosition) Reset()         { *m = MsgPosition{} }
 func (m *MsgPosition) String() string { return proto.CompactTextString(m) }
 func (*MsgPosition) ProtoMessage()    {}
 func (*MsgPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{29}
+	return fileDescriptor_65240d19de191688, []int{40}
 }
 
 func (m *MsgPosition) XXX_Unmarshal(b []byte) error {
@@ -2851,7 +4401,7 @@ func (m *ChannelPosition) Reset()         { *m = ChannelPosition{} }
 func (m *ChannelPosition) String() string { return proto.CompactTextString(m) }
 func (*ChannelPosition) ProtoMessage()    {}
 func (*ChannelPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65240d19de191688, []int{30}
+	return fileDescriptor_65240d19de191688, []int{41}
 }
 
 func (m *ChannelPosition) XXX_Unmarshal(b []byte) error {
@@ -2886,6 +4436,62 @@ func (m *ChannelPosition) GetPosition() string {
 	return ""
 }
 
+type ShardChannel struct {
+	// index of the shard in the virtual channels of the collection
+	Shard                int32    `protobuf:"varint,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	VirtualChannel       string   `protobuf:"bytes,2,opt,name=virtual_channel,json=virtualChannel,proto3" json:"virtual_channel,omitempty"`
+	PhysicalChannel      string   `protobuf:"bytes,3,opt,name=physical_channel,json=physicalChannel,proto3" json:"physical_channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardChannel) Reset()         { *m = ShardChannel{} }
+func (m *ShardChannel) String() string { return proto.CompactTextString(m) }
+func (*ShardChannel) ProtoMessage()    {}
+func (*ShardChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{42}
+}
+
+func (m *ShardChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardChannel.Unmarshal(m, b)
+}
+func (m *ShardChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardChannel.Marshal(b, m, deterministic)
+}
+func (m *ShardChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardChannel.Merge(m, src)
+}
+func (m *ShardChannel) XXX_Size() int {
+	return xxx_messageInfo_ShardChannel.Size(m)
+}
+func (m *ShardChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardChannel proto.InternalMessageInfo
+
+func (m *ShardChannel) GetShard() int32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *ShardChannel) GetVirtualChannel() string {
+	if m != nil {
+		return m.VirtualChannel
+	}
+	return ""
+}
+
+func (m *ShardChannel) GetPhysicalChannel() string {
+	if m != nil {
+		return m.PhysicalChannel
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.backup.ResponseCode", ResponseCode_name, ResponseCode_value)
 	proto.RegisterEnum("milvus.proto.backup.BackupTaskStateCode", BackupTaskStateCode_name, BackupTaskStateCode_value)
@@ -2893,239 +4499,379 @@ func init() {
 	proto.RegisterEnum("milvus.proto.backup.ConsistencyLevel", ConsistencyLevel_name, ConsistencyLevel_value)
 	proto.RegisterEnum("milvus.proto.backup.DataType", DataType_name, DataType_value)
 	proto.RegisterEnum("milvus.proto.backup.FieldState", FieldState_name, FieldState_value)
+	proto.RegisterEnum("milvus.proto.backup.FunctionType", FunctionType_name, FunctionType_value)
 	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.backup.IndexInfo")
 	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.backup.IndexInfo.ParamsEntry")
 	proto.RegisterType((*CollectionBackupInfo)(nil), "milvus.proto.backup.CollectionBackupInfo")
 	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.backup.CollectionBackupInfo.ChannelCheckpointsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.backup.CollectionBackupInfo.PropertiesEntry")
 	proto.RegisterType((*PartitionBackupInfo)(nil), "milvus.proto.backup.PartitionBackupInfo")
 	proto.RegisterType((*SegmentBackupInfo)(nil), "milvus.proto.backup.SegmentBackupInfo")
+	proto.RegisterType((*SegmentIndexFiles)(nil), "milvus.proto.backup.SegmentIndexFiles")
 	proto.RegisterType((*BackupInfo)(nil), "milvus.proto.backup.BackupInfo")
+	proto.RegisterType((*CopyStats)(nil), "milvus.proto.backup.CopyStats")
+	proto.RegisterType((*DatabaseBackupInfo)(nil), "milvus.proto.backup.DatabaseBackupInfo")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.backup.DatabaseBackupInfo.PropertiesEntry")
 	proto.RegisterType((*CollectionLevelBackupInfo)(nil), "milvus.proto.backup.CollectionLevelBackupInfo")
 	proto.RegisterType((*PartitionLevelBackupInfo)(nil), "milvus.proto.backup.PartitionLevelBackupInfo")
 	proto.RegisterType((*SegmentLevelBackupInfo)(nil), "milvus.proto.backup.SegmentLevelBackupInfo")
 	proto.RegisterType((*CreateBackupRequest)(nil), "milvus.proto.backup.CreateBackupRequest")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.backup.CreateBackupRequest.PropertySelectorEntry")
 	proto.RegisterType((*BackupInfoResponse)(nil), "milvus.proto.backup.BackupInfoResponse")
 	proto.RegisterType((*GetBackupRequest)(nil), "milvus.proto.backup.GetBackupRequest")
 	proto.RegisterType((*ListBackupsRequest)(nil), "milvus.proto.backup.ListBackupsRequest")
 	proto.RegisterType((*ListBackupsResponse)(nil), "milvus.proto.backup.ListBackupsResponse")
 	proto.RegisterType((*DeleteBackupRequest)(nil), "milvus.proto.backup.DeleteBackupRequest")
 	proto.RegisterType((*DeleteBackupResponse)(nil), "milvus.proto.backup.DeleteBackupResponse")
+	proto.RegisterType((*CleanupOrphansRequest)(nil), "milvus.proto.backup.CleanupOrphansRequest")
+	proto.RegisterType((*CleanupOrphansResponse)(nil), "milvus.proto.backup.CleanupOrphansResponse")
 	proto.RegisterType((*RestoreBackupRequest)(nil), "milvus.proto.backup.RestoreBackupRequest")
 	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.backup.RestoreBackupRequest.CollectionRenamesEntry")
+	proto.RegisterType((*VectorTransformerOption)(nil), "milvus.proto.backup.VectorTransformerOption")
+	proto.RegisterType((*IndexParamOverride)(nil), "milvus.proto.backup.IndexParamOverride")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.backup.IndexParamOverride.ParamsEntry")
 	proto.RegisterType((*RestorePartitionTask)(nil), "milvus.proto.backup.RestorePartitionTask")
 	proto.RegisterType((*RestoreCollectionTask)(nil), "milvus.proto.backup.RestoreCollectionTask")
 	proto.RegisterType((*RestoreBackupTask)(nil), "milvus.proto.backup.RestoreBackupTask")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.backup.RestoreBackupTask.SanitizedCollectionNamesEntry")
 	proto.RegisterType((*RestoreBackupResponse)(nil), "milvus.proto.backup.RestoreBackupResponse")
 	proto.RegisterType((*GetRestoreStateRequest)(nil), "milvus.proto.backup.GetRestoreStateRequest")
+	proto.RegisterType((*OperationEvent)(nil), "milvus.proto.backup.OperationEvent")
+	proto.RegisterType((*GetEventsRequest)(nil), "milvus.proto.backup.GetEventsRequest")
+	proto.RegisterType((*GetEventsResponse)(nil), "milvus.proto.backup.GetEventsResponse")
 	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.backup.FieldBinlog")
 	proto.RegisterType((*Binlog)(nil), "milvus.proto.backup.Binlog")
 	proto.RegisterType((*KeyValuePair)(nil), "milvus.proto.backup.KeyValuePair")
 	proto.RegisterType((*ValueField)(nil), "milvus.proto.backup.ValueField")
 	proto.RegisterType((*FieldSchema)(nil), "milvus.proto.backup.FieldSchema")
+	proto.RegisterType((*FunctionSchema)(nil), "milvus.proto.backup.FunctionSchema")
 	proto.RegisterType((*CollectionSchema)(nil), "milvus.proto.backup.CollectionSchema")
 	proto.RegisterType((*CheckRequest)(nil), "milvus.proto.backup.CheckRequest")
 	proto.RegisterType((*CheckResponse)(nil), "milvus.proto.backup.CheckResponse")
 	proto.RegisterType((*MsgPosition)(nil), "milvus.proto.backup.MsgPosition")
 	proto.RegisterType((*ChannelPosition)(nil), "milvus.proto.backup.ChannelPosition")
+	proto.RegisterType((*ShardChannel)(nil), "milvus.proto.backup.ShardChannel")
 }
 
 func init() { proto.RegisterFile("backup.proto", fileDescriptor_65240d19de191688) }
 
 var fileDescriptor_65240d19de191688 = []byte{
-	// 3075 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x4b, 0x6f, 0x1c, 0xc7,
-	0xf1, 0xe7, 0xbe, 0x77, 0x6b, 0x76, 0x97, 0xc3, 0x26, 0x45, 0xad, 0x28, 0xcb, 0xa2, 0xf6, 0x6f,
-	0xcb, 0x94, 0x8c, 0x3f, 0x25, 0xd3, 0xb6, 0x62, 0x0b, 0xf1, 0x43, 0x7c, 0x48, 0x5a, 0x4b, 0xa2,
-	0x98, 0x21, 0x25, 0x08, 0xce, 0x63, 0x30, 0x3b, 0xd3, 0xdc, 0x9d, 0x70, 0x76, 0x7a, 0x33, 0x3d,
-	0x2b, 0x6b, 0x05, 0x24, 0x08, 0x90, 0x4b, 0x8e, 0x39, 0xe4, 0x94, 0x6f, 0x10, 0x20, 0x87, 0x04,
-	0x41, 0x72, 0xc8, 0x37, 0x48, 0x90, 0x6b, 0x3e, 0x42, 0x10, 0xe4, 0x94, 0x63, 0xae, 0x41, 0x57,
-	0xf7, 0x3c, 0x76, 0x39, 0xa4, 0x96, 0x81, 0x11, 0xc7, 0xb9, 0x4d, 0xff, 0xba, 0xaa, 0xba, 0xbb,
-	0xba, 0xba, 0xaa, 0xba, 0x7a, 0xa0, 0xde, 0xb5, 0xec, 0xa3, 0xd1, 0x70, 0x7d, 0x18, 0xb0, 0x90,
-	0x91, 0xc5, 0x81, 0xeb, 0x3d, 0x1f, 0x71, 0xd9, 0x5a, 0x97, 0x5d, 0x2b, 0xaf, 0xf5, 0x18, 0xeb,
-	0x79, 0xf4, 0x06, 0x82, 0xdd, 0xd1, 0xe1, 0x0d, 0x1e, 0x06, 0x23, 0x3b, 0x94, 0x44, 0xed, 0xbf,
-	0xe5, 0xa0, 0xd6, 0xf1, 0x1d, 0xfa, 0xa2, 0xe3, 0x1f, 0x32, 0x72, 0x09, 0xe0, 0xd0, 0xa5, 0x9e,
-	0x63, 0xfa, 0xd6, 0x80, 0xb6, 0x72, 0xab, 0xb9, 0xb5, 0x9a, 0x51, 0x43, 0x64, 0xd7, 0x1a, 0x50,
-	0xd1, 0xed, 0x0a, 0x5a, 0xd9, 0x9d, 0x97, 0xdd, 0x88, 0x4c, 0x76, 0x87, 0xe3, 0x21, 0x6d, 0x15,
-	0x52, 0xdd, 0x07, 0xe3, 0x21, 0x25, 0x9b, 0x50, 0x1e, 0x5a, 0x81, 0x35, 0xe0, 0xad, 0xe2, 0x6a,
-	0x61, 0x4d, 0xdb, 0xb8, 0xbe, 0x9e, 0x31, 0xdd, 0xf5, 0x78, 0x32, 0xeb, 0x7b, 0x48, 0xbc, 0xe3,
-	0x87, 0xc1, 0xd8, 0x50, 0x9c, 0x2b, 0x1f, 0x82, 0x96, 0x82, 0x89, 0x0e, 0x85, 0x23, 0x3a, 0x56,
-	0x13, 0x15, 0x9f, 0x64, 0x09, 0x4a, 0xcf, 0x2d, 0x6f, 0x14, 0xcd, 0x4e, 0x36, 0x6e, 0xe7, 0x3f,
-	0xc8, 0xb5, 0xff, 0x52, 0x85, 0xa5, 0x2d, 0xe6, 0x79, 0xd4, 0x0e, 0x5d, 0xe6, 0x6f, 0xe2, 0x68,
-	0xb8, 0xe8, 0x26, 0xe4, 0x5d, 0x47, 0xc9, 0xc8, 0xbb, 0x0e, 0xb9, 0x07, 0xc0, 0x43, 0x2b, 0xa4,
-	0xa6, 0xcd, 0x1c, 0x29, 0xa7, 0xb9, 0xb1, 0x96, 0x39, 0x57, 0x29, 0xe4, 0xc0, 0xe2, 0x47, 0xfb,
-	0x82, 0x61, 0x8b, 0x39, 0xd4, 0xa8, 0xf1, 0xe8, 0x93, 0xb4, 0xa1, 0x4e, 0x83, 0x80, 0x05, 0x8f,
-	0x28, 0xe7, 0x56, 0x2f, 0xd2, 0xc8, 0x04, 0x26, 0x74, 0xc6, 0x43, 0x2b, 0x08, 0xcd, 0xd0, 0x1d,
-	0xd0, 0x56, 0x71, 0x35, 0xb7, 0x56, 0x40, 0x11, 0x41, 0x78, 0xe0, 0x0e, 0x28, 0xb9, 0x00, 0x55,
-	0xea, 0x3b, 0xb2, 0xb3, 0x84, 0x9d, 0x15, 0xea, 0x3b, 0xd8, 0xb5, 0x02, 0xd5, 0x61, 0xc0, 0x7a,
-	0x01, 0xe5, 0xbc, 0x55, 0x5e, 0xcd, 0xad, 0x95, 0x8c, 0xb8, 0x4d, 0xfe, 0x0f, 0x1a, 0x76, 0xbc,
-	0x54, 0xd3, 0x75, 0x5a, 0x15, 0xe4, 0xad, 0x27, 0x60, 0xc7, 0x21, 0xe7, 0xa1, 0xe2, 0x74, 0xe5,
-	0x56, 0x56, 0x71, 0x66, 0x65, 0xa7, 0x8b, 0xfb, 0xf8, 0x16, 0xcc, 0xa7, 0xb8, 0x91, 0xa0, 0x86,
-	0x04, 0xcd, 0x04, 0x46, 0xc2, 0x8f, 0xa0, 0xcc, 0xed, 0x3e, 0x1d, 0x58, 0x2d, 0x58, 0xcd, 0xad,
-	0x69, 0x1b, 0x6f, 0x66, 0x6a, 0x29, 0x51, 0xfa, 0x3e, 0x12, 0x1b, 0x8a, 0x09, 0xd7, 0xde, 0xb7,
-	0x02, 0x87, 0x9b, 0xfe, 0x68, 0xd0, 0xd2, 0x70, 0x0d, 0x35, 0x89, 0xec, 0x8e, 0x06, 0xc4, 0x80,
-	0x05, 0x9b, 0xf9, 0xdc, 0xe5, 0x21, 0xf5, 0xed, 0xb1, 0xe9, 0xd1, 0xe7, 0xd4, 0x6b, 0xd5, 0x71,
-	0x3b, 0x4e, 0x1a, 0x28, 0xa6, 0x7e, 0x28, 0x88, 0x0d, 0xdd, 0x9e, 0x42, 0xc8, 0x13, 0x58, 0x18,
-	0x5a, 0x41, 0xe8, 0xe2, 0xca, 0x24, 0x1b, 0x6f, 0x35, 0xd0, 0x1c, 0xb3, 0xb7, 0x78, 0x2f, 0xa2,
-	0x4e, 0x0c, 0xc6, 0xd0, 0x87, 0x93, 0x20, 0x27, 0xd7, 0x40, 0x97, 0xf4, 0xb8, 0x53, 0x3c, 0xb4,
-	0x06, 0xc3, 0x56, 0x73, 0x35, 0xb7, 0x56, 0x34, 0xe6, 0x25, 0x7e, 0x10, 0xc1, 0x84, 0x40, 0x91,
-	0xbb, 0x2f, 0x69, 0x6b, 0x1e, 0x77, 0x04, 0xbf, 0xc9, 0x45, 0xa8, 0xf5, 0x2d, 0x6e, 0xe2, 0x51,
-	0x69, 0xe9, 0xab, 0xb9, 0xb5, 0xaa, 0x51, 0xed, 0x5b, 0x1c, 0x8f, 0x02, 0xf9, 0x04, 0x34, 0x79,
-	0xaa, 0x5c, 0xff, 0x90, 0xf1, 0xd6, 0x02, 0x4e, 0xf6, 0xf5, 0xd3, 0xcf, 0x8e, 0x21, 0x0f, 0xa2,
-	0xf8, 0xe4, 0x42, 0xcd, 0x1e, 0xb3, 0x1c, 0x13, 0x0d, 0xb3, 0x45, 0xe4, 0xb1, 0x14, 0x08, 0x1a,
-	0x2d, 0xb9, 0x0d, 0x17, 0xd4, 0xdc, 0x87, 0xfd, 0x31, 0x77, 0x6d, 0xcb, 0x4b, 0x2d, 0x62, 0x11,
-	0x17, 0x71, 0x5e, 0x12, 0xec, 0xa9, 0xfe, 0x64, 0x31, 0x01, 0x2c, 0xda, 0x7d, 0xcb, 0xf7, 0xa9,
-	0x67, 0xda, 0x7d, 0x6a, 0x1f, 0x0d, 0x99, 0xeb, 0x87, 0xbc, 0xb5, 0x84, 0x73, 0xbc, 0xf3, 0x0a,
-	0x6b, 0x48, 0x34, 0xba, 0xbe, 0x25, 0x85, 0x6c, 0x25, 0x32, 0xe4, 0xb1, 0x27, 0xf6, 0xb1, 0x0e,
-	0x72, 0x0f, 0x34, 0xef, 0xa6, 0xc9, 0x69, 0x6f, 0x40, 0xc5, 0x58, 0xe7, 0x70, 0xac, 0xab, 0x99,
-	0x63, 0xed, 0x4b, 0xa2, 0xd4, 0xd6, 0x81, 0x77, 0x53, 0x81, 0x7c, 0x65, 0x07, 0xce, 0x9f, 0x30,
-	0xee, 0x99, 0xfc, 0xca, 0x4f, 0xf3, 0xb0, 0x98, 0x61, 0x25, 0xe4, 0x0a, 0xd4, 0x13, 0x53, 0x53,
-	0x0e, 0xa6, 0x60, 0x68, 0x31, 0xd6, 0x71, 0xc8, 0x9b, 0xd0, 0x4c, 0x48, 0x52, 0x3e, 0xb5, 0x11,
-	0xa3, 0x78, 0xcc, 0x8e, 0x9d, 0xe6, 0x42, 0xc6, 0x69, 0x7e, 0x0c, 0xf3, 0x4a, 0x27, 0xb1, 0x5d,
-	0x17, 0xcf, 0xa4, 0x9a, 0x26, 0x4f, 0x43, 0x3c, 0x36, 0xd4, 0x52, 0xca, 0x50, 0x27, 0x4d, 0xa9,
-	0x3c, 0x65, 0x4a, 0xed, 0xdf, 0x17, 0x60, 0xe1, 0x98, 0x60, 0x3c, 0xe6, 0x6a, 0x66, 0xb1, 0x1a,
-	0x6a, 0x0a, 0xe9, 0x38, 0xc7, 0x57, 0x97, 0xcf, 0x58, 0xdd, 0xb4, 0x32, 0x0b, 0xc7, 0x95, 0xf9,
-	0x3a, 0x68, 0xfe, 0x68, 0x60, 0xb2, 0x43, 0x33, 0x60, 0x5f, 0xf0, 0xc8, 0x95, 0xfa, 0xa3, 0xc1,
-	0xe3, 0x43, 0x83, 0x7d, 0xc1, 0xc9, 0x6d, 0xa8, 0x74, 0x5d, 0xdf, 0x63, 0x3d, 0xde, 0x2a, 0xa1,
-	0x62, 0x56, 0x33, 0x15, 0x73, 0x57, 0x44, 0xbb, 0x4d, 0x24, 0x34, 0x22, 0x06, 0xf2, 0x31, 0xa0,
-	0x5b, 0xe7, 0xc8, 0x5d, 0x9e, 0x91, 0x3b, 0x61, 0x11, 0xfc, 0x0e, 0xf5, 0x42, 0x0b, 0xf9, 0x2b,
-	0xb3, 0xf2, 0xc7, 0x2c, 0xf1, 0x5e, 0x54, 0x53, 0x7b, 0x71, 0x01, 0xaa, 0xbd, 0x80, 0x8d, 0x86,
-	0x42, 0x1d, 0x35, 0x19, 0x1a, 0xb0, 0xdd, 0x71, 0x44, 0x68, 0x90, 0xf2, 0xa8, 0x83, 0x9e, 0xb9,
-	0x6a, 0xc4, 0x6d, 0xb2, 0x08, 0x25, 0x97, 0x9b, 0xde, 0x4d, 0xf4, 0xb7, 0x55, 0xa3, 0xe8, 0xf2,
-	0x87, 0x37, 0xdb, 0xbf, 0x2d, 0x00, 0xfc, 0x6f, 0x47, 0x44, 0x02, 0x45, 0x3c, 0x60, 0x15, 0x1c,
-	0x11, 0xbf, 0x33, 0xbd, 0x76, 0x35, 0xdb, 0x6b, 0x3f, 0x03, 0x92, 0x32, 0xd2, 0xe8, 0x80, 0xd5,
-	0x70, 0x27, 0xaf, 0xcd, 0xec, 0xe7, 0x8c, 0x05, 0x7b, 0x0a, 0x4d, 0xb6, 0x16, 0x52, 0x5b, 0xfb,
-	0x26, 0x34, 0xa5, 0x48, 0xf3, 0x39, 0x0d, 0xb8, 0xcb, 0x7c, 0xdc, 0xac, 0x9a, 0xd1, 0x90, 0xe8,
-	0x53, 0x09, 0xb6, 0xbf, 0x03, 0x17, 0x92, 0x51, 0x30, 0xbe, 0xa5, 0xf6, 0xf0, 0x13, 0x28, 0xc9,
-	0x80, 0x91, 0x3b, 0xeb, 0x24, 0x25, 0x5f, 0xfb, 0x73, 0x68, 0xc5, 0x6e, 0x6d, 0x5a, 0xf8, 0xc7,
-	0x93, 0xc2, 0x67, 0x0f, 0x9d, 0x4a, 0xf6, 0x53, 0x58, 0x56, 0x7e, 0x62, 0x5a, 0xf2, 0x37, 0x27,
-	0x25, 0xcf, 0xea, 0xbc, 0x94, 0xdc, 0x9f, 0x14, 0x60, 0x71, 0x2b, 0xa0, 0x56, 0x48, 0x65, 0x9f,
-	0x41, 0x7f, 0x30, 0xa2, 0x3c, 0x24, 0xaf, 0x41, 0x2d, 0x90, 0x9f, 0x9d, 0xc8, 0xae, 0x13, 0x80,
-	0x5c, 0x06, 0x4d, 0xd9, 0x41, 0xca, 0x07, 0x83, 0x84, 0x76, 0x95, 0xa1, 0x4c, 0x25, 0x44, 0xbc,
-	0x55, 0x58, 0x2d, 0xac, 0xd5, 0x8c, 0xf9, 0xc9, 0x8c, 0x88, 0x8b, 0x38, 0x61, 0xf1, 0xb1, 0x6f,
-	0xa3, 0xe1, 0x56, 0x0d, 0xd9, 0x20, 0x1f, 0x41, 0xd3, 0xe9, 0x9a, 0x09, 0x2d, 0x47, 0xd3, 0xd5,
-	0x36, 0x96, 0xd7, 0x65, 0x72, 0xbe, 0x1e, 0x25, 0xe7, 0xeb, 0x4f, 0x45, 0x5c, 0x31, 0x1a, 0x4e,
-	0x37, 0xd9, 0x1a, 0x14, 0x7a, 0xc8, 0x02, 0x5b, 0x7a, 0xdc, 0xaa, 0x21, 0x1b, 0x22, 0x6b, 0x18,
-	0xd0, 0xd0, 0x32, 0x99, 0xef, 0x8d, 0xd1, 0xae, 0xab, 0x46, 0x55, 0x00, 0x8f, 0x7d, 0x6f, 0x4c,
-	0xae, 0xc2, 0x7c, 0xcf, 0x36, 0x87, 0xd6, 0x88, 0x53, 0x93, 0xfa, 0x56, 0xd7, 0x93, 0xce, 0xa3,
-	0x6a, 0x34, 0x7a, 0xf6, 0x9e, 0x40, 0x77, 0x10, 0x24, 0x6b, 0xa0, 0xc7, 0x74, 0x9c, 0xda, 0xcc,
-	0x77, 0x38, 0x7a, 0x93, 0x92, 0xd1, 0x54, 0x84, 0xfb, 0x12, 0x9d, 0xa0, 0xb4, 0x1c, 0x07, 0x4f,
-	0x19, 0xc8, 0xb4, 0x50, 0x51, 0xde, 0x91, 0x68, 0xfb, 0xd7, 0x39, 0x20, 0xa9, 0xbd, 0xa1, 0x7c,
-	0xc8, 0x7c, 0x4e, 0x5f, 0xb1, 0x09, 0xef, 0x43, 0x31, 0xe5, 0x5d, 0xae, 0x64, 0xee, 0x7b, 0x24,
-	0x0a, 0xdd, 0x0a, 0x92, 0x8b, 0x48, 0x3d, 0xe0, 0x3d, 0xe5, 0x48, 0xc4, 0x27, 0x79, 0x17, 0x8a,
-	0x8e, 0x15, 0x5a, 0xb8, 0x01, 0xda, 0xc6, 0xe5, 0x53, 0xdc, 0x14, 0xce, 0x0e, 0x89, 0xdb, 0x7f,
-	0xca, 0x81, 0x7e, 0x8f, 0x86, 0x5f, 0xaa, 0xd5, 0x5c, 0x84, 0x9a, 0x22, 0x50, 0x01, 0xab, 0x16,
-	0xb9, 0x61, 0xc5, 0x3d, 0xb2, 0x8f, 0x68, 0x28, 0xb9, 0x8b, 0x8a, 0x1b, 0x21, 0xe4, 0x26, 0x50,
-	0x1c, 0x5a, 0x61, 0x1f, 0x0d, 0xa5, 0x66, 0xe0, 0xb7, 0xf0, 0x0b, 0x5f, 0xb8, 0x61, 0x9f, 0x8d,
-	0x42, 0xd3, 0xa1, 0xa1, 0xe5, 0x7a, 0xca, 0x20, 0x1a, 0x0a, 0xdd, 0x46, 0xb0, 0xfd, 0x6d, 0x20,
-	0x0f, 0x5d, 0x1e, 0x05, 0xf2, 0xd9, 0x56, 0x93, 0x91, 0xf3, 0xe7, 0xb3, 0x72, 0xfe, 0xf6, 0x6f,
-	0x72, 0xb0, 0x38, 0x21, 0xfd, 0xab, 0xda, 0xdd, 0xc2, 0xec, 0xbb, 0x7b, 0x00, 0x8b, 0xdb, 0xd4,
-	0xa3, 0x5f, 0xae, 0x57, 0x68, 0xff, 0x10, 0x96, 0x26, 0xa5, 0xfe, 0x47, 0x35, 0xd1, 0xfe, 0x55,
-	0x19, 0x96, 0x0c, 0xca, 0x43, 0x16, 0x7c, 0x65, 0xce, 0xee, 0x6d, 0x48, 0x05, 0x34, 0x93, 0x8f,
-	0x0e, 0x0f, 0xdd, 0x17, 0xca, 0x94, 0x53, 0x32, 0xf6, 0x11, 0x27, 0x6c, 0x22, 0x84, 0x06, 0x54,
-	0x4a, 0x96, 0xa9, 0xd8, 0xa7, 0x27, 0xa9, 0xe1, 0xd8, 0xea, 0x52, 0x21, 0xcb, 0x90, 0x22, 0xe4,
-	0x4d, 0x21, 0x35, 0x11, 0x85, 0x27, 0xae, 0xb8, 0x9c, 0x76, 0xc5, 0x53, 0x07, 0xaf, 0x72, 0xe2,
-	0xc1, 0xab, 0xa6, 0x0e, 0xde, 0x71, 0xff, 0x5d, 0x3b, 0x8b, 0xff, 0x5e, 0x81, 0xd8, 0x31, 0x47,
-	0xf9, 0x58, 0xec, 0xa8, 0xdb, 0x50, 0x0f, 0xe4, 0x3a, 0xf1, 0xf6, 0xa6, 0xd2, 0xb2, 0x09, 0x4c,
-	0xd0, 0x08, 0xf7, 0x3a, 0x0a, 0x99, 0xa4, 0xa9, 0x4b, 0x9a, 0x34, 0x46, 0x6e, 0xc2, 0xa2, 0x13,
-	0xb0, 0xe1, 0xce, 0x0b, 0x97, 0x87, 0xc9, 0xd8, 0xad, 0x06, 0x92, 0x66, 0x75, 0x91, 0xab, 0xd0,
-	0x8c, 0x61, 0x29, 0xb7, 0x89, 0xc4, 0x53, 0x28, 0xd9, 0x80, 0x25, 0x7e, 0xe4, 0x0e, 0x65, 0x5c,
-	0x4d, 0x89, 0x9e, 0x47, 0xea, 0xcc, 0x3e, 0x95, 0x41, 0xea, 0x71, 0x06, 0x79, 0x1b, 0x5a, 0x82,
-	0xae, 0x33, 0x18, 0xb2, 0x20, 0xdc, 0x76, 0xf9, 0xd1, 0xb7, 0x46, 0x2c, 0xb4, 0xf0, 0xde, 0xd5,
-	0x5a, 0x40, 0x39, 0x27, 0xf6, 0xaf, 0x6c, 0xc3, 0x72, 0xf6, 0xa6, 0x9f, 0xe9, 0x9a, 0xf6, 0xbb,
-	0x7c, 0x7c, 0x5c, 0xe2, 0xc4, 0x44, 0xe4, 0xa9, 0xc7, 0x92, 0xdd, 0xfb, 0x19, 0xc9, 0xee, 0xb5,
-	0xd3, 0xec, 0xf3, 0xbf, 0x30, 0xdb, 0xed, 0x00, 0x5e, 0x8d, 0x54, 0xa2, 0x8a, 0x46, 0x7e, 0x96,
-	0x2c, 0x0d, 0x04, 0xb3, 0x6c, 0xb7, 0xff, 0x5a, 0x86, 0x73, 0x6a, 0xa1, 0xc9, 0x2e, 0x7c, 0xad,
-	0x15, 0xf7, 0x19, 0x68, 0xe2, 0x24, 0x47, 0xca, 0x29, 0xa3, 0x72, 0xce, 0x90, 0x1f, 0x83, 0xe0,
-	0x96, 0x6d, 0xf2, 0x1e, 0x2c, 0x87, 0x56, 0xd0, 0xa3, 0xa1, 0x39, 0x1d, 0x3d, 0xa5, 0x63, 0x59,
-	0x92, 0xbd, 0x5b, 0x93, 0x75, 0x33, 0x0b, 0xce, 0x27, 0xb7, 0x59, 0x75, 0xd2, 0xcd, 0xd0, 0xe2,
-	0x47, 0xbc, 0x55, 0x3d, 0x25, 0x5b, 0xcf, 0x32, 0x5f, 0xe3, 0x5c, 0x2c, 0x29, 0xa5, 0x55, 0xac,
-	0x00, 0x2a, 0xc1, 0x8e, 0x89, 0xf7, 0x0b, 0x79, 0x45, 0x8c, 0xfc, 0x8a, 0xb3, 0x2f, 0xee, 0x19,
-	0x57, 0x61, 0x3e, 0x64, 0xf1, 0x04, 0x52, 0xd7, 0x90, 0x46, 0xc8, 0x94, 0x34, 0xa4, 0x4b, 0x9b,
-	0x9a, 0x36, 0x65, 0x6a, 0x6f, 0x40, 0x53, 0x69, 0x20, 0x2a, 0x26, 0xd6, 0xe5, 0x6e, 0x49, 0x74,
-	0x5b, 0x96, 0x14, 0xd3, 0x1e, 0xb0, 0xf1, 0x0a, 0x0f, 0xd8, 0x9c, 0xc1, 0x03, 0xce, 0xcf, 0xee,
-	0x01, 0xf5, 0xb3, 0x78, 0xc0, 0x85, 0x33, 0x79, 0x40, 0x72, 0x8a, 0x07, 0x5c, 0x07, 0x22, 0xf0,
-	0x29, 0x5f, 0xb7, 0x88, 0x1c, 0x19, 0x3d, 0xed, 0x5f, 0x14, 0x60, 0x61, 0x22, 0xe0, 0x7d, 0xad,
-	0xcf, 0x98, 0x03, 0xad, 0x89, 0x60, 0x9f, 0x36, 0xf1, 0xf2, 0x29, 0xd5, 0xff, 0x4c, 0x4f, 0x63,
-	0x2c, 0xa7, 0x83, 0xfb, 0x69, 0x46, 0x5e, 0x99, 0xcd, 0xc8, 0xab, 0xaf, 0x32, 0xf2, 0xda, 0xa4,
-	0x91, 0xb7, 0xff, 0x90, 0x8b, 0x9d, 0xe0, 0x57, 0x92, 0xec, 0x91, 0xdb, 0x13, 0x97, 0x9a, 0xab,
-	0xaf, 0x4e, 0x97, 0x50, 0x6f, 0x32, 0xfb, 0xbd, 0x0b, 0xcb, 0xf7, 0x68, 0x18, 0x2d, 0x55, 0x18,
-	0xc0, 0x6c, 0x99, 0xa2, 0xb4, 0xbd, 0x7c, 0x64, 0x7b, 0xed, 0xef, 0x81, 0x96, 0x2a, 0x4f, 0x91,
-	0x16, 0x54, 0xf0, 0x65, 0xa8, 0xb3, 0xad, 0x6a, 0x7a, 0x51, 0x93, 0xbc, 0x9f, 0x54, 0xda, 0xf2,
-	0xb8, 0xd7, 0x17, 0xb3, 0xd3, 0xf4, 0xc9, 0x22, 0x5b, 0xfb, 0x97, 0x39, 0x28, 0x2b, 0xd9, 0x97,
-	0x41, 0xa3, 0x7e, 0x18, 0xb8, 0x54, 0x3e, 0x0d, 0x48, 0xf9, 0xa0, 0xa0, 0xdd, 0xd1, 0x40, 0xdc,
-	0x84, 0xe2, 0x9a, 0x8d, 0x79, 0x18, 0xb0, 0x01, 0xce, 0xb3, 0x68, 0x34, 0x62, 0xf4, 0x6e, 0xc0,
-	0x06, 0xe4, 0x0a, 0xd4, 0x13, 0xb2, 0x90, 0xa1, 0x46, 0x8b, 0x86, 0x16, 0x63, 0x07, 0x4c, 0x18,
-	0xb1, 0xc7, 0x7a, 0x26, 0xa6, 0x7c, 0x32, 0x75, 0xad, 0x78, 0xac, 0xb7, 0x27, 0xb2, 0x3e, 0xd5,
-	0x95, 0xaa, 0x82, 0x8a, 0x2e, 0x61, 0x2c, 0xed, 0x5b, 0x50, 0x7f, 0x40, 0xc7, 0x98, 0xec, 0xed,
-	0x59, 0x6e, 0x30, 0x6b, 0x26, 0xd2, 0xfe, 0x67, 0x0e, 0x00, 0xb9, 0x50, 0x93, 0xe4, 0x12, 0xd4,
-	0xba, 0x8c, 0x79, 0x26, 0xee, 0xad, 0x60, 0xae, 0xde, 0x9f, 0x33, 0xaa, 0x02, 0xda, 0xb6, 0x42,
-	0x8b, 0x5c, 0x84, 0xaa, 0xeb, 0x87, 0xb2, 0x57, 0x88, 0x29, 0xdd, 0x9f, 0x33, 0x2a, 0xae, 0x1f,
-	0x62, 0xe7, 0x25, 0xa8, 0x79, 0xcc, 0xef, 0xc9, 0x5e, 0xac, 0x87, 0x0a, 0x5e, 0x01, 0x61, 0xf7,
-	0x65, 0x80, 0x43, 0x8f, 0x59, 0x8a, 0x5b, 0xac, 0x2c, 0x7f, 0x7f, 0xce, 0xa8, 0x21, 0x86, 0x04,
-	0x57, 0x40, 0x73, 0xd8, 0xa8, 0xeb, 0x51, 0x49, 0x21, 0x16, 0x98, 0xbb, 0x3f, 0x67, 0x80, 0x04,
-	0x23, 0x12, 0x1e, 0x06, 0x6e, 0x34, 0x08, 0xd6, 0x7b, 0x05, 0x89, 0x04, 0xa3, 0x61, 0xba, 0xe3,
-	0x90, 0x72, 0x49, 0x21, 0xce, 0x5f, 0x5d, 0x0c, 0x83, 0x98, 0x20, 0xd8, 0x2c, 0x4b, 0xcb, 0x6d,
-	0xff, 0xbd, 0xa8, 0xcc, 0x47, 0x3e, 0x02, 0x9d, 0x62, 0x3e, 0x51, 0xa9, 0x2e, 0x9f, 0x2a, 0xd5,
-	0xbd, 0x01, 0x4d, 0x97, 0x9b, 0xc3, 0xc0, 0x1d, 0x58, 0xc1, 0xd8, 0x14, 0xaa, 0x2e, 0xc8, 0x08,
-	0xe0, 0xf2, 0x3d, 0x09, 0x3e, 0xa0, 0x63, 0xb2, 0x0a, 0x9a, 0x43, 0xb9, 0x1d, 0xb8, 0x43, 0x74,
-	0xcf, 0x72, 0x3b, 0xd3, 0x10, 0xb9, 0x0d, 0x35, 0x31, 0x1b, 0xf9, 0x42, 0x59, 0xc2, 0x53, 0x79,
-	0x29, 0xd3, 0x38, 0xc5, 0xdc, 0x0f, 0xc6, 0x43, 0x6a, 0x54, 0x1d, 0xf5, 0x45, 0x36, 0x41, 0x13,
-	0x6c, 0xa6, 0x7a, 0xc4, 0x94, 0x6e, 0x2c, 0xfb, 0x4c, 0xa7, 0x6d, 0xc3, 0x00, 0xc1, 0x25, 0x5f,
-	0x2d, 0xc9, 0x36, 0xd4, 0xe5, 0x63, 0x8e, 0x12, 0x52, 0x99, 0x55, 0x88, 0x7c, 0x03, 0x52, 0x52,
-	0x96, 0xa1, 0x6c, 0x89, 0xb0, 0xb7, 0xad, 0x6a, 0x3a, 0xaa, 0x45, 0xde, 0x87, 0x92, 0xac, 0xcc,
-	0xd7, 0x70, 0x65, 0x97, 0x4f, 0x2e, 0x31, 0x4b, 0x37, 0x20, 0xa9, 0xc9, 0xa7, 0x50, 0xa7, 0x1e,
-	0xc5, 0x02, 0x3d, 0xea, 0x05, 0x66, 0xd1, 0x8b, 0xa6, 0x58, 0x50, 0x35, 0xdb, 0xd0, 0x70, 0xe8,
-	0xa1, 0x35, 0xf2, 0x42, 0x53, 0x1a, 0xbd, 0x76, 0x4a, 0xf1, 0x25, 0xb1, 0x7f, 0xa3, 0xae, 0xb8,
-	0x10, 0xc2, 0xf7, 0x63, 0x6e, 0x3a, 0x63, 0xdf, 0x1a, 0xb8, 0xb6, 0xba, 0xe4, 0xd4, 0x5c, 0xbe,
-	0x2d, 0x01, 0xb2, 0x06, 0xba, 0xb0, 0x81, 0x38, 0x71, 0x12, 0x56, 0x20, 0x73, 0x89, 0xa6, 0xcb,
-	0xe3, 0xa4, 0xe8, 0x01, 0x1d, 0xb7, 0xff, 0x9c, 0x03, 0x7d, 0xfa, 0xd5, 0x31, 0x36, 0xab, 0x5c,
-	0xca, 0xac, 0xa6, 0x0c, 0x26, 0x7f, 0xdc, 0x60, 0x12, 0x55, 0x17, 0x26, 0x54, 0xfd, 0x01, 0x94,
-	0xd1, 0x5e, 0xa3, 0x57, 0x96, 0x53, 0xca, 0xf9, 0xd1, 0xab, 0xa7, 0xa4, 0x27, 0x37, 0x61, 0x49,
-	0x16, 0xe4, 0xa2, 0x95, 0x9a, 0xd8, 0x81, 0xd6, 0x58, 0x35, 0x88, 0xec, 0x53, 0x6b, 0x46, 0xfe,
-	0x76, 0x13, 0xea, 0x98, 0x23, 0x28, 0xb7, 0xdd, 0x7e, 0x06, 0x0d, 0xd5, 0x56, 0x41, 0x28, 0x0a,
-	0x33, 0xb9, 0x7f, 0x2b, 0xcc, 0xe4, 0x93, 0x9a, 0xc2, 0x8f, 0x73, 0xa0, 0x3d, 0xe2, 0xbd, 0x3d,
-	0xc6, 0x51, 0x97, 0xc2, 0x7f, 0x46, 0xef, 0x7b, 0x29, 0xdd, 0x69, 0x0a, 0xc3, 0xcc, 0x6e, 0x09,
-	0x4a, 0x03, 0xde, 0xeb, 0x6c, 0xa3, 0x98, 0xba, 0x21, 0x1b, 0x98, 0xef, 0xf1, 0xde, 0xbd, 0x80,
-	0x8d, 0x86, 0x51, 0xe9, 0x2b, 0x6a, 0x8b, 0xa8, 0x93, 0xd4, 0xdb, 0x8b, 0xe8, 0x91, 0x13, 0xa0,
-	0x7d, 0x07, 0xe6, 0xd5, 0xab, 0x5c, 0x3c, 0x8b, 0xac, 0x9d, 0x13, 0xd1, 0x5a, 0xf5, 0xab, 0x05,
-	0xc4, 0xed, 0xeb, 0x3f, 0x82, 0x7a, 0x7a, 0xb5, 0x44, 0x83, 0xca, 0xfe, 0xc8, 0xb6, 0x29, 0xe7,
-	0xfa, 0x1c, 0x99, 0x07, 0x6d, 0x97, 0x85, 0xe6, 0xfe, 0x68, 0x28, 0xae, 0x9a, 0x7a, 0x8e, 0x2c,
-	0x40, 0x63, 0x97, 0x99, 0x7b, 0x34, 0x18, 0xb8, 0x9c, 0xbb, 0xcc, 0xd7, 0xf3, 0xa4, 0x0a, 0xc5,
-	0xbb, 0x96, 0xeb, 0xe9, 0x05, 0xb2, 0x04, 0xf3, 0x78, 0xe6, 0x68, 0x48, 0x03, 0x73, 0x47, 0xe4,
-	0x46, 0xfa, 0xcf, 0x0a, 0xe4, 0x12, 0xb4, 0xd4, 0x5e, 0x98, 0x8f, 0xbb, 0xdf, 0xa7, 0x76, 0x68,
-	0x0a, 0x91, 0x77, 0xd9, 0xc8, 0x77, 0xf4, 0x9f, 0x17, 0xae, 0xbf, 0x80, 0xc5, 0x8c, 0x77, 0x10,
-	0x42, 0xa0, 0xb9, 0x79, 0x67, 0xeb, 0xc1, 0x93, 0x3d, 0xb3, 0xb3, 0xdb, 0x39, 0xe8, 0xdc, 0x79,
-	0xa8, 0xcf, 0x91, 0x25, 0xd0, 0x15, 0xb6, 0xf3, 0x6c, 0x67, 0xeb, 0xc9, 0x41, 0x67, 0xf7, 0x9e,
-	0x9e, 0x4b, 0x51, 0xee, 0x3f, 0xd9, 0xda, 0xda, 0xd9, 0xdf, 0xd7, 0xf3, 0x62, 0xde, 0x0a, 0xbb,
-	0x7b, 0xa7, 0xf3, 0x50, 0x2f, 0xa4, 0x88, 0x0e, 0x3a, 0x8f, 0x76, 0x1e, 0x3f, 0x39, 0xd0, 0x8b,
-	0xd7, 0x9f, 0xc6, 0x77, 0xdc, 0xc9, 0xa1, 0x35, 0xa8, 0x24, 0x63, 0x36, 0xa0, 0x96, 0x1e, 0x4c,
-	0x68, 0x27, 0x1e, 0x45, 0xac, 0x5c, 0x8a, 0xd7, 0xa0, 0x92, 0xc8, 0x7d, 0x26, 0xce, 0xd3, 0xd4,
-	0x53, 0x3a, 0x40, 0x79, 0x3f, 0x0c, 0x98, 0xdf, 0xd3, 0xe7, 0x50, 0x06, 0x95, 0xda, 0x43, 0x81,
-	0x9b, 0x42, 0x15, 0xd4, 0xd1, 0xf3, 0xa4, 0x09, 0xb0, 0xf3, 0x9c, 0xfa, 0xe1, 0xc8, 0xf2, 0xbc,
-	0xb1, 0x5e, 0x10, 0xed, 0xad, 0x11, 0x0f, 0xd9, 0xc0, 0x7d, 0x49, 0x1d, 0xbd, 0x78, 0xfd, 0x1f,
-	0x39, 0xa8, 0x46, 0x3e, 0x45, 0x8c, 0xbe, 0xcb, 0x7c, 0xaa, 0xcf, 0x89, 0xaf, 0x4d, 0xc6, 0x3c,
-	0x3d, 0x27, 0xbe, 0x3a, 0x7e, 0xf8, 0x81, 0x9e, 0x27, 0x35, 0x28, 0x75, 0xfc, 0xf0, 0x9d, 0x5b,
-	0x7a, 0x41, 0x7d, 0xbe, 0xbb, 0xa1, 0x17, 0xd5, 0xe7, 0xad, 0xf7, 0xf4, 0x92, 0xf8, 0xbc, 0x2b,
-	0xc2, 0x9b, 0x0e, 0x62, 0x72, 0xdb, 0x18, 0xc7, 0x74, 0x4d, 0x4d, 0xd4, 0xf5, 0x7b, 0xfa, 0x92,
-	0x98, 0xdb, 0x53, 0x2b, 0xd8, 0xea, 0x5b, 0x81, 0x7e, 0x4e, 0xd0, 0xdf, 0x09, 0x02, 0x6b, 0xac,
-	0x2f, 0x8b, 0x51, 0x3e, 0xe3, 0xcc, 0xd7, 0xcf, 0x13, 0x1d, 0xea, 0x9b, 0xae, 0x6f, 0x05, 0xe3,
-	0xa7, 0xd4, 0x0e, 0x59, 0xa0, 0x3b, 0x42, 0xf3, 0x28, 0x56, 0x01, 0x54, 0x58, 0x0c, 0x02, 0xef,
-	0xdc, 0x52, 0xd0, 0x21, 0x6e, 0xc6, 0x24, 0xd6, 0x23, 0xe7, 0x60, 0x61, 0x7f, 0x68, 0x05, 0x9c,
-	0xa6, 0xb9, 0xfb, 0xd7, 0x9f, 0x02, 0x24, 0x2e, 0x58, 0x0c, 0x87, 0x2d, 0x79, 0x7f, 0x70, 0xf4,
-	0x39, 0x94, 0x1e, 0x23, 0x62, 0xd6, 0xb9, 0x18, 0xda, 0x0e, 0xd8, 0x70, 0x28, 0xa0, 0x7c, 0xcc,
-	0x87, 0x10, 0x75, 0xf4, 0xc2, 0xc6, 0x1f, 0x4b, 0xb0, 0xf8, 0x08, 0x0f, 0xbe, 0x34, 0xbe, 0x7d,
-	0x1a, 0x3c, 0x77, 0x6d, 0x4a, 0x6c, 0xa8, 0xa7, 0x9f, 0x44, 0x48, 0x76, 0x19, 0x20, 0xe3, 0xd5,
-	0x64, 0xe5, 0xad, 0x57, 0x55, 0x57, 0xd5, 0x21, 0x6b, 0xcf, 0x91, 0xef, 0x42, 0x2d, 0x2e, 0x9f,
-	0x93, 0xec, 0xbf, 0x33, 0xa6, 0xcb, 0xeb, 0x67, 0x11, 0xdf, 0x05, 0x2d, 0x55, 0x73, 0x26, 0xd9,
-	0x9c, 0xc7, 0x6b, 0xde, 0x2b, 0x6b, 0xaf, 0x26, 0x8c, 0xc7, 0xa0, 0x50, 0x4f, 0x97, 0x73, 0x4f,
-	0xd0, 0x53, 0x46, 0x1d, 0x79, 0xe5, 0xda, 0x0c, 0x94, 0xf1, 0x30, 0x7d, 0x68, 0x4c, 0x24, 0xea,
-	0xe4, 0xda, 0xcc, 0xb5, 0xcf, 0x95, 0xeb, 0xb3, 0x90, 0xc6, 0x23, 0xf5, 0x00, 0x92, 0xbc, 0x9f,
-	0xbc, 0x7d, 0xd2, 0xa6, 0x64, 0x5c, 0x0c, 0xce, 0x38, 0xd0, 0x1e, 0x94, 0x30, 0x1e, 0x91, 0xec,
-	0xc8, 0x93, 0x8e, 0x5d, 0x2b, 0xed, 0xd3, 0x48, 0x22, 0x89, 0x9b, 0x1f, 0x7e, 0xfe, 0x8d, 0x9e,
-	0x1b, 0xf6, 0x47, 0xdd, 0x75, 0x9b, 0x0d, 0x6e, 0xbc, 0x74, 0x3d, 0xcf, 0x7d, 0x19, 0x52, 0xbb,
-	0x7f, 0x43, 0x32, 0xff, 0xbf, 0x64, 0xbb, 0x61, 0xb3, 0x40, 0xfd, 0xd7, 0x76, 0x43, 0x22, 0xc3,
-	0x6e, 0xb7, 0x8c, 0xed, 0x77, 0xff, 0x15, 0x00, 0x00, 0xff, 0xff, 0x19, 0x88, 0x9a, 0xae, 0x1a,
-	0x27, 0x00, 0x00,
+	// 5036 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x7c, 0x4d, 0x6c, 0x1c, 0x47,
+	0x76, 0xb0, 0x66, 0x86, 0x43, 0xce, 0xbc, 0xf9, 0x61, 0xb3, 0xf8, 0xd7, 0x1a, 0x59, 0x16, 0x3d,
+	0x5e, 0xcb, 0x94, 0xec, 0xa5, 0x64, 0xda, 0x92, 0x6d, 0x61, 0xed, 0x5d, 0xf1, 0x47, 0xd2, 0xac,
+	0x44, 0x91, 0x5f, 0x93, 0xd2, 0xe7, 0x2c, 0x76, 0xd3, 0x68, 0x76, 0x17, 0x87, 0xbd, 0xec, 0xe9,
+	0x6a, 0x77, 0xf5, 0x50, 0x1a, 0x03, 0x09, 0x16, 0x09, 0x90, 0xec, 0x25, 0x48, 0x0e, 0x0b, 0xe4,
+	0x14, 0x20, 0xa7, 0x00, 0xb9, 0x05, 0xc8, 0x25, 0xc8, 0x2d, 0x87, 0x5c, 0x16, 0xb9, 0xe4, 0x1c,
+	0xe4, 0x1c, 0xe4, 0x94, 0x1c, 0x02, 0xe4, 0x1a, 0xd4, 0xab, 0xea, 0x9f, 0x99, 0x69, 0x92, 0x43,
+	0xdb, 0xf0, 0x66, 0x73, 0xeb, 0x7a, 0xef, 0xd5, 0xab, 0x9f, 0xf7, 0xea, 0xbd, 0x57, 0xaf, 0xde,
+	0x0c, 0xd4, 0x0f, 0x2d, 0xfb, 0xa4, 0x1f, 0xac, 0x05, 0x21, 0x8b, 0x18, 0x99, 0xef, 0xb9, 0xde,
+	0x69, 0x9f, 0xcb, 0xd6, 0x9a, 0x44, 0xb5, 0xde, 0xe8, 0x32, 0xd6, 0xf5, 0xe8, 0x1d, 0x04, 0x1e,
+	0xf6, 0x8f, 0xee, 0xf0, 0x28, 0xec, 0xdb, 0x91, 0x24, 0x6a, 0xff, 0x5b, 0x01, 0xaa, 0x1d, 0xdf,
+	0xa1, 0xaf, 0x3b, 0xfe, 0x11, 0x23, 0xd7, 0x01, 0x8e, 0x5c, 0xea, 0x39, 0xa6, 0x6f, 0xf5, 0xa8,
+	0x5e, 0x58, 0x29, 0xac, 0x56, 0x8d, 0x2a, 0x42, 0x9e, 0x5b, 0x3d, 0x2a, 0xd0, 0xae, 0xa0, 0x95,
+	0xe8, 0xa2, 0x44, 0x23, 0x64, 0x18, 0x1d, 0x0d, 0x02, 0xaa, 0x97, 0x32, 0xe8, 0x83, 0x41, 0x40,
+	0xc9, 0x06, 0x4c, 0x07, 0x56, 0x68, 0xf5, 0xb8, 0x3e, 0xb5, 0x52, 0x5a, 0xad, 0xad, 0xdf, 0x5e,
+	0xcb, 0x99, 0xee, 0x5a, 0x32, 0x99, 0xb5, 0x3d, 0x24, 0xde, 0xf6, 0xa3, 0x70, 0x60, 0xa8, 0x9e,
+	0xad, 0x4f, 0xa1, 0x96, 0x01, 0x13, 0x0d, 0x4a, 0x27, 0x74, 0xa0, 0x26, 0x2a, 0x3e, 0xc9, 0x02,
+	0x94, 0x4f, 0x2d, 0xaf, 0x1f, 0xcf, 0x4e, 0x36, 0x1e, 0x14, 0x3f, 0x29, 0xb4, 0xff, 0xae, 0x06,
+	0x0b, 0x9b, 0xcc, 0xf3, 0xa8, 0x1d, 0xb9, 0xcc, 0xdf, 0xc0, 0xd1, 0x70, 0xd1, 0x4d, 0x28, 0xba,
+	0x8e, 0xe2, 0x51, 0x74, 0x1d, 0xf2, 0x18, 0x80, 0x47, 0x56, 0x44, 0x4d, 0x9b, 0x39, 0x92, 0x4f,
+	0x73, 0x7d, 0x35, 0x77, 0xae, 0x92, 0xc9, 0x81, 0xc5, 0x4f, 0xf6, 0x45, 0x87, 0x4d, 0xe6, 0x50,
+	0xa3, 0xca, 0xe3, 0x4f, 0xd2, 0x86, 0x3a, 0x0d, 0x43, 0x16, 0xee, 0x50, 0xce, 0xad, 0x6e, 0xbc,
+	0x23, 0x43, 0x30, 0xb1, 0x67, 0x3c, 0xb2, 0xc2, 0xc8, 0x8c, 0xdc, 0x1e, 0xd5, 0xa7, 0x56, 0x0a,
+	0xab, 0x25, 0x64, 0x11, 0x46, 0x07, 0x6e, 0x8f, 0x92, 0xab, 0x50, 0xa1, 0xbe, 0x23, 0x91, 0x65,
+	0x44, 0xce, 0x50, 0xdf, 0x41, 0x54, 0x0b, 0x2a, 0x41, 0xc8, 0xba, 0x21, 0xe5, 0x5c, 0x9f, 0x5e,
+	0x29, 0xac, 0x96, 0x8d, 0xa4, 0x4d, 0xde, 0x86, 0x86, 0x9d, 0x2c, 0xd5, 0x74, 0x1d, 0x7d, 0x06,
+	0xfb, 0xd6, 0x53, 0x60, 0xc7, 0x21, 0xcb, 0x30, 0xe3, 0x1c, 0x4a, 0x51, 0x56, 0x70, 0x66, 0xd3,
+	0xce, 0x21, 0xca, 0xf1, 0x5d, 0x98, 0xcd, 0xf4, 0x46, 0x82, 0x2a, 0x12, 0x34, 0x53, 0x30, 0x12,
+	0x7e, 0x06, 0xd3, 0xdc, 0x3e, 0xa6, 0x3d, 0x4b, 0x87, 0x95, 0xc2, 0x6a, 0x6d, 0xfd, 0x9d, 0xdc,
+	0x5d, 0x4a, 0x37, 0x7d, 0x1f, 0x89, 0x0d, 0xd5, 0x09, 0xd7, 0x7e, 0x6c, 0x85, 0x0e, 0x37, 0xfd,
+	0x7e, 0x4f, 0xaf, 0xe1, 0x1a, 0xaa, 0x12, 0xf2, 0xbc, 0xdf, 0x23, 0x06, 0xcc, 0xd9, 0xcc, 0xe7,
+	0x2e, 0x8f, 0xa8, 0x6f, 0x0f, 0x4c, 0x8f, 0x9e, 0x52, 0x4f, 0xaf, 0xa3, 0x38, 0xce, 0x1a, 0x28,
+	0xa1, 0x7e, 0x26, 0x88, 0x0d, 0xcd, 0x1e, 0x81, 0x90, 0x17, 0x30, 0x17, 0x58, 0x61, 0xe4, 0xe2,
+	0xca, 0x64, 0x37, 0xae, 0x37, 0x50, 0x1d, 0xf3, 0x45, 0xbc, 0x17, 0x53, 0xa7, 0x0a, 0x63, 0x68,
+	0xc1, 0x30, 0x90, 0x93, 0x5b, 0xa0, 0x49, 0x7a, 0x94, 0x14, 0x8f, 0xac, 0x5e, 0xa0, 0x37, 0x57,
+	0x0a, 0xab, 0x53, 0xc6, 0xac, 0x84, 0x1f, 0xc4, 0x60, 0x42, 0x60, 0x8a, 0xbb, 0x5f, 0x51, 0x7d,
+	0x16, 0x25, 0x82, 0xdf, 0xe4, 0x1a, 0x54, 0x8f, 0x2d, 0x6e, 0xe2, 0x51, 0xd1, 0xb5, 0x95, 0xc2,
+	0x6a, 0xc5, 0xa8, 0x1c, 0x5b, 0x1c, 0x8f, 0x02, 0xf9, 0x21, 0xd4, 0xe4, 0xa9, 0x72, 0xfd, 0x23,
+	0xc6, 0xf5, 0x39, 0x9c, 0xec, 0x9b, 0xe7, 0x9f, 0x1d, 0x03, 0xdc, 0xf8, 0x93, 0x8b, 0x6d, 0xf6,
+	0x98, 0xe5, 0x98, 0xa8, 0x98, 0x3a, 0x91, 0xc7, 0x52, 0x40, 0x50, 0x69, 0xc9, 0x03, 0xb8, 0xaa,
+	0xe6, 0x1e, 0x1c, 0x0f, 0xb8, 0x6b, 0x5b, 0x5e, 0x66, 0x11, 0xf3, 0xb8, 0x88, 0x65, 0x49, 0xb0,
+	0xa7, 0xf0, 0xe9, 0x62, 0x42, 0x98, 0xb7, 0x8f, 0x2d, 0xdf, 0xa7, 0x9e, 0x69, 0x1f, 0x53, 0xfb,
+	0x24, 0x60, 0xae, 0x1f, 0x71, 0x7d, 0x01, 0xe7, 0xf8, 0xf0, 0x02, 0x6d, 0x48, 0x77, 0x74, 0x6d,
+	0x53, 0x32, 0xd9, 0x4c, 0x79, 0xc8, 0x63, 0x4f, 0xec, 0x31, 0x04, 0x79, 0x0c, 0x35, 0xef, 0xae,
+	0xc9, 0x69, 0xb7, 0x47, 0xc5, 0x58, 0x8b, 0x38, 0xd6, 0xcd, 0xdc, 0xb1, 0xf6, 0x25, 0x51, 0x46,
+	0x74, 0xe0, 0xdd, 0x55, 0x40, 0x2e, 0x76, 0x3d, 0x64, 0xaf, 0x4c, 0x9b, 0xf5, 0xfd, 0x48, 0x5f,
+	0x42, 0x71, 0x54, 0x42, 0xf6, 0x6a, 0x53, 0xb4, 0xc9, 0xef, 0x00, 0x04, 0x21, 0x0b, 0x68, 0x18,
+	0xb9, 0x94, 0xeb, 0xcb, 0x38, 0xc8, 0xa7, 0x93, 0x2f, 0x68, 0x2f, 0xe9, 0x2b, 0x17, 0x92, 0x61,
+	0x46, 0x6e, 0x40, 0x2d, 0xa3, 0x2c, 0xba, 0x8e, 0x02, 0x81, 0x54, 0x4f, 0xc8, 0x3b, 0xd0, 0xf4,
+	0xfb, 0x3d, 0x33, 0xd1, 0x32, 0xae, 0x5f, 0xc5, 0xd9, 0x35, 0xfc, 0x7e, 0x2f, 0xd1, 0x47, 0x4e,
+	0x74, 0x98, 0xb1, 0x3c, 0xd7, 0xe2, 0x94, 0xeb, 0xad, 0x95, 0xd2, 0x6a, 0xd5, 0x88, 0x9b, 0xe4,
+	0x09, 0x34, 0xf1, 0x18, 0x99, 0x6a, 0xfb, 0xb8, 0x7e, 0x0d, 0x17, 0xf0, 0x56, 0xfe, 0x2e, 0x09,
+	0x52, 0x25, 0x01, 0xa3, 0xc1, 0x33, 0x2d, 0xde, 0xda, 0x86, 0xe5, 0x33, 0x64, 0x73, 0x19, 0xdb,
+	0xdb, 0xfa, 0x0c, 0x66, 0x47, 0x76, 0xe4, 0x52, 0xa6, 0xfb, 0x97, 0x45, 0x98, 0xcf, 0x39, 0x88,
+	0xe4, 0x2d, 0xa8, 0xa7, 0xa7, 0x59, 0xd9, 0xf0, 0x92, 0x51, 0x4b, 0x60, 0x1d, 0x47, 0xec, 0x65,
+	0x4a, 0x92, 0x71, 0x5b, 0x8d, 0x04, 0x8a, 0x96, 0x6c, 0xcc, 0x60, 0x96, 0x72, 0x0c, 0xe6, 0x2e,
+	0xcc, 0x2a, 0xb5, 0x4b, 0x4c, 0xc7, 0xd4, 0xa5, 0xb4, 0xaf, 0xc9, 0xb3, 0x20, 0x9e, 0xd8, 0x82,
+	0x72, 0xc6, 0x16, 0x0c, 0x9f, 0xd6, 0xe9, 0x91, 0xd3, 0xda, 0xfe, 0xeb, 0x29, 0x98, 0x1b, 0x63,
+	0x2c, 0x3a, 0xc5, 0x33, 0x4b, 0xb6, 0xa1, 0xaa, 0x20, 0x1d, 0x67, 0x7c, 0x75, 0xc5, 0x9c, 0xd5,
+	0x8d, 0x6e, 0x66, 0x69, 0x7c, 0x33, 0xdf, 0x84, 0x9a, 0x50, 0x4c, 0x76, 0x64, 0x86, 0xec, 0x15,
+	0x8f, 0xbd, 0x95, 0xdf, 0xef, 0xed, 0x1e, 0x19, 0xec, 0x15, 0x27, 0x0f, 0x60, 0xe6, 0xd0, 0xf5,
+	0x3d, 0xd6, 0xe5, 0x7a, 0x19, 0x37, 0x66, 0x25, 0x77, 0x63, 0x1e, 0x89, 0x80, 0x62, 0x03, 0x09,
+	0x8d, 0xb8, 0x03, 0xf9, 0x1c, 0xd0, 0x73, 0x72, 0xec, 0x3d, 0x3d, 0x61, 0xef, 0xb4, 0x8b, 0xe8,
+	0xef, 0x50, 0x2f, 0xb2, 0xb0, 0xff, 0xcc, 0xa4, 0xfd, 0x93, 0x2e, 0x89, 0x2c, 0x2a, 0x19, 0x59,
+	0x5c, 0x85, 0x4a, 0x37, 0x64, 0xfd, 0x40, 0x6c, 0x47, 0x55, 0x7a, 0x5f, 0x6c, 0x77, 0x1c, 0xe1,
+	0x7d, 0x25, 0x3f, 0xea, 0xa0, 0xf3, 0xab, 0x18, 0x49, 0x9b, 0xcc, 0x43, 0xd9, 0xe5, 0xa6, 0x77,
+	0x17, 0x5d, 0x5a, 0xc5, 0x98, 0x72, 0xf9, 0xb3, 0xbb, 0xc2, 0x6c, 0x49, 0x33, 0x7e, 0xe4, 0x7a,
+	0x94, 0xeb, 0xf5, 0x8b, 0x15, 0x07, 0xad, 0xf9, 0x23, 0x41, 0xad, 0xcc, 0x39, 0x7e, 0x93, 0x55,
+	0xe1, 0x6b, 0x38, 0x55, 0x2a, 0x28, 0x75, 0xba, 0x21, 0xdd, 0xb3, 0x80, 0x4b, 0xad, 0x10, 0x4a,
+	0xdd, 0xfe, 0xa3, 0x02, 0xcc, 0x8d, 0xf1, 0x12, 0x8b, 0x3a, 0xec, 0xbb, 0x9e, 0x93, 0x6a, 0xca,
+	0x0c, 0xb6, 0xa5, 0x9e, 0xc8, 0x39, 0x9e, 0xd2, 0x90, 0xbb, 0xcc, 0x8f, 0xf5, 0x04, 0x81, 0x2f,
+	0x25, 0x8c, 0x7c, 0x00, 0x65, 0xb9, 0x84, 0x12, 0x2e, 0xe1, 0x5a, 0x7e, 0x64, 0x24, 0xf7, 0x57,
+	0x52, 0xb6, 0xff, 0xa2, 0x0a, 0xf0, 0x7f, 0x3b, 0xe0, 0x22, 0x30, 0x85, 0x82, 0x98, 0xc1, 0x11,
+	0xf1, 0x3b, 0x37, 0x28, 0xa8, 0xe4, 0x07, 0x05, 0x5f, 0x00, 0xc9, 0x1c, 0xd0, 0xd8, 0xb8, 0x54,
+	0x71, 0x83, 0x6f, 0x4d, 0xec, 0x75, 0x8c, 0x39, 0x7b, 0x04, 0x9a, 0xaa, 0x35, 0x64, 0xd4, 0xfa,
+	0x1d, 0x68, 0x4a, 0x96, 0x89, 0x9c, 0x6b, 0xd2, 0x26, 0x4a, 0x68, 0x2c, 0xe8, 0x55, 0xd0, 0x14,
+	0x59, 0xc8, 0x58, 0x64, 0x06, 0x56, 0x74, 0x8c, 0xe1, 0x57, 0xd5, 0x50, 0xdd, 0x0d, 0xc6, 0xa2,
+	0x3d, 0x2b, 0x3a, 0x26, 0x77, 0x61, 0x41, 0x86, 0x74, 0x66, 0x44, 0x7b, 0x81, 0x27, 0x44, 0xc9,
+	0x7c, 0x6f, 0x80, 0x6a, 0x59, 0x31, 0x88, 0xc4, 0x1d, 0x28, 0xd4, 0xae, 0xef, 0x0d, 0x84, 0xb1,
+	0x91, 0x07, 0x1f, 0xef, 0x0a, 0x5c, 0x6f, 0xa2, 0x03, 0xab, 0x49, 0x98, 0xb8, 0x2d, 0x70, 0xf2,
+	0x3e, 0x10, 0xee, 0x5b, 0x01, 0x3f, 0x66, 0x91, 0xc9, 0x83, 0x90, 0x5a, 0x8e, 0xd9, 0xe3, 0x2a,
+	0x6c, 0xd2, 0x62, 0xcc, 0x3e, 0x22, 0x76, 0x38, 0x31, 0x40, 0x73, 0xac, 0xc8, 0xca, 0x9c, 0x0c,
+	0xae, 0x6b, 0xb8, 0x7f, 0xef, 0xe6, 0xee, 0xdf, 0x96, 0x22, 0xce, 0xec, 0xde, 0xac, 0x33, 0x04,
+	0xe3, 0x64, 0x1d, 0x16, 0xfb, 0xbe, 0xc7, 0x6c, 0x2b, 0xa2, 0x8e, 0x99, 0xda, 0x57, 0x19, 0x83,
+	0x95, 0x8c, 0xf9, 0x04, 0x19, 0x1f, 0x32, 0x87, 0x93, 0x35, 0x98, 0x8f, 0x29, 0x7b, 0x34, 0xb2,
+	0x4c, 0x19, 0xce, 0x62, 0xd4, 0x55, 0x36, 0xe6, 0x14, 0x6a, 0x87, 0x46, 0x16, 0x7a, 0x5d, 0x4e,
+	0xee, 0xc0, 0x3c, 0x3f, 0x71, 0x83, 0x80, 0x3a, 0x66, 0x2a, 0x3c, 0xae, 0xcf, 0xe3, 0x7e, 0x10,
+	0x85, 0x4a, 0x85, 0x3d, 0x16, 0x3d, 0x2c, 0x8c, 0x45, 0x0f, 0x9f, 0x01, 0xd8, 0x2c, 0x18, 0xa0,
+	0x03, 0x11, 0xe1, 0x51, 0xe1, 0xcc, 0x70, 0x71, 0x93, 0x05, 0x03, 0x71, 0x8e, 0xb8, 0x51, 0xb5,
+	0xe3, 0x4f, 0x11, 0x55, 0x84, 0x94, 0xf7, 0x7b, 0xd4, 0xc1, 0x98, 0xa8, 0x62, 0xc4, 0x4d, 0xf2,
+	0x1e, 0xcc, 0x51, 0xdf, 0x0e, 0x07, 0x01, 0x2a, 0x29, 0x0a, 0x95, 0xea, 0xcb, 0x38, 0xbe, 0x96,
+	0x22, 0x30, 0xc6, 0xa7, 0xe4, 0xf6, 0x10, 0xf1, 0x09, 0x1d, 0x08, 0x73, 0x23, 0x43, 0x9d, 0xd9,
+	0x14, 0xf1, 0x94, 0x0e, 0x3a, 0x8e, 0xb8, 0x6f, 0x64, 0x19, 0x5b, 0x5e, 0x84, 0x01, 0x4f, 0xdd,
+	0x68, 0x66, 0xd8, 0x5a, 0x5e, 0x24, 0x54, 0x42, 0x41, 0xa8, 0x63, 0x0a, 0x69, 0x09, 0xc6, 0x7a,
+	0x0b, 0x69, 0xb5, 0x04, 0x23, 0x44, 0xfb, 0x94, 0x0e, 0x72, 0x0d, 0xe5, 0xb5, 0x5c, 0x43, 0xf9,
+	0xaf, 0x05, 0xa8, 0x26, 0x9b, 0xa1, 0xce, 0xf9, 0xa9, 0xeb, 0xd0, 0x50, 0x19, 0xa9, 0xa4, 0x2d,
+	0xf4, 0xd6, 0x66, 0x81, 0x4b, 0x1d, 0xf3, 0x70, 0x10, 0x51, 0xae, 0x0c, 0x64, 0x4d, 0xc2, 0x36,
+	0x04, 0x48, 0x9c, 0x2e, 0x45, 0xc2, 0x0e, 0x7f, 0x4e, 0xed, 0x88, 0x2b, 0x4f, 0xda, 0x90, 0xd0,
+	0x5d, 0x09, 0x14, 0x76, 0x88, 0x7a, 0x56, 0xc0, 0x29, 0xaa, 0xb5, 0xb2, 0x43, 0x0a, 0xb2, 0xc3,
+	0xc9, 0x0a, 0x0e, 0x34, 0x40, 0x21, 0x0b, 0x02, 0x69, 0x8b, 0x50, 0xb2, 0x42, 0xca, 0x3b, 0xe2,
+	0xce, 0x31, 0x67, 0x9d, 0x76, 0xcd, 0xde, 0xa1, 0x19, 0xd0, 0xd0, 0xe4, 0xd4, 0x66, 0xbe, 0x83,
+	0x76, 0xa9, 0x60, 0x34, 0xad, 0xd3, 0xee, 0xce, 0xe1, 0x1e, 0x0d, 0xf7, 0x11, 0xda, 0xfe, 0xcf,
+	0x02, 0x90, 0x71, 0x85, 0xcf, 0x5e, 0x00, 0x0b, 0x43, 0x17, 0xc0, 0xff, 0x3f, 0x14, 0xfc, 0x16,
+	0xf1, 0x18, 0x7d, 0x3c, 0xe1, 0x31, 0x3a, 0x37, 0xf4, 0xbd, 0x05, 0xda, 0xc8, 0xcd, 0x52, 0xba,
+	0x91, 0xaa, 0x31, 0x3b, 0x7c, 0xb5, 0xe4, 0xdf, 0x34, 0x64, 0xfc, 0x29, 0x5c, 0x4d, 0x4f, 0x0d,
+	0xde, 0xfd, 0x32, 0x0b, 0xff, 0x21, 0x94, 0xe5, 0x65, 0xaa, 0x70, 0x59, 0x0b, 0x2b, 0xfb, 0xb5,
+	0x7f, 0x02, 0x7a, 0x12, 0x8f, 0x8e, 0x32, 0xff, 0x7c, 0x98, 0xf9, 0xe4, 0xd7, 0x4a, 0xc5, 0xfb,
+	0x25, 0x2c, 0x29, 0x7b, 0x32, 0xca, 0xf9, 0x07, 0xc3, 0x9c, 0x27, 0x8d, 0x3a, 0x15, 0xdf, 0x5f,
+	0xcf, 0xc0, 0xfc, 0x66, 0x48, 0xad, 0x48, 0x09, 0xcb, 0xa0, 0x5f, 0xf6, 0x29, 0x8f, 0xc8, 0x1b,
+	0x50, 0x0d, 0xe5, 0x67, 0x27, 0x76, 0xca, 0x29, 0x20, 0x63, 0x6e, 0x32, 0xc1, 0xb3, 0x32, 0x37,
+	0xcf, 0x95, 0x97, 0x9b, 0x50, 0xa4, 0x42, 0x5a, 0x16, 0x1f, 0xf8, 0x36, 0x6a, 0x7b, 0xc5, 0x90,
+	0x0d, 0xf2, 0x19, 0x34, 0x9d, 0xc3, 0x21, 0xe3, 0x57, 0x46, 0x9b, 0xb5, 0xb4, 0x26, 0x13, 0x57,
+	0x6b, 0x71, 0xe2, 0x6a, 0xed, 0xa5, 0x90, 0xae, 0xd1, 0x70, 0x0e, 0xb3, 0xf6, 0x70, 0x01, 0xca,
+	0x47, 0x2c, 0xb4, 0x65, 0xa8, 0x5c, 0x31, 0x64, 0x43, 0xdc, 0xed, 0xd0, 0xfc, 0xa2, 0x1b, 0x9a,
+	0x41, 0x4c, 0x45, 0x00, 0xd0, 0xf9, 0xdc, 0x84, 0xd9, 0xae, 0x6d, 0x06, 0x56, 0x9f, 0x53, 0x93,
+	0xfa, 0xd6, 0xa1, 0x27, 0xa3, 0xbe, 0x8a, 0xd1, 0xe8, 0xda, 0x7b, 0x02, 0xba, 0x8d, 0x40, 0x61,
+	0x40, 0x12, 0x3a, 0x79, 0xbe, 0x38, 0x86, 0x81, 0x65, 0xa3, 0xa9, 0x08, 0xe5, 0xf9, 0xe2, 0x43,
+	0x94, 0x96, 0xe3, 0x60, 0x88, 0x00, 0xd2, 0xd4, 0x28, 0xca, 0x87, 0x12, 0x7a, 0xa6, 0xab, 0xac,
+	0x4d, 0xec, 0x2a, 0xeb, 0xe3, 0xae, 0xf2, 0x33, 0xb8, 0xd6, 0xb3, 0x5e, 0x9b, 0xa3, 0xee, 0x32,
+	0x9e, 0x73, 0x03, 0x6d, 0x87, 0xde, 0xb3, 0x5e, 0xef, 0x0f, 0xb9, 0xcd, 0x78, 0xf6, 0x4b, 0x30,
+	0x7d, 0x4a, 0x43, 0xf7, 0x68, 0x80, 0x39, 0x8b, 0x8a, 0xa1, 0x5a, 0x99, 0x00, 0x26, 0xf6, 0x8c,
+	0xd2, 0xff, 0x56, 0xe2, 0x00, 0x26, 0x3e, 0xfd, 0x5c, 0x98, 0xf0, 0xf4, 0xf2, 0xc0, 0x6d, 0x16,
+	0x50, 0xcc, 0x63, 0x54, 0x8d, 0xf4, 0xf6, 0xb5, 0x2f, 0xa0, 0xd2, 0x3a, 0x66, 0xae, 0x22, 0xb1,
+	0x33, 0x6d, 0x64, 0xef, 0x22, 0x5c, 0xb8, 0x0f, 0x9b, 0xf9, 0x91, 0xeb, 0xf7, 0xc5, 0xfe, 0x98,
+	0x18, 0xc1, 0xa1, 0x13, 0xad, 0x18, 0xb3, 0x31, 0x62, 0xd7, 0xdf, 0x16, 0x60, 0x72, 0x02, 0x73,
+	0xca, 0xc4, 0x0c, 0x4c, 0x4e, 0x05, 0x13, 0x16, 0xa2, 0x03, 0xad, 0xad, 0x7f, 0x9e, 0x7f, 0xb2,
+	0xc7, 0x4f, 0x41, 0x6c, 0xb5, 0x06, 0xfb, 0x8a, 0x81, 0xb4, 0x5d, 0x5a, 0x30, 0x02, 0x16, 0x7b,
+	0x25, 0xfd, 0x21, 0x7a, 0xde, 0x8a, 0xa1, 0x5a, 0xb9, 0xce, 0x66, 0x31, 0xcf, 0xd9, 0xb4, 0x36,
+	0x61, 0x31, 0x77, 0xb0, 0x4b, 0x99, 0xb7, 0xbf, 0x29, 0x00, 0xc9, 0x1c, 0x71, 0xca, 0x03, 0xe6,
+	0x73, 0x7a, 0xc1, 0x59, 0xbe, 0x07, 0x53, 0x99, 0x08, 0x3b, 0x3f, 0x19, 0x10, 0xb3, 0xc2, 0xd0,
+	0x1a, 0xc9, 0xc5, 0xbc, 0x7a, 0xbc, 0xab, 0x82, 0x69, 0xf1, 0x49, 0x3e, 0x84, 0x29, 0xa1, 0x11,
+	0x78, 0x8e, 0x6b, 0xeb, 0x37, 0xce, 0x09, 0xd5, 0x71, 0x76, 0x48, 0xdc, 0xfe, 0x75, 0x01, 0xb4,
+	0xc7, 0x34, 0xfa, 0x56, 0x8d, 0xcf, 0x35, 0xa8, 0x2a, 0x02, 0x75, 0x61, 0xad, 0xc6, 0xd7, 0x30,
+	0xd5, 0xbb, 0x6f, 0x9f, 0xd0, 0x48, 0xf6, 0x9e, 0x52, 0xbd, 0x11, 0x84, 0xbd, 0x09, 0x4c, 0x61,
+	0x50, 0x5b, 0x46, 0x0c, 0x7e, 0x0b, 0xfd, 0x7c, 0xe5, 0x46, 0xc7, 0xac, 0x1f, 0x99, 0x0e, 0x8d,
+	0x2c, 0xd7, 0x53, 0x76, 0xa5, 0xa1, 0xa0, 0x5b, 0x08, 0x6c, 0xff, 0x65, 0x01, 0xc8, 0x33, 0x97,
+	0xc7, 0x37, 0xf9, 0xc9, 0x96, 0x93, 0x93, 0x57, 0x2d, 0xe6, 0xe6, 0x55, 0xbf, 0x0f, 0x44, 0x29,
+	0xb9, 0x85, 0xa4, 0x11, 0x3b, 0xa1, 0xbe, 0x5a, 0xdf, 0x5c, 0x16, 0x73, 0x20, 0x10, 0x42, 0x4d,
+	0x3c, 0xb7, 0xe7, 0x46, 0xb8, 0xc4, 0xb2, 0x21, 0x1b, 0xed, 0x7f, 0x2f, 0xc0, 0xfc, 0xd0, 0x14,
+	0x7f, 0x53, 0x3a, 0x52, 0x9a, 0x58, 0x47, 0xc8, 0x7d, 0x58, 0xf6, 0xe9, 0xeb, 0xc8, 0xcc, 0x59,
+	0xbd, 0x14, 0xd2, 0xa2, 0x40, 0x6f, 0x8e, 0xee, 0x40, 0xfb, 0xe7, 0x30, 0xbf, 0x45, 0x3d, 0xfa,
+	0x2d, 0xbb, 0xb6, 0xc4, 0xb5, 0x94, 0x32, 0xae, 0xa5, 0xfd, 0x7b, 0xb0, 0x30, 0x3c, 0xd6, 0x77,
+	0xba, 0xaf, 0xed, 0x7f, 0x2c, 0xc0, 0xe2, 0xa6, 0x47, 0x2d, 0xbf, 0x1f, 0xec, 0x86, 0xc1, 0xb1,
+	0xe5, 0x4f, 0xa8, 0x7c, 0x22, 0xd8, 0x0b, 0x07, 0x66, 0xd8, 0x97, 0xb7, 0xfa, 0x8a, 0x31, 0xed,
+	0x84, 0x03, 0xa3, 0xef, 0x0b, 0x8f, 0xd4, 0x0d, 0x2d, 0x9b, 0x8a, 0x30, 0xd2, 0x65, 0xa9, 0xd7,
+	0x90, 0x51, 0x2b, 0x41, 0xdc, 0x1e, 0xa2, 0x62, 0x7f, 0x91, 0xaf, 0x9e, 0x53, 0x17, 0xaa, 0x67,
+	0x39, 0xab, 0x9e, 0xff, 0x5c, 0x80, 0xa5, 0xd1, 0x75, 0x7c, 0xb7, 0x1a, 0xaa, 0xc3, 0x0c, 0x93,
+	0x23, 0xa3, 0x92, 0x56, 0x8d, 0xb8, 0xf9, 0xb5, 0xd5, 0xf0, 0x5f, 0x1a, 0xb0, 0x60, 0x50, 0x1e,
+	0xb1, 0xf0, 0x37, 0x16, 0x63, 0xbd, 0x07, 0x99, 0x24, 0x80, 0xc9, 0xfb, 0x47, 0x47, 0xee, 0x6b,
+	0x25, 0x9a, 0x0c, 0x8f, 0x7d, 0x84, 0x13, 0x36, 0x94, 0x76, 0x08, 0xa9, 0xe4, 0x2c, 0x53, 0x77,
+	0x3f, 0x3a, 0x6b, 0x63, 0xc7, 0x56, 0x97, 0x89, 0x94, 0x0d, 0xc9, 0x42, 0x3a, 0xcf, 0x39, 0x7b,
+	0x14, 0x9e, 0x46, 0x80, 0xd3, 0xd9, 0x08, 0x70, 0xc4, 0x50, 0xcf, 0x9c, 0x69, 0xa8, 0x2b, 0x19,
+	0x43, 0x3d, 0x1e, 0x36, 0x56, 0x2f, 0x13, 0x36, 0xb6, 0x20, 0x89, 0x07, 0xe3, 0xfc, 0x5d, 0xdc,
+	0x16, 0x69, 0xa4, 0x50, 0xae, 0x13, 0xd3, 0x66, 0x2a, 0x36, 0x1b, 0x82, 0x09, 0x1a, 0x11, 0xd5,
+	0xf5, 0x23, 0x26, 0x69, 0xea, 0x92, 0x26, 0x0b, 0x23, 0x77, 0x61, 0xde, 0x09, 0x59, 0xb0, 0xfd,
+	0xda, 0xe5, 0x51, 0x3a, 0xb6, 0xca, 0x8a, 0xe4, 0xa1, 0xc8, 0x4d, 0x68, 0x26, 0x60, 0xc9, 0x57,
+	0x46, 0x64, 0x23, 0x50, 0xb2, 0x0e, 0x0b, 0x22, 0x35, 0x20, 0x03, 0x99, 0x0c, 0x6b, 0x19, 0x9d,
+	0xe5, 0xe2, 0x54, 0xd6, 0x4d, 0x4b, 0xb2, 0x6e, 0x0f, 0x40, 0x17, 0x74, 0x9d, 0x5e, 0xc0, 0xc2,
+	0x68, 0xcb, 0xe5, 0x27, 0xff, 0xaf, 0xcf, 0x22, 0x0b, 0xd3, 0xfc, 0xfa, 0x1c, 0xf2, 0x39, 0x13,
+	0x4f, 0x56, 0x61, 0x34, 0x0a, 0x3b, 0x2b, 0x38, 0xdb, 0x83, 0x59, 0x99, 0x52, 0x64, 0xa7, 0x34,
+	0x0c, 0x5d, 0x87, 0x72, 0x7d, 0xfe, 0x9c, 0xb4, 0x0c, 0x2e, 0x0f, 0x5f, 0x78, 0x77, 0x15, 0xbd,
+	0xd1, 0xc4, 0xfe, 0x71, 0x93, 0xe3, 0xd8, 0x62, 0x12, 0x7b, 0xa1, 0x7b, 0xea, 0x7a, 0xb4, 0x4b,
+	0xb9, 0x0a, 0xc5, 0x46, 0xc1, 0xc2, 0xdf, 0x8a, 0xeb, 0xb3, 0xf0, 0xe5, 0xb1, 0x51, 0x5b, 0x44,
+	0xa3, 0xd6, 0x54, 0xe0, 0xd8, 0xa0, 0xbd, 0x07, 0x73, 0x4a, 0xb8, 0x99, 0x48, 0x57, 0x66, 0x3f,
+	0x34, 0x85, 0x48, 0x43, 0xdd, 0x87, 0x70, 0xdd, 0xea, 0x47, 0xcc, 0x0c, 0x29, 0xe6, 0xe9, 0x83,
+	0x90, 0x9e, 0xba, 0xac, 0xcf, 0xbd, 0x81, 0x29, 0xda, 0xd4, 0xc1, 0x94, 0x48, 0xc5, 0x68, 0x09,
+	0x22, 0x03, 0x69, 0xf6, 0x12, 0x92, 0x67, 0x48, 0x21, 0xee, 0xfe, 0x98, 0x78, 0x96, 0xa1, 0xbf,
+	0x8e, 0xf4, 0x32, 0x15, 0x8d, 0xfa, 0x77, 0x1f, 0x96, 0x6d, 0x94, 0x9e, 0xd9, 0x73, 0x39, 0x77,
+	0xfd, 0x6e, 0x32, 0x2b, 0xcc, 0x8b, 0x54, 0x8c, 0x45, 0x89, 0xde, 0x91, 0xd8, 0x78, 0x6a, 0x62,
+	0x66, 0x38, 0x25, 0x35, 0x65, 0x27, 0xf3, 0x82, 0x24, 0x47, 0x6a, 0xc9, 0x99, 0x09, 0x22, 0x75,
+	0x90, 0x9d, 0xf4, 0x3d, 0x09, 0x87, 0xfe, 0x14, 0xae, 0xaa, 0xe4, 0x30, 0x0a, 0xed, 0x90, 0x1e,
+	0x89, 0x4d, 0x71, 0x51, 0x07, 0x30, 0x79, 0x52, 0x31, 0x96, 0x90, 0x00, 0x05, 0xb5, 0x81, 0x68,
+	0xa9, 0x21, 0xe2, 0x1d, 0x91, 0x5b, 0xbe, 0x1b, 0xb9, 0x5f, 0x51, 0x73, 0xcc, 0x5a, 0xbd, 0x81,
+	0x5d, 0x97, 0x63, 0x82, 0xcd, 0x11, 0xab, 0xf5, 0x2e, 0xcc, 0xc6, 0x02, 0x88, 0x9f, 0xb4, 0xae,
+	0x4b, 0xc5, 0x57, 0xe0, 0x87, 0x12, 0x2a, 0x32, 0xd4, 0xce, 0xc0, 0xb7, 0x7a, 0xae, 0x6d, 0x62,
+	0x59, 0x82, 0xfe, 0xa6, 0x4c, 0xf1, 0x2a, 0x20, 0xe6, 0xf6, 0x45, 0x0e, 0xce, 0xb3, 0x22, 0xca,
+	0xa5, 0x3d, 0x11, 0x89, 0xcb, 0x88, 0x86, 0xbe, 0x7e, 0x43, 0x3a, 0x28, 0x89, 0x12, 0xe3, 0xee,
+	0x49, 0x04, 0xf9, 0x01, 0xb4, 0xa8, 0x38, 0x5b, 0x62, 0xa7, 0x33, 0x33, 0x0f, 0x98, 0xe7, 0xda,
+	0x03, 0x7d, 0x05, 0xbb, 0xe9, 0x31, 0x45, 0x3a, 0xf5, 0x3d, 0xc4, 0x93, 0x0d, 0xb8, 0x1e, 0xcf,
+	0x3d, 0xdb, 0x39, 0xcd, 0x9f, 0xbc, 0x85, 0x2b, 0xb9, 0xa6, 0x88, 0x32, 0xfd, 0x13, 0x12, 0xf2,
+	0x33, 0x98, 0x3f, 0xc5, 0x9b, 0x80, 0x19, 0x85, 0x96, 0xcf, 0x8f, 0x58, 0xd8, 0xa3, 0x21, 0xd7,
+	0xdb, 0x78, 0x52, 0xde, 0xcf, 0x3d, 0x29, 0x2f, 0x91, 0xfe, 0x20, 0x25, 0xdf, 0xc5, 0x3c, 0x99,
+	0x41, 0x4e, 0x47, 0x11, 0xbc, 0xb5, 0x05, 0x4b, 0xf9, 0x36, 0xfa, 0x52, 0x77, 0x8e, 0x3f, 0x2e,
+	0xc0, 0xf2, 0x19, 0xa3, 0xe6, 0x85, 0xb6, 0x85, 0xdc, 0xd0, 0x76, 0xb8, 0xc2, 0xa4, 0x38, 0x5a,
+	0x61, 0x12, 0xe7, 0xd1, 0x4b, 0x99, 0x3c, 0xba, 0x06, 0x25, 0xc7, 0xed, 0xa9, 0x14, 0x99, 0xf8,
+	0x6c, 0xff, 0x61, 0x11, 0xc8, 0xb8, 0xa5, 0xf8, 0xd6, 0x26, 0x71, 0x41, 0x1d, 0xcb, 0xd3, 0x91,
+	0x3a, 0x96, 0x0f, 0x27, 0xb4, 0x64, 0xdf, 0x76, 0x41, 0xcb, 0x3f, 0x95, 0x92, 0x68, 0x23, 0x39,
+	0xc5, 0xe2, 0x69, 0x64, 0xec, 0x7d, 0xe5, 0x49, 0xce, 0xfb, 0xca, 0xad, 0xf3, 0xdc, 0xfb, 0xff,
+	0xc2, 0x07, 0x96, 0x0e, 0xe0, 0x4b, 0xa4, 0xba, 0x5f, 0x63, 0x8c, 0x70, 0x99, 0xdc, 0x1a, 0x88,
+	0xce, 0xb2, 0x9d, 0xf3, 0x24, 0x5c, 0xc9, 0x7b, 0x12, 0x1e, 0x7d, 0x0f, 0xad, 0x8e, 0xbf, 0x87,
+	0xbe, 0x0d, 0x8d, 0xc4, 0xd6, 0x66, 0x5e, 0x59, 0xe2, 0x48, 0xc1, 0xd9, 0x17, 0xaf, 0x2d, 0x37,
+	0x61, 0x16, 0xbd, 0x05, 0x82, 0x24, 0x59, 0x4d, 0x26, 0x84, 0x85, 0x7f, 0x40, 0xa8, 0xa0, 0x6b,
+	0xff, 0xb2, 0x0e, 0x8b, 0xc6, 0xa8, 0x8d, 0xf8, 0xad, 0x96, 0xe7, 0x8f, 0xa1, 0x26, 0x0e, 0x5e,
+	0x2c, 0xb3, 0x69, 0x94, 0xd9, 0x25, 0x92, 0xad, 0x20, 0x7a, 0x2b, 0xa1, 0x7d, 0x04, 0x4b, 0x91,
+	0x15, 0x76, 0x69, 0x34, 0xea, 0x5b, 0x54, 0xb8, 0xb8, 0x20, 0xb1, 0xc3, 0x8e, 0x85, 0x58, 0xb0,
+	0x9c, 0xca, 0x30, 0x16, 0x41, 0x64, 0xf1, 0x13, 0xae, 0x57, 0xce, 0x49, 0xfd, 0xe6, 0x9d, 0x2a,
+	0x63, 0x31, 0xe1, 0x94, 0xd9, 0x55, 0x3e, 0xae, 0x03, 0xd5, 0xc9, 0x74, 0x00, 0x72, 0x74, 0x60,
+	0xe8, 0x04, 0xd4, 0x46, 0x4e, 0xc0, 0xf7, 0xa0, 0xa9, 0x76, 0x20, 0x4e, 0xda, 0xcb, 0xc7, 0xb8,
+	0xba, 0x84, 0x6e, 0xc9, 0xd4, 0x7d, 0x36, 0xae, 0x6d, 0x5c, 0x10, 0xd7, 0x36, 0x27, 0x88, 0x6b,
+	0x67, 0x27, 0x8f, 0x6b, 0xb5, 0xcb, 0xc4, 0xb5, 0x73, 0x97, 0x8a, 0x6b, 0xc9, 0x39, 0x71, 0xed,
+	0x1a, 0xe0, 0x33, 0xd9, 0x48, 0x04, 0x3b, 0xaf, 0xf2, 0xa9, 0x63, 0x98, 0xbc, 0x88, 0x74, 0xe1,
+	0x9b, 0x45, 0xa4, 0x17, 0x46, 0x84, 0x8b, 0x97, 0x8c, 0x08, 0x97, 0x46, 0x23, 0xc2, 0xef, 0x41,
+	0x93, 0xb3, 0x7e, 0x68, 0xd3, 0x44, 0xf6, 0xf2, 0xdd, 0xad, 0x2e, 0xa1, 0x4a, 0xf6, 0x1f, 0xc1,
+	0x92, 0xa2, 0x1a, 0x3d, 0x23, 0xf2, 0xe1, 0x6d, 0x41, 0x62, 0x47, 0xce, 0xc8, 0x5d, 0x50, 0x70,
+	0x73, 0xb8, 0x46, 0x44, 0xd6, 0x1c, 0x91, 0xd1, 0x3e, 0x1d, 0x47, 0xf4, 0x18, 0x3f, 0x8b, 0xae,
+	0x83, 0xe1, 0x65, 0xc9, 0x20, 0xa3, 0x27, 0xb1, 0xe3, 0x5c, 0x1c, 0x99, 0x5e, 0xfb, 0x66, 0x91,
+	0xe9, 0x1b, 0xe7, 0x46, 0xa6, 0x13, 0x47, 0x97, 0xc3, 0x05, 0x89, 0x6f, 0x8e, 0x16, 0x24, 0x8e,
+	0x05, 0x9f, 0x37, 0x72, 0x82, 0xcf, 0x0b, 0xc3, 0xc1, 0x95, 0x0b, 0xc3, 0xc1, 0xf6, 0x9f, 0x94,
+	0x61, 0x6e, 0xe8, 0xa6, 0xfd, 0x5b, 0xed, 0x06, 0x1c, 0xd0, 0x87, 0xb2, 0x0c, 0x59, 0x2b, 0x3c,
+	0x7d, 0x4e, 0x25, 0x70, 0xae, 0x33, 0x34, 0x96, 0xb2, 0x59, 0x85, 0xf3, 0xec, 0xf0, 0xcc, 0x64,
+	0x76, 0xb8, 0x72, 0x91, 0x1d, 0xae, 0x8e, 0xd8, 0xe1, 0x3f, 0x28, 0x40, 0x2b, 0xbe, 0xc7, 0x38,
+	0xe3, 0x37, 0x1d, 0xc0, 0x15, 0x6d, 0x5d, 0x9c, 0x3d, 0x11, 0xd3, 0x5e, 0xdb, 0x8f, 0x19, 0x8d,
+	0xdc, 0x88, 0x64, 0x90, 0xa8, 0xf3, 0x33, 0xd0, 0xa3, 0x29, 0xa3, 0xda, 0x68, 0xca, 0xa8, 0xf5,
+	0x14, 0xae, 0x9f, 0xcb, 0xfb, 0x52, 0x91, 0xe6, 0xdf, 0x17, 0x60, 0x71, 0x68, 0xee, 0xdf, 0x75,
+	0xaa, 0xee, 0xc1, 0xd0, 0x83, 0xc3, 0xcd, 0xc9, 0x36, 0x57, 0xbd, 0x3b, 0x3c, 0x82, 0xa5, 0xc7,
+	0x34, 0x8a, 0xa5, 0x2b, 0x74, 0x7e, 0xb2, 0xac, 0x9c, 0x3c, 0x6e, 0xc5, 0xf8, 0xb8, 0xb5, 0xff,
+	0xaa, 0x00, 0xcd, 0xdd, 0x80, 0x86, 0x98, 0xef, 0xdb, 0x3e, 0xa5, 0x7e, 0x24, 0x26, 0xca, 0xe9,
+	0x97, 0xaa, 0x8a, 0x4a, 0x7c, 0x8a, 0xfb, 0x0b, 0x1e, 0x01, 0x59, 0x17, 0x80, 0xdf, 0x08, 0x4b,
+	0x2f, 0x12, 0xf8, 0x2d, 0x72, 0x8f, 0x3d, 0x75, 0xd8, 0x64, 0x72, 0x2e, 0x6e, 0x66, 0x1f, 0xe5,
+	0xcb, 0x17, 0x55, 0x65, 0x4f, 0xe7, 0xdd, 0x6e, 0xda, 0xbf, 0x90, 0x0f, 0x2d, 0x38, 0x45, 0xfe,
+	0xb5, 0xd6, 0x2a, 0xde, 0x55, 0xac, 0xa3, 0x08, 0xcb, 0x0a, 0xbe, 0x54, 0x89, 0xe0, 0x0a, 0x02,
+	0xf6, 0xe9, 0x97, 0x22, 0x30, 0x7e, 0x65, 0xb9, 0x69, 0x4e, 0x45, 0xbe, 0x3a, 0xd4, 0x04, 0x4c,
+	0x25, 0x54, 0xda, 0x7f, 0x5b, 0x80, 0xb9, 0xcc, 0x14, 0xbe, 0x5b, 0x65, 0xf9, 0x78, 0xe8, 0xe5,
+	0xe1, 0xed, 0x5c, 0x46, 0xc3, 0x82, 0x54, 0x9a, 0xf2, 0xbb, 0x50, 0xcb, 0x14, 0x07, 0x0a, 0x19,
+	0xa1, 0x99, 0xef, 0x6c, 0xc5, 0x75, 0x72, 0xaa, 0x49, 0xee, 0xa5, 0x75, 0x8e, 0xc5, 0x8b, 0x8b,
+	0xe0, 0x62, 0xda, 0xf6, 0x3f, 0x14, 0x60, 0x5a, 0xf1, 0xbe, 0x01, 0x35, 0xea, 0x47, 0xa1, 0x4b,
+	0xa5, 0xab, 0x91, 0xfc, 0x41, 0x81, 0x84, 0xaf, 0x79, 0x07, 0x9a, 0x49, 0xd5, 0x98, 0x79, 0x14,
+	0xb2, 0x1e, 0xee, 0xcb, 0x94, 0xd1, 0x48, 0xa0, 0x8f, 0x42, 0xd6, 0x13, 0xb2, 0x48, 0xc9, 0x22,
+	0x86, 0xdb, 0x30, 0x65, 0xd4, 0x12, 0xd8, 0x01, 0x13, 0x96, 0x59, 0x3c, 0x1e, 0x63, 0x02, 0x55,
+	0xe9, 0x9a, 0xc7, 0xba, 0x58, 0xb7, 0xa5, 0x50, 0x99, 0x1a, 0x54, 0x81, 0x42, 0x0b, 0xb8, 0x04,
+	0xd3, 0xfc, 0xd8, 0x5a, 0xbf, 0x77, 0x5f, 0x29, 0x99, 0x6a, 0xb5, 0xef, 0x43, 0xfd, 0x29, 0x1d,
+	0x60, 0x4a, 0x75, 0xcf, 0x72, 0xc3, 0x49, 0xcd, 0x48, 0xfb, 0xbf, 0x0b, 0x00, 0xd8, 0x4b, 0x7a,
+	0xc9, 0xeb, 0x50, 0x3d, 0x64, 0xcc, 0xc3, 0xc4, 0x16, 0x76, 0xae, 0x3c, 0xb9, 0x62, 0x54, 0x04,
+	0x48, 0x64, 0xb3, 0xc8, 0x35, 0xa8, 0xb8, 0x7e, 0x24, 0xb1, 0x82, 0x4d, 0xf9, 0xc9, 0x15, 0x63,
+	0xc6, 0xf5, 0x23, 0x44, 0x5e, 0x87, 0xaa, 0xc7, 0x54, 0x52, 0x4c, 0x2a, 0xa7, 0xe8, 0x2b, 0x40,
+	0x88, 0xbe, 0x01, 0x70, 0xe4, 0x31, 0x4b, 0xf5, 0x16, 0x2b, 0x2e, 0x3e, 0xb9, 0x62, 0x54, 0x11,
+	0x86, 0x04, 0x6f, 0x41, 0xcd, 0x61, 0xfd, 0x43, 0x4f, 0x26, 0xfb, 0x70, 0xe1, 0x85, 0x27, 0x57,
+	0x0c, 0x90, 0xc0, 0x98, 0x84, 0x47, 0x61, 0x9c, 0x79, 0x93, 0x5b, 0x20, 0x48, 0x24, 0x30, 0x1e,
+	0x06, 0x4b, 0x80, 0x24, 0x85, 0x70, 0x36, 0x75, 0x31, 0x0c, 0xc2, 0x04, 0xc1, 0xc6, 0xb4, 0x54,
+	0xc3, 0xf6, 0x9f, 0x97, 0x95, 0x5a, 0xc9, 0x5f, 0x3f, 0x9c, 0xa3, 0x56, 0x71, 0xf2, 0xa3, 0x98,
+	0x49, 0x7e, 0x7c, 0x0f, 0x9a, 0x2e, 0x37, 0x83, 0xd0, 0xed, 0x59, 0xe1, 0x00, 0xcb, 0x9d, 0xe4,
+	0x63, 0x54, 0xdd, 0xe5, 0x7b, 0x12, 0x28, 0x4a, 0x9d, 0x56, 0xa0, 0xe6, 0x50, 0x6e, 0x87, 0x2e,
+	0x66, 0x63, 0x94, 0x98, 0xb3, 0x20, 0xf2, 0x00, 0xaa, 0x62, 0x36, 0x32, 0xa5, 0x51, 0xc6, 0x23,
+	0x76, 0xfd, 0xcc, 0x8a, 0x1e, 0x91, 0xe6, 0x30, 0x2a, 0x8e, 0xfa, 0x22, 0x1b, 0x50, 0x13, 0xdd,
+	0x4c, 0x95, 0xf5, 0x98, 0x3e, 0xa7, 0x96, 0x3c, 0xab, 0x1b, 0x06, 0x88, 0x5e, 0x32, 0xbb, 0x41,
+	0xb6, 0x40, 0x56, 0x91, 0xc6, 0x4c, 0x66, 0x26, 0x65, 0x22, 0xab, 0x66, 0x15, 0x97, 0x25, 0x98,
+	0xb6, 0xc4, 0x35, 0x64, 0x4b, 0x15, 0x6c, 0xa8, 0x16, 0xb9, 0x07, 0x65, 0x59, 0x2f, 0x5d, 0xc5,
+	0x95, 0xdd, 0x38, 0xbb, 0xf0, 0x57, 0x3a, 0x00, 0x49, 0x4d, 0x7e, 0x04, 0x75, 0xea, 0x51, 0x2c,
+	0xd6, 0xc3, 0x7d, 0x81, 0x49, 0xf6, 0xa5, 0xa6, 0xba, 0x88, 0x06, 0xd9, 0x82, 0x86, 0x43, 0x8f,
+	0xac, 0xbe, 0x17, 0x99, 0x52, 0xe9, 0x6b, 0xe7, 0x3c, 0x89, 0xa7, 0xfa, 0x6f, 0xd4, 0x55, 0x2f,
+	0x04, 0x61, 0xc2, 0x89, 0x9b, 0x2a, 0x8c, 0x54, 0x4f, 0x09, 0x55, 0x97, 0x6f, 0x49, 0x80, 0xa8,
+	0x2d, 0x10, 0x3a, 0x90, 0x5c, 0x64, 0x4f, 0x68, 0x7c, 0xb7, 0x6b, 0xba, 0x3c, 0x09, 0x93, 0x85,
+	0x1e, 0xbc, 0x0f, 0xc4, 0xe5, 0xe6, 0x51, 0xdf, 0x97, 0x4e, 0x82, 0xf5, 0xa3, 0xa0, 0x1f, 0xa9,
+	0x8b, 0x99, 0xe6, 0xf2, 0x47, 0x0a, 0xb1, 0x8b, 0xf0, 0xf6, 0x7f, 0x15, 0xa1, 0x19, 0x83, 0x94,
+	0x72, 0xc6, 0x2a, 0x58, 0xc8, 0xa8, 0x60, 0xea, 0x1c, 0x4a, 0xe8, 0x1c, 0x46, 0x94, 0xad, 0x34,
+	0xae, 0x6c, 0xf7, 0x94, 0xc7, 0x9b, 0x3a, 0xc7, 0x94, 0xc7, 0x03, 0xe3, 0x9e, 0x22, 0xb9, 0x28,
+	0xfa, 0x70, 0xfd, 0xa0, 0x1f, 0x99, 0x69, 0x72, 0x4e, 0xbe, 0x46, 0x55, 0x8d, 0x59, 0x44, 0x3c,
+	0x8a, 0x53, 0x74, 0x5c, 0x44, 0x72, 0x59, 0x5a, 0xd7, 0x91, 0x7a, 0x59, 0x32, 0x1a, 0x29, 0xa5,
+	0x28, 0x24, 0x79, 0x1f, 0x88, 0xdc, 0x85, 0x21, 0xa6, 0x33, 0xc8, 0x54, 0x93, 0x98, 0x0c, 0xd7,
+	0x55, 0xd0, 0x86, 0xa8, 0x5d, 0x47, 0x26, 0x0a, 0x4a, 0x46, 0x33, 0x43, 0x2b, 0xf8, 0x7e, 0x9a,
+	0x24, 0x01, 0xab, 0x93, 0x6a, 0xb2, 0xea, 0xd0, 0xfe, 0xd3, 0x22, 0x68, 0xa3, 0xbf, 0x89, 0xca,
+	0xdd, 0xf8, 0x91, 0x8d, 0x2e, 0x8e, 0x6f, 0x74, 0x7a, 0x1e, 0x4a, 0x43, 0xe7, 0xe1, 0x13, 0x98,
+	0xc6, 0x05, 0xc4, 0x29, 0xca, 0x73, 0x2a, 0xe1, 0xe3, 0xdf, 0x64, 0x49, 0x7a, 0x71, 0xb7, 0x93,
+	0x25, 0x51, 0xe6, 0xf0, 0x55, 0xa7, 0x8c, 0xfc, 0x89, 0xc4, 0x6d, 0x65, 0x2f, 0x3c, 0x0f, 0xa1,
+	0x1a, 0x2b, 0x5c, 0x7c, 0xac, 0xdf, 0x3e, 0x57, 0xe2, 0x6a, 0xc4, 0xb4, 0x57, 0xbb, 0x09, 0x75,
+	0xbc, 0x9b, 0xab, 0x60, 0xa5, 0xfd, 0x05, 0x34, 0x54, 0x5b, 0x45, 0x0e, 0x71, 0x6c, 0x50, 0xf8,
+	0x5a, 0xb1, 0x41, 0x31, 0x7d, 0x3d, 0xff, 0x45, 0x01, 0x6a, 0x3b, 0xbc, 0xbb, 0xc7, 0x38, 0x9e,
+	0x19, 0xac, 0xe7, 0x54, 0x3f, 0x60, 0xca, 0x6c, 0x7f, 0x4d, 0xc1, 0xe2, 0x2a, 0x80, 0x1e, 0xef,
+	0x76, 0xb6, 0x90, 0x4d, 0xdd, 0x90, 0x0d, 0xcc, 0xb3, 0xf0, 0xee, 0xe3, 0x90, 0xf5, 0x83, 0xb8,
+	0xf0, 0x24, 0x6e, 0x8b, 0x38, 0x27, 0xad, 0xf8, 0x9e, 0x42, 0x8f, 0x9c, 0x02, 0xda, 0x0f, 0x61,
+	0x56, 0xfd, 0xa4, 0x26, 0x99, 0x45, 0x9e, 0xf0, 0xc5, 0x15, 0x44, 0xe1, 0xd5, 0x02, 0x92, 0x76,
+	0xfb, 0x35, 0xd4, 0xb3, 0x3f, 0xda, 0x11, 0x53, 0xc4, 0x5b, 0x2a, 0x32, 0x28, 0x1b, 0xb2, 0x21,
+	0x02, 0xc6, 0x53, 0x37, 0x8c, 0xfa, 0x96, 0x17, 0xff, 0x0e, 0x28, 0x2e, 0x37, 0x51, 0xe0, 0xb8,
+	0xfb, 0x2d, 0xd0, 0x92, 0x9f, 0x7e, 0xc5, 0x94, 0x72, 0x4d, 0xb3, 0x31, 0x5c, 0x91, 0xde, 0xfe,
+	0x7d, 0xa8, 0x67, 0xf7, 0x99, 0xd4, 0x60, 0x66, 0xbf, 0x6f, 0xdb, 0x94, 0x73, 0xed, 0x0a, 0x99,
+	0x85, 0xda, 0x73, 0x16, 0x99, 0xfb, 0xfd, 0x40, 0x5c, 0xbb, 0xb5, 0x02, 0x99, 0x83, 0xc6, 0x73,
+	0x66, 0xee, 0xd1, 0x10, 0xdf, 0xb1, 0x98, 0xaf, 0x15, 0x49, 0x05, 0xa6, 0x1e, 0x59, 0xae, 0xa7,
+	0x95, 0xc8, 0x02, 0xcc, 0xa2, 0x55, 0xa7, 0x22, 0xce, 0xc4, 0xc7, 0x42, 0xed, 0xcf, 0x4a, 0xe4,
+	0x3a, 0xe8, 0x4a, 0x0b, 0x4c, 0x59, 0x29, 0x6b, 0x0a, 0x96, 0x8f, 0x58, 0xdf, 0x77, 0xb4, 0x5f,
+	0x95, 0x6e, 0xbf, 0x86, 0xf9, 0x9c, 0xdf, 0x00, 0x10, 0x02, 0xcd, 0x8d, 0x87, 0x9b, 0x4f, 0x5f,
+	0xec, 0x99, 0x9d, 0xe7, 0x9d, 0x83, 0xce, 0xc3, 0x67, 0xda, 0x15, 0xb2, 0x00, 0x9a, 0x82, 0x6d,
+	0x7f, 0xb1, 0xbd, 0xf9, 0xe2, 0xa0, 0xf3, 0xfc, 0xb1, 0x56, 0xc8, 0x50, 0xee, 0xbf, 0xd8, 0xdc,
+	0xdc, 0xde, 0xdf, 0xd7, 0x8a, 0x62, 0xde, 0x0a, 0xf6, 0xe8, 0x61, 0xe7, 0x99, 0x56, 0xca, 0x10,
+	0x1d, 0x74, 0x76, 0xb6, 0x77, 0x5f, 0x1c, 0x68, 0x53, 0xb7, 0x5f, 0x26, 0xc9, 0xf6, 0xe1, 0xa1,
+	0x6b, 0x30, 0x93, 0x8e, 0xd9, 0x80, 0x6a, 0x76, 0x30, 0xb1, 0x3b, 0xc9, 0x28, 0x62, 0xe5, 0x92,
+	0x7d, 0x0d, 0x66, 0x52, 0xbe, 0x5f, 0x08, 0x63, 0x30, 0xf2, 0x2b, 0x45, 0x80, 0xe9, 0xfd, 0x28,
+	0x64, 0x7e, 0x57, 0xbb, 0x82, 0x3c, 0xa8, 0xdc, 0x3d, 0x64, 0xb8, 0x21, 0xb6, 0x82, 0x3a, 0x5a,
+	0x91, 0x34, 0x01, 0x30, 0x7a, 0xed, 0x5b, 0x9e, 0x37, 0xd0, 0x4a, 0xa2, 0xbd, 0xd9, 0xe7, 0x11,
+	0xeb, 0x89, 0x3b, 0x9f, 0x36, 0x75, 0xfb, 0x3f, 0x0a, 0x50, 0x89, 0xbd, 0x96, 0x18, 0xfd, 0x39,
+	0xf3, 0xa9, 0x76, 0x45, 0x7c, 0x6d, 0x30, 0xe6, 0x69, 0x05, 0xf1, 0xd5, 0xf1, 0xa3, 0x4f, 0xb4,
+	0x22, 0xa9, 0x42, 0xb9, 0xe3, 0x47, 0x1f, 0xdc, 0xd7, 0x4a, 0xea, 0xf3, 0xc3, 0x75, 0x6d, 0x4a,
+	0x7d, 0xde, 0xff, 0x48, 0x2b, 0x8b, 0xcf, 0x47, 0x1e, 0xb3, 0x22, 0x0d, 0xc4, 0xe4, 0xb6, 0x30,
+	0x52, 0xd2, 0x6a, 0x6a, 0xa2, 0xae, 0xdf, 0xd5, 0x16, 0xc4, 0xdc, 0x5e, 0x5a, 0xe1, 0xe6, 0xb1,
+	0x15, 0x6a, 0x8b, 0x82, 0xfe, 0x61, 0x18, 0x5a, 0x03, 0x6d, 0x49, 0x8c, 0xf2, 0x63, 0xce, 0x7c,
+	0x6d, 0x99, 0x68, 0x50, 0xdf, 0x70, 0x7d, 0x2b, 0x1c, 0xc8, 0x57, 0x24, 0xcd, 0x11, 0x3b, 0x8f,
+	0x6c, 0x15, 0x80, 0x0a, 0x8d, 0x41, 0xc0, 0x07, 0xf7, 0x15, 0xe8, 0x08, 0x85, 0x31, 0x0c, 0xeb,
+	0x92, 0x45, 0x98, 0xdb, 0x0f, 0xac, 0x90, 0xd3, 0x6c, 0xef, 0xe3, 0xdb, 0x2f, 0x01, 0x52, 0x27,
+	0x2f, 0x86, 0xc3, 0x96, 0xcc, 0x18, 0x3a, 0xda, 0x15, 0xe4, 0x9e, 0x40, 0xc4, 0xac, 0x0b, 0x09,
+	0x68, 0x2b, 0x64, 0x41, 0x20, 0x40, 0xc5, 0xa4, 0x1f, 0x82, 0xa8, 0xa3, 0x95, 0x6e, 0x7f, 0x02,
+	0xf5, 0xac, 0xbb, 0x12, 0x4b, 0x7d, 0xe1, 0x9f, 0xf8, 0xec, 0x95, 0xaf, 0xf6, 0x73, 0x67, 0xfd,
+	0x9e, 0xe4, 0x75, 0x40, 0x5f, 0x47, 0xdb, 0xbd, 0x43, 0xea, 0x38, 0xc8, 0x6b, 0xfd, 0x57, 0x33,
+	0x30, 0xbf, 0x83, 0xc6, 0x4a, 0xaa, 0xed, 0x3e, 0x0d, 0x4f, 0x5d, 0x9b, 0x12, 0x1b, 0xea, 0xd9,
+	0x2a, 0x44, 0xb2, 0x3a, 0x69, 0xa1, 0x62, 0xeb, 0xdd, 0x8b, 0x2a, 0xa9, 0xd4, 0xf1, 0x6c, 0x5f,
+	0x21, 0x3f, 0x83, 0x6a, 0x52, 0x70, 0x47, 0xf2, 0x7f, 0x32, 0x3b, 0x5a, 0x90, 0x77, 0x19, 0xf6,
+	0x87, 0x50, 0xcb, 0xd4, 0x97, 0x91, 0xfc, 0x9e, 0xe3, 0x45, 0x72, 0xad, 0xd5, 0x8b, 0x09, 0x93,
+	0x31, 0x28, 0xd4, 0xb3, 0xc5, 0x56, 0x67, 0xec, 0x53, 0x4e, 0xed, 0x57, 0xeb, 0xd6, 0x04, 0x94,
+	0xc9, 0x30, 0xc7, 0xd0, 0x18, 0x4a, 0x1f, 0x90, 0x5b, 0x13, 0x57, 0xbf, 0xb4, 0x6e, 0x4f, 0x42,
+	0x9a, 0x8c, 0xd4, 0x05, 0x48, 0xb3, 0x11, 0xe4, 0xbd, 0xb3, 0x84, 0x92, 0x93, 0xae, 0xb8, 0xe4,
+	0x40, 0x7b, 0x50, 0x96, 0xf9, 0xee, 0x7c, 0x6f, 0x99, 0xf5, 0xb7, 0xad, 0xf6, 0x79, 0x24, 0x09,
+	0xc7, 0x9f, 0xa2, 0x3a, 0xc9, 0x3b, 0xfd, 0xd9, 0xea, 0x34, 0x94, 0x76, 0x68, 0xdd, 0xbc, 0x88,
+	0x2c, 0xe1, 0x7e, 0x02, 0xcd, 0xe1, 0x72, 0x30, 0x92, 0xbf, 0xde, 0xdc, 0xda, 0xb7, 0xd6, 0x7b,
+	0x13, 0xd1, 0xc6, 0x83, 0x6d, 0x7c, 0xfa, 0x93, 0x8f, 0xbb, 0x6e, 0x74, 0xdc, 0x3f, 0x5c, 0xb3,
+	0x59, 0xef, 0xce, 0x57, 0xae, 0xe7, 0xb9, 0x5f, 0x45, 0xd4, 0x3e, 0xbe, 0x23, 0xb9, 0x7c, 0x5f,
+	0xf6, 0xbf, 0x63, 0xb3, 0x50, 0xfd, 0x6f, 0xc2, 0x1d, 0x09, 0x09, 0x0e, 0x0f, 0xa7, 0xb1, 0xfd,
+	0xe1, 0xff, 0x0c, 0x00, 0xad, 0x6b, 0xa2, 0x81, 0x7a, 0x41, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3154,6 +4900,10 @@ type MilvusBackupServiceClient interface {
 	GetRestore(ctx context.Context, in *GetRestoreStateRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error)
 	// Check connections
 	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	// Get events of a backup or restore, wait for new events if there is none
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
+	// Remove partial backups left by crashed backup processes
+	CleanupOrphans(ctx context.Context, in *CleanupOrphansRequest, opts ...grpc.CallOption) (*CleanupOrphansResponse, error)
 }
 
 type milvusBackupServiceClient struct {
@@ -3227,6 +4977,24 @@ func (c *milvusBackupServiceClient) Check(ctx context.Context, in *CheckRequest,
 	return out, nil
 }
 
+func (c *milvusBackupServiceClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
+	out := new(GetEventsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.backup.MilvusBackupService/GetEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusBackupServiceClient) CleanupOrphans(ctx context.Context, in *CleanupOrphansRequest, opts ...grpc.CallOption) (*CleanupOrphansResponse, error) {
+	out := new(CleanupOrphansResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.backup.MilvusBackupService/CleanupOrphans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MilvusBackupServiceServer is the server API for MilvusBackupService service.
 type MilvusBackupServiceServer interface {
 	// Create backup
@@ -3243,6 +5011,10 @@ type MilvusBackupServiceServer interface {
 	GetRestore(context.Context, *GetRestoreStateRequest) (*RestoreBackupResponse, error)
 	// Check connections
 	Check(context.Context, *CheckRequest) (*CheckResponse, error)
+	// Get events of a backup or restore, wait for new events if there is none
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	// Remove partial backups left by crashed backup processes
+	CleanupOrphans(context.Context, *CleanupOrphansRequest) (*CleanupOrphansResponse, error)
 }
 
 // UnimplementedMilvusBackupServiceServer can be embedded to have forward compatible implementations.
@@ -3270,6 +5042,12 @@ func (*UnimplementedMilvusBackupServiceServer) GetRestore(ctx context.Context, r
 func (*UnimplementedMilvusBackupServiceServer) Check(ctx context.Context, req *CheckRequest) (*CheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
 }
+func (*UnimplementedMilvusBackupServiceServer) GetEvents(ctx context.Context, req *GetEventsRequest) (*GetEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+}
+func (*UnimplementedMilvusBackupServiceServer) CleanupOrphans(ctx context.Context, req *CleanupOrphansRequest) (*CleanupOrphansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CleanupOrphans not implemented")
+}
 
 func RegisterMilvusBackupServiceServer(s *grpc.Server, srv MilvusBackupServiceServer) {
 	s.RegisterService(&_MilvusBackupService_serviceDesc, srv)
@@ -3401,6 +5179,42 @@ func _MilvusBackupService_Check_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusBackupService_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusBackupServiceServer).GetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.backup.MilvusBackupService/GetEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusBackupServiceServer).GetEvents(ctx, req.(*GetEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusBackupService_CleanupOrphans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CleanupOrphansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusBackupServiceServer).CleanupOrphans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.backup.MilvusBackupService/CleanupOrphans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusBackupServiceServer).CleanupOrphans(ctx, req.(*CleanupOrphansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MilvusBackupService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.backup.MilvusBackupService",
 	HandlerType: (*MilvusBackupServiceServer)(nil),
@@ -3433,6 +5247,14 @@ var _MilvusBackupService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Check",
 			Handler:    _MilvusBackupService_Check_Handler,
 		},
+		{
+			MethodName: "GetEvents",
+			Handler:    _MilvusBackupService_GetEvents_Handler,
+		},
+		{
+			MethodName: "CleanupOrphans",
+			Handler:    _MilvusBackupService_CleanupOrphans_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "backup.proto",
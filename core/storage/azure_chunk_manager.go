@@ -21,7 +21,9 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
@@ -30,13 +32,15 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/zilliztech/milvus-backup/core/paramtable"
 	"github.com/zilliztech/milvus-backup/internal/log"
 	"github.com/zilliztech/milvus-backup/internal/util/errorutil"
 )
 
 // AzureChunkManager is responsible for read and write data stored in minio.
 type AzureChunkManager struct {
-	aos *AzureObjectStorage
+	aos      *AzureObjectStorage
+	copyMode string
 
 	//cli *azblob.Client
 	//	ctx        context.Context
@@ -57,7 +61,8 @@ func NewAzureChunkManager(ctx context.Context, c *config) (*AzureChunkManager, e
 	//	return nil, err
 	//}
 	mcm := &AzureChunkManager{
-		aos: aos,
+		aos:      aos,
+		copyMode: c.copyMode,
 		//cli:        cli,
 		//bucketName: c.bucketName,
 		//rootPath:   strings.TrimLeft(c.rootPath, "/"),
@@ -79,15 +84,40 @@ func (mcm *AzureChunkManager) Copy(ctx context.Context, fromBucketName string, t
 	}
 	for _, objectkey := range objectkeys {
 		dstObjectKey := strings.Replace(objectkey, fromPath, toPath, 1)
-		err := mcm.aos.CopyObject(ctx, fromBucketName, toBucketName, objectkey, dstObjectKey)
+		var err error
+		if mcm.copyMode == paramtable.CopyModeClient {
+			err = mcm.clientCopyObject(ctx, fromBucketName, toBucketName, objectkey, dstObjectKey)
+		} else {
+			err = mcm.aos.CopyObject(ctx, fromBucketName, toBucketName, objectkey, dstObjectKey)
+			var respErr *azcore.ResponseError
+			if err != nil && mcm.copyMode != paramtable.CopyModeServer && errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotImplemented {
+				log.Warn("server-side copy is not implemented, fallback to client-side copy, set minio.copyMode to client to skip trying it",
+					zap.String("srcObjectKey", objectkey), zap.Error(err))
+				err = mcm.clientCopyObject(ctx, fromBucketName, toBucketName, objectkey, dstObjectKey)
+			}
+		}
 		if err != nil {
-			log.Error("copyObject error", zap.String("srcObjectKey", objectkey), zap.String("dstObjectKey", dstObjectKey), zap.Error(err))
+			log.Error("copyObject error", zap.String("srcObjectKey", objectkey), zap.String("dstObjectKey", dstObjectKey), zap.String("copyMode", mcm.copyMode), zap.Error(err))
 			return err
 		}
 	}
 	return nil
 }
 
+// clientCopyObject downloads the blob and uploads it, for the setups whose server-side copy doesn't work
+func (mcm *AzureChunkManager) clientCopyObject(ctx context.Context, fromBucketName, toBucketName, fromPath, toPath string) error {
+	size, err := mcm.getObjectSize(ctx, fromBucketName, fromPath)
+	if err != nil {
+		return err
+	}
+	reader, err := mcm.getObject(ctx, fromBucketName, fromPath, 0, size)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	return mcm.putObject(ctx, toBucketName, toPath, reader, size)
+}
+
 // Path returns the path of minio data if exists.
 func (mcm *AzureChunkManager) Path(ctx context.Context, bucketName string, filePath string) (string, error) {
 	exist, err := mcm.Exist(ctx, bucketName, filePath)
@@ -132,6 +162,15 @@ func (mcm *AzureChunkManager) Write(ctx context.Context, bucketName string, file
 	return nil
 }
 
+func (mcm *AzureChunkManager) WriteStream(ctx context.Context, bucketName string, filePath string, reader io.Reader, size int64) error {
+	err := mcm.putObject(ctx, bucketName, filePath, reader, size)
+	if err != nil {
+		log.Warn("failed to put object", zap.String("bucket", bucketName), zap.String("path", filePath), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 // MultiWrite saves multiple objects, the path is the key of @kvs.
 // The object value is the value of @kvs.
 func (mcm *AzureChunkManager) MultiWrite(ctx context.Context, bucketName string, kvs map[string][]byte) error {
@@ -338,6 +377,15 @@ func (mcm *AzureChunkManager) ListWithPrefix(ctx context.Context, bucketName str
 	}
 }
 
+func (mcm *AzureChunkManager) ListWithPrefixPage(ctx context.Context, bucketName string, prefix string, recursive bool, startAfter string, limit int) ([]string, []int64, string, error) {
+	objectsKeys, sizes, err := mcm.ListWithPrefix(ctx, bucketName, prefix, recursive)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	pageKeys, pageSizes, nextToken := pageObjects(objectsKeys, sizes, startAfter, limit)
+	return pageKeys, pageSizes, nextToken, nil
+}
+
 func (mcm *AzureChunkManager) getObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error) {
 	//resp, err := mcm.cli.DownloadStream(ctx, bucketName, objectName, nil)
 	//if err != nil {
@@ -381,6 +429,10 @@ func (mcm *AzureChunkManager) getObjectSize(ctx context.Context, bucketName, obj
 	return info, err
 }
 
+func (mcm *AzureChunkManager) LastModified(ctx context.Context, bucketName string, prefix string) (time.Time, error) {
+	return mcm.aos.LastModified(ctx, bucketName, prefix)
+}
+
 func (mcm *AzureChunkManager) listObjects(ctx context.Context, bucketName string, prefix string, recursive bool) (map[string]int64, error) {
 	res, err := mcm.aos.ListObjects(ctx, bucketName, prefix, recursive)
 	return res, err
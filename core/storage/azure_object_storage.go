@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"time"
 
@@ -69,11 +70,11 @@ type AzureObjectStorage struct {
 //}
 
 func newAzureObjectStorageWithConfig(ctx context.Context, c *config) (*AzureObjectStorage, error) {
-	client, err := newAzureObjectClient(ctx, c.address, c.accessKeyID, c.secretAccessKeyID, c.bucketName, c.useIAM, c.createBucket)
+	client, err := newAzureObjectClient(ctx, c.address, c.accessKeyID, c.secretAccessKeyID, c.bucketName, c.useIAM, c.createBucket, azureClientOptions(c))
 	if err != nil {
 		return nil, err
 	}
-	backupClient, err := newAzureObjectClient(ctx, c.address, c.backupAccessKeyID, c.backupSecretAccessKeyID, c.backupBucketName, c.useIAM, c.createBucket)
+	backupClient, err := newAzureObjectClient(ctx, c.address, c.backupAccessKeyID, c.backupSecretAccessKeyID, c.backupBucketName, c.useIAM, c.createBucket, azureClientOptions(c))
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +88,16 @@ func newAzureObjectStorageWithConfig(ctx context.Context, c *config) (*AzureObje
 	}, nil
 }
 
-func newAzureObjectClient(ctx context.Context, address, accessKeyID, secretAccessKeyID, bucketName string, useIAM, createBucket bool) (*innerAzureClient, error) {
+// azureClientOptions applies the transport override of c to both source and backup clients
+func azureClientOptions(c *config) *service.ClientOptions {
+	if !hasTransportOverride(c) {
+		return &service.ClientOptions{}
+	}
+	tr := overrideTransport(http.DefaultTransport.(*http.Transport).Clone(), c)
+	return &service.ClientOptions{ClientOptions: azcore.ClientOptions{Transport: &http.Client{Transport: tr}}}
+}
+
+func newAzureObjectClient(ctx context.Context, address, accessKeyID, secretAccessKeyID, bucketName string, useIAM, createBucket bool, clientOptions *service.ClientOptions) (*innerAzureClient, error) {
 	var client *service.Client
 	var err error
 	if useIAM {
@@ -99,11 +109,11 @@ func newAzureObjectClient(ctx context.Context, address, accessKeyID, secretAcces
 		if credErr != nil {
 			return nil, credErr
 		}
-		client, err = service.NewClient("https://"+accessKeyID+".blob."+address+"/", cred, &service.ClientOptions{})
+		client, err = service.NewClient("https://"+accessKeyID+".blob."+address+"/", cred, clientOptions)
 	} else {
 		connectionString := "DefaultEndpointsProtocol=https;AccountName=" + accessKeyID +
 			";AccountKey=" + secretAccessKeyID + ";EndpointSuffix=" + address
-		client, err = service.NewClientFromConnectionString(connectionString, &service.ClientOptions{})
+		client, err = service.NewClientFromConnectionString(connectionString, clientOptions)
 	}
 	if err != nil {
 		return nil, err
@@ -195,6 +205,25 @@ func (aos *AzureObjectStorage) ListObjects(ctx context.Context, bucketName strin
 	return objects, nil
 }
 
+func (aos *AzureObjectStorage) LastModified(ctx context.Context, bucketName string, prefix string) (time.Time, error) {
+	pager := aos.clients[bucketName].client.NewContainerClient(bucketName).NewListBlobsFlatPager(&azblob.ListBlobsFlatOptions{
+		Prefix: &prefix,
+	})
+	var lastModified time.Time
+	for pager.More() {
+		pageResp, err := pager.NextPage(ctx)
+		if err != nil {
+			return time.Time{}, err
+		}
+		for _, blob := range pageResp.Segment.BlobItems {
+			if blob.Properties.LastModified != nil && blob.Properties.LastModified.After(lastModified) {
+				lastModified = *blob.Properties.LastModified
+			}
+		}
+	}
+	return lastModified, nil
+}
+
 func (aos *AzureObjectStorage) RemoveObject(ctx context.Context, bucketName, objectName string) error {
 	_, err := aos.clients[bucketName].client.NewContainerClient(bucketName).NewBlockBlobClient(objectName).Delete(ctx, &blob.DeleteOptions{})
 	return err
@@ -2,20 +2,33 @@ package storage
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/zilliztech/milvus-backup/core/paramtable"
 )
 
 func NewChunkManager(ctx context.Context, params paramtable.BackupParams) (ChunkManager, error) {
+	var cm ChunkManager
+	var err error
 	engine := params.MinioCfg.StorageType
-	switch engine {
-	case paramtable.Local:
-		return newLocalChunkManagerWithParams(ctx, params)
-	case paramtable.CloudProviderAzure:
-		return newAzureChunkManagerWithParams(ctx, params)
+	switch {
+	case params.MinioCfg.HasSeparateBackupStorage():
+		cm, err = newMixedChunkManagerWithParams(ctx, &params.MinioCfg)
+	case engine == paramtable.Local:
+		cm, err = newLocalChunkManagerWithParams(ctx, params)
+	case engine == paramtable.CloudProviderAzure:
+		cm, err = newAzureChunkManagerWithParams(ctx, params)
 	default:
-		return newMinioChunkManagerWithParams(ctx, params)
+		cm, err = newMinioChunkManagerWithParams(ctx, params)
 	}
+	if err != nil {
+		return nil, err
+	}
+	if params.MinioCfg.MaxConcurrentRequests > 0 {
+		return NewLimitedChunkManager(cm, params.MinioCfg.MaxConcurrentRequests), nil
+	}
+	return cm, nil
 }
 
 func newMinioChunkManagerWithParams(ctx context.Context, params paramtable.BackupParams) (*MinioChunkManager, error) {
@@ -30,6 +43,14 @@ func newMinioChunkManagerWithParams(ctx context.Context, params paramtable.Backu
 	c.storageType = params.MinioCfg.StorageType
 	c.useIAM = params.MinioCfg.UseIAM
 	c.iamEndpoint = params.MinioCfg.IAMEndpoint
+	c.endpointOverride = params.MinioCfg.EndpointOverride
+	c.insecureSkipVerify = params.MinioCfg.InsecureSkipVerify
+	c.region = params.MinioCfg.Region
+	c.detectBucketRegion = params.MinioCfg.DetectBucketRegion
+	c.copyMode = params.MinioCfg.CopyMode
+	c.requestTimeout = time.Duration(params.MinioCfg.RequestTimeoutSeconds) * time.Second
+	c.dialTimeout = time.Duration(params.MinioCfg.DialTimeoutSeconds) * time.Second
+	c.maxIdleConns = params.MinioCfg.MaxIdleConns
 	c.createBucket = true
 	return newMinioChunkManagerWithConfig(ctx, c)
 }
@@ -46,6 +67,12 @@ func newAzureChunkManagerWithParams(ctx context.Context, params paramtable.Backu
 	c.storageType = params.MinioCfg.StorageType
 	c.useIAM = params.MinioCfg.UseIAM
 	c.iamEndpoint = params.MinioCfg.IAMEndpoint
+	c.endpointOverride = params.MinioCfg.EndpointOverride
+	c.insecureSkipVerify = params.MinioCfg.InsecureSkipVerify
+	c.copyMode = params.MinioCfg.CopyMode
+	c.requestTimeout = time.Duration(params.MinioCfg.RequestTimeoutSeconds) * time.Second
+	c.dialTimeout = time.Duration(params.MinioCfg.DialTimeoutSeconds) * time.Second
+	c.maxIdleConns = params.MinioCfg.MaxIdleConns
 	c.createBucket = true
 
 	c.backupAccessKeyID = params.MinioCfg.BackupAccessKeyID
@@ -65,3 +92,27 @@ func newLocalChunkManagerWithParams(ctx context.Context, params paramtable.Backu
 
 	return NewLocalChunkManager(ctx, c)
 }
+
+// pageObjects sorts the listed objects and cuts out the page after startAfter,
+// for the storages which can not list from a key natively
+func pageObjects(keys []string, sizes []int64, startAfter string, limit int) ([]string, []int64, string) {
+	idx := make([]int, len(keys))
+	for i := range keys {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool { return keys[idx[i]] < keys[idx[j]] })
+
+	pageKeys := make([]string, 0)
+	pageSizes := make([]int64, 0)
+	for _, i := range idx {
+		if keys[i] <= startAfter {
+			continue
+		}
+		if limit > 0 && len(pageKeys) == limit {
+			return pageKeys, pageSizes, pageKeys[len(pageKeys)-1]
+		}
+		pageKeys = append(pageKeys, keys[i])
+		pageSizes = append(pageSizes, sizes[i])
+	}
+	return pageKeys, pageSizes, ""
+}
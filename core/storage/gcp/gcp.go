@@ -91,6 +91,10 @@ func NewMinioClient(address string, opts *minio.Options) (*minio.Client, error)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create default transport")
 	}
+	if opts.Transport != nil {
+		// keep the customized transport, e.g. endpoint override
+		transport.backend = opts.Transport
+	}
 	opts.Transport = transport
 	opts.Creds = credentials.NewStaticV2("", "", "")
 	return minio.New(address, opts)
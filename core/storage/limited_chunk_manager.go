@@ -0,0 +1,143 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// LimitedChunkManager limits the concurrent requests of all the callers of the wrapped chunk manager,
+// so the worker pools together don't open more connections than the object store allows.
+// Each call takes one slot, Copy handles its objects one by one, RemoveWithPrefix still removes in parallel within its slot.
+// A reader keeps its slot until it is closed. WriteStream takes no slot, its content is streamed from a reader holding one,
+// so a streamed copy takes one slot like Copy and never holds a slot while waiting for a second one.
+// Every method is implemented explicitly instead of embedding the wrapped chunk manager, so that a method added to
+// ChunkManager can't bypass the limit.
+type LimitedChunkManager struct {
+	cm  ChunkManager
+	sem chan struct{}
+}
+
+var _ ChunkManager = (*LimitedChunkManager)(nil)
+
+func NewLimitedChunkManager(cm ChunkManager, maxConcurrentRequests int) *LimitedChunkManager {
+	return &LimitedChunkManager{
+		cm:  cm,
+		sem: make(chan struct{}, maxConcurrentRequests),
+	}
+}
+
+func (lcm *LimitedChunkManager) acquire(ctx context.Context) error {
+	select {
+	case lcm.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (lcm *LimitedChunkManager) release() {
+	<-lcm.sem
+}
+
+func (lcm *LimitedChunkManager) Write(ctx context.Context, bucketName string, filePath string, content []byte) error {
+	if err := lcm.acquire(ctx); err != nil {
+		return err
+	}
+	defer lcm.release()
+	return lcm.cm.Write(ctx, bucketName, filePath, content)
+}
+
+func (lcm *LimitedChunkManager) WriteStream(ctx context.Context, bucketName string, filePath string, reader io.Reader, size int64) error {
+	return lcm.cm.WriteStream(ctx, bucketName, filePath, reader, size)
+}
+
+func (lcm *LimitedChunkManager) Exist(ctx context.Context, bucketName string, filePath string) (bool, error) {
+	if err := lcm.acquire(ctx); err != nil {
+		return false, err
+	}
+	defer lcm.release()
+	return lcm.cm.Exist(ctx, bucketName, filePath)
+}
+
+func (lcm *LimitedChunkManager) Read(ctx context.Context, bucketName string, filePath string) ([]byte, error) {
+	if err := lcm.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer lcm.release()
+	return lcm.cm.Read(ctx, bucketName, filePath)
+}
+
+func (lcm *LimitedChunkManager) Reader(ctx context.Context, bucketName string, filePath string) (FileReader, error) {
+	if err := lcm.acquire(ctx); err != nil {
+		return nil, err
+	}
+	reader, err := lcm.cm.Reader(ctx, bucketName, filePath)
+	if err != nil {
+		lcm.release()
+		return nil, err
+	}
+	return &limitedReader{FileReader: reader, release: lcm.release}, nil
+}
+
+// limitedReader releases the slot of the reader when it is closed
+type limitedReader struct {
+	FileReader
+	release func()
+	once    sync.Once
+}
+
+func (r *limitedReader) Close() error {
+	err := r.FileReader.Close()
+	r.once.Do(r.release)
+	return err
+}
+
+func (lcm *LimitedChunkManager) ListWithPrefix(ctx context.Context, bucketName string, prefix string, recursive bool) ([]string, []int64, error) {
+	if err := lcm.acquire(ctx); err != nil {
+		return nil, nil, err
+	}
+	defer lcm.release()
+	return lcm.cm.ListWithPrefix(ctx, bucketName, prefix, recursive)
+}
+
+func (lcm *LimitedChunkManager) ListWithPrefixPage(ctx context.Context, bucketName string, prefix string, recursive bool, startAfter string, limit int) ([]string, []int64, string, error) {
+	if err := lcm.acquire(ctx); err != nil {
+		return nil, nil, "", err
+	}
+	defer lcm.release()
+	return lcm.cm.ListWithPrefixPage(ctx, bucketName, prefix, recursive, startAfter, limit)
+}
+
+func (lcm *LimitedChunkManager) Remove(ctx context.Context, bucketName string, filePath string) error {
+	if err := lcm.acquire(ctx); err != nil {
+		return err
+	}
+	defer lcm.release()
+	return lcm.cm.Remove(ctx, bucketName, filePath)
+}
+
+func (lcm *LimitedChunkManager) RemoveWithPrefix(ctx context.Context, bucketName string, prefix string) error {
+	if err := lcm.acquire(ctx); err != nil {
+		return err
+	}
+	defer lcm.release()
+	return lcm.cm.RemoveWithPrefix(ctx, bucketName, prefix)
+}
+
+func (lcm *LimitedChunkManager) Copy(ctx context.Context, fromBucketName string, toBucketName string, fromPath string, toPath string) error {
+	if err := lcm.acquire(ctx); err != nil {
+		return err
+	}
+	defer lcm.release()
+	return lcm.cm.Copy(ctx, fromBucketName, toBucketName, fromPath, toPath)
+}
+
+func (lcm *LimitedChunkManager) LastModified(ctx context.Context, bucketName string, prefix string) (time.Time, error) {
+	if err := lcm.acquire(ctx); err != nil {
+		return time.Time{}, err
+	}
+	defer lcm.release()
+	return lcm.cm.LastModified(ctx, bucketName, prefix)
+}
@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// countingChunkManager records the max number of its concurrent Exist calls
+type countingChunkManager struct {
+	ChunkManager
+	running    int32
+	maxRunning int32
+}
+
+func (c *countingChunkManager) Exist(ctx context.Context, bucketName string, filePath string) (bool, error) {
+	running := atomic.AddInt32(&c.running, 1)
+	defer atomic.AddInt32(&c.running, -1)
+	for {
+		maxRunning := atomic.LoadInt32(&c.maxRunning)
+		if running <= maxRunning || atomic.CompareAndSwapInt32(&c.maxRunning, maxRunning, running) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	return true, nil
+}
+
+func (c *countingChunkManager) Reader(ctx context.Context, bucketName string, filePath string) (FileReader, error) {
+	return io.NopCloser(strings.NewReader(filePath)), nil
+}
+
+func TestLimitedChunkManager(t *testing.T) {
+	counting := &countingChunkManager{}
+	lcm := NewLimitedChunkManager(counting, 3)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			exist, err := lcm.Exist(context.Background(), "bucket", "a")
+			assert.NoError(t, err)
+			assert.True(t, exist)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(3), atomic.LoadInt32(&counting.maxRunning))
+
+	// a reader keeps its slot until it is closed
+	reader, err := lcm.Reader(context.Background(), "bucket", "a")
+	assert.NoError(t, err)
+	assert.Len(t, lcm.sem, 1)
+	assert.NoError(t, reader.Close())
+	assert.NoError(t, reader.Close())
+	assert.Len(t, lcm.sem, 0)
+
+	// a caller waiting for a slot gives up with its context
+	for i := 0; i < 3; i++ {
+		lcm.sem <- struct{}{}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = lcm.Exist(ctx, "bucket", "a")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -25,6 +25,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 
@@ -86,6 +87,22 @@ func (lcm *LocalChunkManager) Write(ctx context.Context, bucketName string, file
 	return WriteFile(filePath, content, os.ModePerm)
 }
 
+// WriteStream writes the content of the reader to local storage.
+func (lcm *LocalChunkManager) WriteStream(ctx context.Context, bucketName string, filePath string, reader io.Reader, size int64) error {
+	if err := os.MkdirAll(path.Dir(filePath), os.ModePerm); err != nil {
+		return WrapErrFileNotFound(filePath)
+	}
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, os.ModePerm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, reader); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
 // Exist checks whether chunk is saved to local storage.
 func (lcm *LocalChunkManager) Exist(ctx context.Context, bucketName string, filePath string) (bool, error) {
 	_, err := os.Stat(filePath)
@@ -103,6 +120,18 @@ func (lcm *LocalChunkManager) Read(ctx context.Context, bucketName string, fileP
 	return ReadFile(filePath)
 }
 
+// Reader opens the local file if exists.
+func (lcm *LocalChunkManager) Reader(ctx context.Context, bucketName string, filePath string) (FileReader, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, WrapErrFileNotFound(filePath)
+		}
+		return nil, err
+	}
+	return file, nil
+}
+
 func (lcm *LocalChunkManager) ListWithPrefix(ctx context.Context, bucketName string, prefix string, recursive bool) ([]string, []int64, error) {
 	var filePaths []string
 	var sizes []int64
@@ -143,6 +172,32 @@ func (lcm *LocalChunkManager) ListWithPrefix(ctx context.Context, bucketName str
 	return filePaths, sizes, nil
 }
 
+func (lcm *LocalChunkManager) ListWithPrefixPage(ctx context.Context, bucketName string, prefix string, recursive bool, startAfter string, limit int) ([]string, []int64, string, error) {
+	objectsKeys, sizes, err := lcm.ListWithPrefix(ctx, bucketName, prefix, recursive)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	pageKeys, pageSizes, nextToken := pageObjects(objectsKeys, sizes, startAfter, limit)
+	return pageKeys, pageSizes, nextToken, nil
+}
+
+func (lcm *LocalChunkManager) LastModified(ctx context.Context, bucketName string, prefix string) (time.Time, error) {
+	var lastModified time.Time
+	err := filepath.Walk(filepath.Dir(prefix), func(filePath string, f os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(filePath, prefix) && !f.IsDir() && f.ModTime().After(lastModified) {
+			lastModified = f.ModTime()
+		}
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return lastModified, nil
+}
+
 func (lcm *LocalChunkManager) Size(ctx context.Context, bucketName string, filePath string) (int64, error) {
 	fi, err := os.Stat(filePath)
 	if err != nil {
@@ -8,7 +8,9 @@ import (
 	"github.com/zilliztech/milvus-backup/core/storage/tencent"
 	"golang.org/x/sync/errgroup"
 	"io"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -45,6 +47,7 @@ type MinioChunkManager struct {
 	//	ctx        context.Context
 	bucketName string
 	rootPath   string
+	copyMode   string
 }
 
 var _ ChunkManager = (*MinioChunkManager)(nil)
@@ -64,6 +67,8 @@ func newMinioChunkManagerWithConfig(ctx context.Context, c *config) (*MinioChunk
 	var creds *credentials.Credentials
 	var newMinioFn = minio.New
 	var bucketLookupType = minio.BucketLookupAuto
+	// the other providers have their own region handling in the endpoints
+	var detectRegion = false
 
 	switch c.storageType {
 	case paramtable.CloudProviderAliyun:
@@ -100,6 +105,7 @@ func newMinioChunkManagerWithConfig(ctx context.Context, c *config) (*MinioChunk
 			creds = credentials.NewStaticV4(c.accessKeyID, c.secretAccessKeyID, "")
 		}
 	default: // aws, minio
+		detectRegion = c.detectBucketRegion
 		if c.useIAM {
 			creds = credentials.NewIAM("")
 		} else {
@@ -110,6 +116,14 @@ func newMinioChunkManagerWithConfig(ctx context.Context, c *config) (*MinioChunk
 		BucketLookup: bucketLookupType,
 		Creds:        creds,
 		Secure:       c.useSSL,
+		Region:       c.region,
+	}
+	if hasTransportOverride(c) {
+		tr, err := minio.DefaultTransport(c.useSSL)
+		if err != nil {
+			return nil, err
+		}
+		minioOpts.Transport = overrideTransport(tr, c)
 	}
 	minIOClient, err := newMinioFn(c.address, minioOpts)
 	// options nil or invalid formatted endpoint, don't need to retry
@@ -142,16 +156,33 @@ func newMinioChunkManagerWithConfig(ctx context.Context, c *config) (*MinioChunk
 	if err != nil {
 		return nil, err
 	}
+	// a configured region is used as it is
+	if detectRegion && c.region == "" {
+		detectBucketRegion(ctx, minIOClient, c)
+	}
 
 	mcm := &MinioChunkManager{
 		Client:     minIOClient,
 		bucketName: c.bucketName,
+		copyMode:   c.copyMode,
 	}
 	mcm.rootPath = mcm.normalizeRootPath(c.rootPath)
 	log.Info("minio chunk manager init success.", zap.String("bucketname", c.bucketName), zap.String("root", mcm.RootPath()))
 	return mcm, nil
 }
 
+// detectBucketRegion looks up the region of the bucket and logs it. The region is only detected if none is configured,
+// it is not pinned on the client, which keeps looking up the region of each bucket it accesses.
+func detectBucketRegion(ctx context.Context, client *minio.Client, c *config) {
+	region, err := client.GetBucketLocation(ctx, c.bucketName)
+	if err != nil {
+		log.Warn("fail to detect bucket region, disable minio.detectBucketRegion if the store doesn't support it",
+			zap.String("bucket", c.bucketName), zap.Error(err))
+		return
+	}
+	log.Info("detected bucket region", zap.String("bucket", c.bucketName), zap.String("detectedRegion", region))
+}
+
 // normalizeRootPath
 func (mcm *MinioChunkManager) normalizeRootPath(rootPath string) string {
 	// no leading "/"
@@ -213,6 +244,15 @@ func (mcm *MinioChunkManager) Write(ctx context.Context, bucketName string, file
 	return nil
 }
 
+func (mcm *MinioChunkManager) WriteStream(ctx context.Context, bucketName string, filePath string, reader io.Reader, size int64) error {
+	_, err := mcm.Client.PutObject(ctx, bucketName, filePath, reader, size, minio.PutObjectOptions{})
+	if err != nil {
+		log.Warn("failed to put object", zap.String("path", filePath), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 // MultiWrite saves multiple objects, the path is the key of @kvs.
 // The object value is the value of @kvs.
 func (mcm *MinioChunkManager) MultiWrite(ctx context.Context, bucketName string, kvs map[string][]byte) error {
@@ -434,6 +474,47 @@ func (mcm *MinioChunkManager) ListWithPrefix(ctx context.Context, bucketName str
 	return objectsKeys, sizes, nil
 }
 
+func (mcm *MinioChunkManager) ListWithPrefixPage(ctx context.Context, bucketName string, prefix string, recursive bool, startAfter string, limit int) ([]string, []int64, string, error) {
+	// stop the listing goroutine once the page is full
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	objects := mcm.Client.ListObjects(ctx, bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: recursive, StartAfter: startAfter})
+	objectsKeys := make([]string, 0)
+	sizes := make([]int64, 0)
+
+	for object := range objects {
+		if object.Err != nil {
+			log.Warn("failed to list with prefix", zap.String("bucket", bucketName), zap.String("prefix", prefix), zap.String("startAfter", startAfter), zap.Error(object.Err))
+			return nil, nil, "", object.Err
+		}
+		// the objects under startAfter are rolled up into the same common prefix again when not recursive
+		if object.Key <= startAfter {
+			continue
+		}
+		if limit > 0 && len(objectsKeys) == limit {
+			return objectsKeys, sizes, objectsKeys[len(objectsKeys)-1], nil
+		}
+		objectsKeys = append(objectsKeys, object.Key)
+		sizes = append(sizes, object.Size)
+	}
+	return objectsKeys, sizes, "", nil
+}
+
+func (mcm *MinioChunkManager) LastModified(ctx context.Context, bucketName string, prefix string) (time.Time, error) {
+	objects := mcm.Client.ListObjects(ctx, bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true})
+	var lastModified time.Time
+	for object := range objects {
+		if object.Err != nil {
+			log.Warn("failed to list with prefix", zap.String("bucket", bucketName), zap.String("prefix", prefix), zap.Error(object.Err))
+			return time.Time{}, object.Err
+		}
+		if object.LastModified.After(lastModified) {
+			lastModified = object.LastModified
+		}
+	}
+	return lastModified, nil
+}
+
 func (mcm *MinioChunkManager) Copy(ctx context.Context, fromBucketName string, toBucketName string, fromPath string, toPath string) error {
 	objectkeys, _, err := mcm.ListWithPrefix(ctx, fromBucketName, fromPath, true)
 	if err != nil {
@@ -441,15 +522,25 @@ func (mcm *MinioChunkManager) Copy(ctx context.Context, fromBucketName string, t
 		return err
 	}
 	for _, objectkey := range objectkeys {
-		src := minio.CopySrcOptions{Bucket: fromBucketName, Object: objectkey}
 		dstObjectKey := strings.Replace(objectkey, fromPath, toPath, 1)
-		dst := minio.CopyDestOptions{Bucket: toBucketName, Object: dstObjectKey}
-
-		_, err = mcm.Client.CopyObject(ctx, dst, src)
+		if mcm.copyMode == paramtable.CopyModeClient {
+			err = mcm.clientCopyObject(ctx, fromBucketName, toBucketName, objectkey, dstObjectKey)
+		} else {
+			src := minio.CopySrcOptions{Bucket: fromBucketName, Object: objectkey}
+			dst := minio.CopyDestOptions{Bucket: toBucketName, Object: dstObjectKey}
+			_, err = mcm.Client.CopyObject(ctx, dst, src)
+			if err != nil && mcm.copyMode != paramtable.CopyModeServer && isCopyNotImplemented(err) {
+				log.Warn("server-side copy is not implemented, fallback to client-side copy, set minio.copyMode to client to skip trying it",
+					zap.String("srcObjectKey", objectkey),
+					zap.Error(err))
+				err = mcm.clientCopyObject(ctx, fromBucketName, toBucketName, objectkey, dstObjectKey)
+			}
+		}
 		if err != nil {
 			log.Error("copyObject error",
 				zap.String("srcObjectKey", objectkey),
 				zap.String("dstObjectKey", dstObjectKey),
+				zap.String("copyMode", mcm.copyMode),
 				zap.Error(err))
 			return err
 		}
@@ -457,6 +548,26 @@ func (mcm *MinioChunkManager) Copy(ctx context.Context, fromBucketName string, t
 	return nil
 }
 
+// clientCopyObject downloads the object and uploads it, for the stores whose server-side copy doesn't work
+func (mcm *MinioChunkManager) clientCopyObject(ctx context.Context, fromBucketName, toBucketName, fromKey, toKey string) error {
+	object, err := mcm.Client.GetObject(ctx, fromBucketName, fromKey, minio.GetObjectOptions{})
+	if err != nil {
+		return err
+	}
+	defer object.Close()
+	info, err := object.Stat()
+	if err != nil {
+		return err
+	}
+	_, err = mcm.Client.PutObject(ctx, toBucketName, toKey, object, info.Size, minio.PutObjectOptions{})
+	return err
+}
+
+func isCopyNotImplemented(err error) bool {
+	errResponse := minio.ToErrorResponse(err)
+	return errResponse.StatusCode == http.StatusNotImplemented || errResponse.Code == "NotImplemented"
+}
+
 // Learn from file.ReadFile
 func Read(r io.Reader, size int64) ([]byte, error) {
 	data := make([]byte, 0, size)
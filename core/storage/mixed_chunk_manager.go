@@ -0,0 +1,189 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zilliztech/milvus-backup/core/paramtable"
+	"github.com/zilliztech/milvus-backup/internal/log"
+)
+
+// MixedChunkManager serves the backup bucket from another storage than the milvus buckets, e.g. milvus on aliyun and
+// backups on aws. Requests are routed by the bucket name, so the backup bucket name must differ from the milvus ones.
+// Copies between the storages are streamed through the backup tool, server-side copy can't cross storages.
+type MixedChunkManager struct {
+	source       ChunkManager
+	backup       ChunkManager
+	backupBucket string
+}
+
+var _ ChunkManager = (*MixedChunkManager)(nil)
+
+func NewMixedChunkManager(source ChunkManager, backup ChunkManager, backupBucket string) *MixedChunkManager {
+	return &MixedChunkManager{source: source, backup: backup, backupBucket: backupBucket}
+}
+
+func (m *MixedChunkManager) route(bucketName string) ChunkManager {
+	if bucketName == m.backupBucket {
+		return m.backup
+	}
+	return m.source
+}
+
+func (m *MixedChunkManager) Write(ctx context.Context, bucketName string, filePath string, content []byte) error {
+	return m.route(bucketName).Write(ctx, bucketName, filePath, content)
+}
+
+func (m *MixedChunkManager) WriteStream(ctx context.Context, bucketName string, filePath string, reader io.Reader, size int64) error {
+	return m.route(bucketName).WriteStream(ctx, bucketName, filePath, reader, size)
+}
+
+func (m *MixedChunkManager) Exist(ctx context.Context, bucketName string, filePath string) (bool, error) {
+	return m.route(bucketName).Exist(ctx, bucketName, filePath)
+}
+
+func (m *MixedChunkManager) Read(ctx context.Context, bucketName string, filePath string) ([]byte, error) {
+	return m.route(bucketName).Read(ctx, bucketName, filePath)
+}
+
+func (m *MixedChunkManager) Reader(ctx context.Context, bucketName string, filePath string) (FileReader, error) {
+	return m.route(bucketName).Reader(ctx, bucketName, filePath)
+}
+
+func (m *MixedChunkManager) ListWithPrefix(ctx context.Context, bucketName string, prefix string, recursive bool) ([]string, []int64, error) {
+	return m.route(bucketName).ListWithPrefix(ctx, bucketName, prefix, recursive)
+}
+
+func (m *MixedChunkManager) ListWithPrefixPage(ctx context.Context, bucketName string, prefix string, recursive bool, startAfter string, limit int) ([]string, []int64, string, error) {
+	return m.route(bucketName).ListWithPrefixPage(ctx, bucketName, prefix, recursive, startAfter, limit)
+}
+
+func (m *MixedChunkManager) Remove(ctx context.Context, bucketName string, filePath string) error {
+	return m.route(bucketName).Remove(ctx, bucketName, filePath)
+}
+
+func (m *MixedChunkManager) RemoveWithPrefix(ctx context.Context, bucketName string, prefix string) error {
+	return m.route(bucketName).RemoveWithPrefix(ctx, bucketName, prefix)
+}
+
+func (m *MixedChunkManager) LastModified(ctx context.Context, bucketName string, prefix string) (time.Time, error) {
+	return m.route(bucketName).LastModified(ctx, bucketName, prefix)
+}
+
+// Copy uses the copy of the storage if both buckets are in it, otherwise streams each object from the download
+// into the upload, an object is never held in memory as a whole
+func (m *MixedChunkManager) Copy(ctx context.Context, fromBucketName string, toBucketName string, fromPath string, toPath string) error {
+	from, to := m.route(fromBucketName), m.route(toBucketName)
+	if from == to {
+		return from.Copy(ctx, fromBucketName, toBucketName, fromPath, toPath)
+	}
+	objectKeys, sizes, err := from.ListWithPrefix(ctx, fromBucketName, fromPath, true)
+	if err != nil {
+		log.Warn("listWithPrefix error", zap.String("bucket", fromBucketName), zap.String("prefix", fromPath), zap.Error(err))
+		return err
+	}
+	for i, objectKey := range objectKeys {
+		dstObjectKey := strings.Replace(objectKey, fromPath, toPath, 1)
+		if err := copyObjectStream(ctx, from, to, fromBucketName, toBucketName, objectKey, dstObjectKey, sizes[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyObjectStream pipes one object from the download of a storage into the upload to another storage
+func copyObjectStream(ctx context.Context, from, to ChunkManager, fromBucketName, toBucketName, srcObjectKey, dstObjectKey string, size int64) error {
+	reader, err := from.Reader(ctx, fromBucketName, srcObjectKey)
+	if err != nil {
+		log.Error("fail to download object to copy across storages",
+			zap.String("bucket", fromBucketName), zap.String("srcObjectKey", srcObjectKey), zap.Error(err))
+		return err
+	}
+	defer reader.Close()
+	if err := to.WriteStream(ctx, toBucketName, dstObjectKey, reader, size); err != nil {
+		log.Error("fail to upload object to copy across storages",
+			zap.String("bucket", toBucketName), zap.String("dstObjectKey", dstObjectKey), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// newMixedChunkManagerWithParams connects the milvus storage and the separate backup storage
+func newMixedChunkManagerWithParams(ctx context.Context, cfg *paramtable.MinioConfig) (*MixedChunkManager, error) {
+	if cfg.BackupBucketName == cfg.BucketName {
+		return nil, fmt.Errorf("backup bucket %s in a separate backup storage must not have the name of the milvus bucket", cfg.BackupBucketName)
+	}
+	if cfg.CopyMode == paramtable.CopyModeServer {
+		return nil, errors.New("minio.copyMode server can not copy between the milvus storage and a separate backup storage")
+	}
+	log.Info("backup bucket is in a separate storage",
+		zap.String("storageType", cfg.StorageType),
+		zap.String("address", cfg.Address),
+		zap.String("backupStorageType", cfg.BackupStorageType),
+		zap.String("backupAddress", cfg.BackupAddress))
+
+	source := newDefaultConfig()
+	source.storageType = cfg.StorageType
+	source.address = cfg.Address + ":" + cfg.Port
+	source.accessKeyID = cfg.AccessKeyID
+	source.secretAccessKeyID = cfg.SecretAccessKey
+	source.useSSL = cfg.UseSSL
+	source.useIAM = cfg.UseIAM
+	source.bucketName = cfg.BucketName
+	source.rootPath = cfg.RootPath
+	// the milvus bucket must exist
+	source.createBucket = false
+
+	backup := newDefaultConfig()
+	backup.storageType = cfg.BackupStorageType
+	backup.address = cfg.BackupAddress + ":" + cfg.BackupPort
+	backup.accessKeyID = cfg.BackupAccessKeyID
+	backup.secretAccessKeyID = cfg.BackupSecretAccessKey
+	backup.useSSL = cfg.BackupUseSSL
+	backup.useIAM = cfg.BackupUseIAM
+	backup.bucketName = cfg.BackupBucketName
+	backup.rootPath = cfg.BackupRootPath
+	backup.createBucket = true
+
+	for _, c := range []*config{source, backup} {
+		c.iamEndpoint = cfg.IAMEndpoint
+		c.endpointOverride = cfg.EndpointOverride
+		c.insecureSkipVerify = cfg.InsecureSkipVerify
+		c.region = cfg.Region
+		c.detectBucketRegion = cfg.DetectBucketRegion
+		c.copyMode = cfg.CopyMode
+		c.requestTimeout = time.Duration(cfg.RequestTimeoutSeconds) * time.Second
+		c.dialTimeout = time.Duration(cfg.DialTimeoutSeconds) * time.Second
+		c.maxIdleConns = cfg.MaxIdleConns
+		c.backupRootPath = cfg.BackupRootPath
+	}
+
+	sourceCM, err := newSingleStorageChunkManager(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("fail to connect milvus storage, err: %w", err)
+	}
+	backupCM, err := newSingleStorageChunkManager(ctx, backup)
+	if err != nil {
+		return nil, fmt.Errorf("fail to connect backup storage, err: %w", err)
+	}
+	return NewMixedChunkManager(sourceCM, backupCM, cfg.BackupBucketName), nil
+}
+
+// newSingleStorageChunkManager connects one storage of a mixed chunk manager, azure needs both of the accounts
+// in one client and can't be one side yet
+func newSingleStorageChunkManager(ctx context.Context, c *config) (ChunkManager, error) {
+	switch c.storageType {
+	case paramtable.Local:
+		return NewLocalChunkManager(ctx, c)
+	case paramtable.CloudProviderAzure:
+		return nil, errors.New("azure is not supported with a separate backup storage yet")
+	default:
+		return newMinioChunkManagerWithConfig(ctx, c)
+	}
+}
@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMixedChunkManagerCopy(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	sourceCfg, backupCfg := newDefaultConfig(), newDefaultConfig()
+	sourceCfg.rootPath = filepath.Join(dir, "milvus")
+	backupCfg.rootPath = filepath.Join(dir, "backup")
+	source, err := NewLocalChunkManager(ctx, sourceCfg)
+	assert.NoError(t, err)
+	backup, err := NewLocalChunkManager(ctx, backupCfg)
+	assert.NoError(t, err)
+	mixed := NewMixedChunkManager(source, backup, "backup-bucket")
+
+	fromPath := filepath.Join(sourceCfg.rootPath, "insert_log", "1")
+	toPath := filepath.Join(backupCfg.rootPath, "backups", "b1", "insert_log", "1")
+	objects := map[string][]byte{
+		"100/0/1001": []byte("binlog of field 0"),
+		"100/1/1002": make([]byte, 1<<20),
+		"101/0/1003": {},
+	}
+	for key, content := range objects {
+		assert.NoError(t, mixed.Write(ctx, "milvus-bucket", filepath.Join(fromPath, key), content))
+	}
+
+	// the storages differ, the objects are streamed from one local chunk manager into the other
+	assert.NoError(t, mixed.Copy(ctx, "milvus-bucket", "backup-bucket", fromPath, toPath))
+	for key, content := range objects {
+		copied, err := mixed.Read(ctx, "backup-bucket", filepath.Join(toPath, key))
+		assert.NoError(t, err)
+		assert.Equal(t, content, copied)
+	}
+	keys, _, err := mixed.ListWithPrefix(ctx, "backup-bucket", toPath, true)
+	assert.NoError(t, err)
+	assert.Len(t, keys, len(objects))
+
+	// a missing source object fails the copy
+	reader, err := mixed.Reader(ctx, "milvus-bucket", filepath.Join(fromPath, "missing"))
+	assert.ErrorIs(t, err, ErrNoSuchKey)
+	assert.Nil(t, reader)
+}
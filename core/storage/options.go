@@ -1,5 +1,7 @@
 package storage
 
+import "time"
+
 // Option for setting params used by chunk manager client.
 type config struct {
 	address           string
@@ -12,6 +14,20 @@ type config struct {
 	useIAM            bool
 	iamEndpoint       string
 
+	// dial this host[:port] instead of the resolved address
+	endpointOverride string
+	// skip TLS certificate verification, only for internal endpoints
+	insecureSkipVerify bool
+	// region of the buckets, and whether to look it up from the bucket
+	region             string
+	detectBucketRegion bool
+	// auto, server or client, see paramtable.CopyModeAuto
+	copyMode string
+	// 0 to use the defaults of the http transport
+	requestTimeout time.Duration
+	dialTimeout    time.Duration
+	maxIdleConns   int
+
 	// deprecated
 	cloudProvider string
 	storageType   string
@@ -81,3 +97,39 @@ func IAMEndpoint(iamEndpoint string) Option {
 		c.iamEndpoint = iamEndpoint
 	}
 }
+
+func EndpointOverride(endpointOverride string) Option {
+	return func(c *config) {
+		c.endpointOverride = endpointOverride
+	}
+}
+
+func InsecureSkipVerify(insecureSkipVerify bool) Option {
+	return func(c *config) {
+		c.insecureSkipVerify = insecureSkipVerify
+	}
+}
+
+func CopyMode(copyMode string) Option {
+	return func(c *config) {
+		c.copyMode = copyMode
+	}
+}
+
+func RequestTimeout(requestTimeout time.Duration) Option {
+	return func(c *config) {
+		c.requestTimeout = requestTimeout
+	}
+}
+
+func DialTimeout(dialTimeout time.Duration) Option {
+	return func(c *config) {
+		c.dialTimeout = dialTimeout
+	}
+}
+
+func MaxIdleConns(maxIdleConns int) Option {
+	return func(c *config) {
+		c.maxIdleConns = maxIdleConns
+	}
+}
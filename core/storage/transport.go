@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/zilliztech/milvus-backup/internal/log"
+)
+
+// hasTransportOverride returns whether the object store connections need a customized transport
+func hasTransportOverride(c *config) bool {
+	return c.endpointOverride != "" || c.insecureSkipVerify ||
+		c.requestTimeout > 0 || c.dialTimeout > 0 || c.maxIdleConns > 0
+}
+
+// overrideTransport makes tr dial endpointOverride instead of the resolved address,
+// the request host is not changed so that virtual host style and signatures still work.
+// It also applies the timeouts and connection pool size of c.
+func overrideTransport(tr *http.Transport, c *config) *http.Transport {
+	if c.endpointOverride != "" || c.dialTimeout > 0 {
+		dialTimeout := 30 * time.Second
+		if c.dialTimeout > 0 {
+			dialTimeout = c.dialTimeout
+		}
+		dialer := &net.Dialer{
+			Timeout:   dialTimeout,
+			KeepAlive: 30 * time.Second,
+		}
+		endpointOverride := c.endpointOverride
+		if endpointOverride != "" {
+			log.Info("object store connections are redirected", zap.String("address", c.address), zap.String("endpointOverride", c.endpointOverride))
+		}
+		tr.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			if endpointOverride == "" {
+				return dialer.DialContext(ctx, network, addr)
+			}
+			target := endpointOverride
+			if _, _, err := net.SplitHostPort(endpointOverride); err != nil {
+				// keep the port of the original address if override has no port
+				_, port, splitErr := net.SplitHostPort(addr)
+				if splitErr != nil {
+					return nil, splitErr
+				}
+				target = net.JoinHostPort(endpointOverride, port)
+			}
+			return dialer.DialContext(ctx, network, target)
+		}
+	}
+	// the time to wait for the response headers, a whole request may take longer to stream a large object
+	if c.requestTimeout > 0 {
+		tr.ResponseHeaderTimeout = c.requestTimeout
+	}
+	// all requests go to the same host, so the per host limit matters for parallel copies
+	if c.maxIdleConns > 0 {
+		tr.MaxIdleConns = c.maxIdleConns
+		tr.MaxIdleConnsPerHost = c.maxIdleConns
+	}
+	if c.insecureSkipVerify {
+		log.Warn("TLS certificate verification of the object store is DISABLED, only use it for internal endpoints",
+			zap.String("address", c.address))
+		if tr.TLSClientConfig == nil {
+			tr.TLSClientConfig = &tls.Config{}
+		}
+		tr.TLSClientConfig.InsecureSkipVerify = true
+	}
+	return tr
+}
@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"io"
+	"time"
 )
 
 type FileReader interface {
@@ -16,14 +17,23 @@ type ChunkManager interface {
 	Write(ctx context.Context, bucketName string, filePath string, content []byte) error
 	// Exist returns true if @filePath exists.
 	Exist(ctx context.Context, bucketName string, filePath string) (bool, error)
+	// WriteStream writes the content of @reader to @filePath, @size is -1 if the size is unknown.
+	WriteStream(ctx context.Context, bucketName string, filePath string, reader io.Reader, size int64) error
 	// Read reads @filePath and returns content.
 	Read(ctx context.Context, bucketName string, filePath string) ([]byte, error)
+	// Reader returns a reader of the content of @filePath, the caller must close it.
+	Reader(ctx context.Context, bucketName string, filePath string) (FileReader, error)
 	// ListWithPrefix list all objects with same @prefix
 	ListWithPrefix(ctx context.Context, bucketName string, prefix string, recursive bool) ([]string, []int64, error)
+	// ListWithPrefixPage list at most @limit objects with same @prefix after @startAfter in lexical order,
+	// the returned token is the @startAfter of the next page, empty if there are no more objects. @limit <= 0 means no limit.
+	ListWithPrefixPage(ctx context.Context, bucketName string, prefix string, recursive bool, startAfter string, limit int) ([]string, []int64, string, error)
 	// Remove delete @filePath.
 	Remove(ctx context.Context, bucketName string, filePath string) error
 	// RemoveWithPrefix remove files with same @prefix.
 	RemoveWithPrefix(ctx context.Context, bucketName string, prefix string) error
 	// Copy files from fromPath into toPath recursively
 	Copy(ctx context.Context, fromBucketName string, toBucketName string, fromPath string, toPath string) error
+	// LastModified returns the latest modified time of the objects with same @prefix, zero time if there is no object.
+	LastModified(ctx context.Context, bucketName string, prefix string) (time.Time, error)
 }
@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	NameTemplateDate    = "{date}"
+	NameTemplateTime    = "{time}"
+	NameTemplateCluster = "{cluster}"
+	NameTemplateSeq     = "{seq}"
+)
+
+// FormatBackupName renders the backup name template, date and time are in UTC.
+// characters not allowed in backup names are replaced by ReplaceInvalidChars.
+func FormatBackupName(template string, now time.Time, cluster string, seq int) string {
+	now = now.UTC()
+	name := strings.NewReplacer(
+		NameTemplateDate, now.Format("20060102"),
+		NameTemplateTime, now.Format("150405"),
+		NameTemplateCluster, cluster,
+		NameTemplateSeq, fmt.Sprint(seq),
+	).Replace(template)
+	return ReplaceInvalidChars(name)
+}
@@ -0,0 +1,18 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatBackupName(t *testing.T) {
+	now := time.Date(2024, 8, 1, 9, 30, 5, 0, time.UTC)
+	name := FormatBackupName("backup_{cluster}_{date}_{time}_{seq}", now, "milvus-prod.local", 3)
+	assert.Equal(t, "backup_milvus_prod_local_20240801_093005_3", name)
+	assert.NoError(t, ValidateType(name, "backup_name"))
+
+	name = FormatBackupName("nightly-{date}", now.In(time.FixedZone("UTC+8", 8*3600)), "", 1)
+	assert.Equal(t, "nightly_20240801", name)
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+var (
+	floatVectorIndexTypes = []string{"FLAT", "IVF_FLAT", "IVF_SQ8", "IVF_PQ", "HNSW", "DISKANN", "SCANN",
+		"GPU_IVF_FLAT", "GPU_IVF_PQ", "GPU_CAGRA", "GPU_BRUTE_FORCE", "AUTOINDEX"}
+	floatVectorMetricTypes  = []string{"L2", "IP", "COSINE"}
+	binaryVectorIndexTypes  = []string{"BIN_FLAT", "BIN_IVF_FLAT", "AUTOINDEX"}
+	binaryVectorMetricTypes = []string{"HAMMING", "JACCARD", "SUBSTRUCTURE", "SUPERSTRUCTURE"}
+	sparseVectorIndexTypes  = []string{"SPARSE_INVERTED_INDEX", "SPARSE_WAND", "AUTOINDEX"}
+	sparseVectorMetricTypes = []string{"IP"}
+	scalarIndexTypes        = []string{"STL_SORT", "TRIE", "MARISA-TRIE", "INVERTED", "BITMAP", "AUTOINDEX"}
+
+	// index params must be positive integers
+	intIndexParams = []string{"nlist", "m", "nbits", "M", "efConstruction"}
+)
+
+// ValidateIndexParams checks the index type and params can be built on a field of dataType
+func ValidateIndexParams(dataType backuppb.DataType, indexType string, params map[string]string) error {
+	var indexTypes, metricTypes []string
+	switch dataType {
+	case backuppb.DataType_FloatVector, backuppb.DataType_Float16Vector, backuppb.DataType_BFloat16Vector:
+		indexTypes, metricTypes = floatVectorIndexTypes, floatVectorMetricTypes
+	case backuppb.DataType_BinaryVector:
+		indexTypes, metricTypes = binaryVectorIndexTypes, binaryVectorMetricTypes
+	case backuppb.DataType_SparseFloatVector:
+		indexTypes, metricTypes = sparseVectorIndexTypes, sparseVectorMetricTypes
+	default:
+		indexTypes = scalarIndexTypes
+	}
+
+	if !containsFold(indexTypes, indexType) {
+		return fmt.Errorf("index type %s is not supported on %s field", indexType, dataType.String())
+	}
+	if metricType, ok := params["metric_type"]; ok {
+		if metricTypes == nil {
+			return fmt.Errorf("metric type is not supported on %s field", dataType.String())
+		}
+		if !containsFold(metricTypes, metricType) {
+			return fmt.Errorf("metric type %s is not supported on %s field", metricType, dataType.String())
+		}
+	}
+	for _, key := range intIndexParams {
+		value, ok := params[key]
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || v <= 0 {
+			return fmt.Errorf("index param %s should be a positive integer, got %s", key, value)
+		}
+	}
+	return nil
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
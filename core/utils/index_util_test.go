@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zilliztech/milvus-backup/core/proto/backuppb"
+)
+
+func TestValidateIndexParams(t *testing.T) {
+	err := ValidateIndexParams(backuppb.DataType_FloatVector, "IVF_FLAT", map[string]string{"nlist": "2048", "metric_type": "COSINE"})
+	assert.NoError(t, err)
+
+	err = ValidateIndexParams(backuppb.DataType_FloatVector, "BIN_IVF_FLAT", map[string]string{"nlist": "2048"})
+	assert.Error(t, err)
+
+	err = ValidateIndexParams(backuppb.DataType_FloatVector, "IVF_FLAT", map[string]string{"metric_type": "HAMMING"})
+	assert.Error(t, err)
+
+	err = ValidateIndexParams(backuppb.DataType_FloatVector, "IVF_FLAT", map[string]string{"nlist": "abc"})
+	assert.Error(t, err)
+
+	err = ValidateIndexParams(backuppb.DataType_BinaryVector, "BIN_IVF_FLAT", map[string]string{"metric_type": "JACCARD"})
+	assert.NoError(t, err)
+
+	err = ValidateIndexParams(backuppb.DataType_VarChar, "Trie", map[string]string{})
+	assert.NoError(t, err)
+
+	err = ValidateIndexParams(backuppb.DataType_Int64, "STL_SORT", map[string]string{"metric_type": "L2"})
+	assert.Error(t, err)
+}
@@ -39,6 +39,34 @@ func ValidateType(entity, entityType string) error {
 	return nil
 }
 
+// ReplaceInvalidChars replaces the characters other than numbers, letters and underscores by underscores,
+// byte by byte, so a multi-byte character becomes several underscores
+func ReplaceInvalidChars(entity string) string {
+	replaced := []byte(entity)
+	for i, c := range replaced {
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			replaced[i] = '_'
+		}
+	}
+	return string(replaced)
+}
+
+// SanitizeName makes a name valid for ValidateType, the characters are replaced by ReplaceInvalidChars,
+// a leading underscore is added if the first character is not a letter or underscore,
+// and the name is truncated to MaxNameLength.
+func SanitizeName(entity string) string {
+	entity = strings.TrimSpace(entity)
+	sanitized := make([]byte, 0, len(entity)+1)
+	if entity == "" || (entity[0] != '_' && !isAlpha(entity[0])) {
+		sanitized = append(sanitized, '_')
+	}
+	sanitized = append(sanitized, ReplaceInvalidChars(entity)...)
+	if len(sanitized) > MaxNameLength {
+		sanitized = sanitized[:MaxNameLength]
+	}
+	return string(sanitized)
+}
+
 // isAlpha check if c is alpha.
 func isAlpha(c uint8) bool {
 	if (c < 'A' || c > 'Z') && (c < 'a' || c > 'z') {
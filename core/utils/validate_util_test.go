@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeName(t *testing.T) {
+	assert.Equal(t, "coll_1", SanitizeName("coll_1"))
+	assert.Equal(t, "my_coll_v2", SanitizeName("my-coll.v2"))
+	assert.Equal(t, "_1coll", SanitizeName("1coll"))
+	assert.Equal(t, "_", SanitizeName(""))
+	// multi-byte characters are replaced byte by byte
+	assert.Equal(t, "_______", SanitizeName("集合"))
+	assert.Equal(t, "1coll_v2", ReplaceInvalidChars("1coll.v2"))
+
+	long := SanitizeName(strings.Repeat("a", MaxNameLength+10))
+	assert.Equal(t, MaxNameLength, len(long))
+	for _, name := range []string{"my-coll.v2", "1coll", "", "集合", strings.Repeat("-", MaxNameLength*2)} {
+		assert.NoError(t, ValidateType(SanitizeName(name), "collection_name"))
+	}
+}
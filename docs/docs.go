@@ -1,5 +1,5 @@
-// Package docs GENERATED BY SWAG; DO NOT EDIT
-// This file was generated by swaggo/swag
+// Code generated by swaggo/swag. DO NOT EDIT.
+
 package docs
 
 import "github.com/swaggo/swag"
@@ -23,6 +23,46 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/cleanup": {
+            "post": {
+                "description": "Remove partial backups without backup meta, which are left by crashed backups",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Backup"
+                ],
+                "summary": "Cleanup orphans interface",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "request_id",
+                        "name": "request_id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "CleanupOrphansRequest JSON",
+                        "name": "object",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/backuppb.CleanupOrphansRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/backuppb.CleanupOrphansResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/create": {
             "post": {
                 "description": "Create a backup with the given name and collections",
@@ -86,6 +126,12 @@ const docTemplate = `{
                         "name": "backup_name",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete the base of incremental backups",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -140,6 +186,53 @@ const docTemplate = `{
                 }
             }
         },
+        "/get_events": {
+            "get": {
+                "description": "Long poll the events of a backup or restore with the given id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Backup"
+                ],
+                "summary": "Get events interface",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "request_id",
+                        "name": "request_id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "backup id or restore id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "only return events after this seq",
+                        "name": "after_seq",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "seconds to wait for new events",
+                        "name": "wait_seconds",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/backuppb.GetEventsResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/get_restore": {
             "get": {
                 "description": "Get restore task state with the given id",
@@ -175,6 +268,36 @@ const docTemplate = `{
                 }
             }
         },
+        "/has_backup": {
+            "get": {
+                "description": "Check whether a backup with the given name exists, without reading the backup",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Backup"
+                ],
+                "summary": "Has backup interface",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "backup_name",
+                        "name": "backup_name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
         "/list": {
             "get": {
                 "description": "List all backups in current storage",
@@ -198,6 +321,18 @@ const docTemplate = `{
                         "name": "collection_name",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_continuation_token of the last page",
+                        "name": "continuation_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "max number of backup dirs to list in one page",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -255,10 +390,25 @@ const docTemplate = `{
         "backuppb.BackupInfo": {
             "type": "object",
             "properties": {
+                "backup_time": {
+                    "description": "latest backup timestamp of the collections in UTC, RFC3339 with milliseconds, the time of backup_timestamp",
+                    "type": "string"
+                },
                 "backup_timestamp": {
-                    "description": "backup timestamp",
+                    "description": "latest backup timestamp of the collections, a hybrid timestamp of milvus.\neach collection contains the data before its own backup timestamp",
                     "type": "integer"
                 },
+                "base_backup_name": {
+                    "description": "base backup of an incremental backup, the segments unchanged since the base are not copied again and are restored from it",
+                    "type": "string"
+                },
+                "binlog_types": {
+                    "description": "binlog types copied in the backup",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "collection_backups": {
                     "description": "array of collection backup",
                     "type": "array",
@@ -266,6 +416,43 @@ const docTemplate = `{
                         "$ref": "#/definitions/backuppb.CollectionBackupInfo"
                     }
                 },
+                "copy_stats": {
+                    "description": "throughput of the binlog copies of the backup, not set for meta only backups",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/backuppb.CopyStats"
+                        }
+                    ]
+                },
+                "database_backups": {
+                    "description": "databases of the source cluster, only set if backup_databases in the request",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/backuppb.DatabaseBackupInfo"
+                    }
+                },
+                "encrypted_data_key": {
+                    "description": "random key of the binlogs of the backup, encrypted by the key derived from the passphrase",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "encryption_key_id": {
+                    "description": "backup.encryption.keyId when the backup is created, which passphrase the backup needs",
+                    "type": "string"
+                },
+                "encryption_salt": {
+                    "description": "salt of the scrypt derivation of the key encrypting the data key from the passphrase",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "encryption_scheme": {
+                    "description": "client side encryption of the binlogs, AES-256-GCM, empty if the binlogs are not encrypted",
+                    "type": "string"
+                },
                 "end_time": {
                     "type": "integer"
                 },
@@ -275,6 +462,10 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "milvus_root_path": {
+                    "description": "rootPath of the source milvus, binlog paths in the backup meta are under it",
+                    "type": "string"
+                },
                 "milvus_version": {
                     "type": "string"
                 },
@@ -284,14 +475,44 @@ const docTemplate = `{
                 "progress": {
                     "type": "integer"
                 },
+                "resumed": {
+                    "description": "the backup is resumed from an interrupted one by resume of the request",
+                    "type": "boolean"
+                },
+                "schema_template_only": {
+                    "description": "schema template backup, only contains schema, index and properties of collections",
+                    "type": "boolean"
+                },
+                "segment_meta_shards": {
+                    "description": "number of the segment_meta_\u003ci\u003e.json files the segment meta is split into, 0 means a single segment_meta.json",
+                    "type": "integer"
+                },
                 "size": {
                     "type": "integer"
                 },
+                "skipped_collections": {
+                    "description": "collections dropped or timed out during the backup and skipped because of continue_on_error, format db.collection",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "snapshot_spread_ms": {
+                    "description": "max difference between backup timestamps of the collections in milliseconds",
+                    "type": "integer"
+                },
                 "start_time": {
                     "type": "integer"
                 },
                 "state_code": {
                     "$ref": "#/definitions/backuppb.BackupTaskStateCode"
+                },
+                "unlocated_segment_ids": {
+                    "description": "segments returned by flush but not found in the collections, they are not in the backup",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 }
             }
         },
@@ -353,6 +574,10 @@ const docTemplate = `{
                 "log_size": {
                     "type": "integer"
                 },
+                "sha256": {
+                    "description": "hex sha256 of the binlog, set if backup.verifyChecksum is enabled",
+                    "type": "string"
+                },
                 "timestamp_from": {
                     "type": "integer"
                 },
@@ -361,13 +586,81 @@ const docTemplate = `{
                 }
             }
         },
+        "backuppb.CleanupOrphansRequest": {
+            "type": "object",
+            "properties": {
+                "continuation_token": {
+                    "description": "continue cleanup after the backup dirs of the last page, got from next_continuation_token",
+                    "type": "string"
+                },
+                "dry_run": {
+                    "description": "only list the orphan backups, don't remove them",
+                    "type": "boolean"
+                },
+                "grace_period_seconds": {
+                    "description": "orphan backups modified within the grace period are kept, default 24h if not set",
+                    "type": "integer"
+                },
+                "limit": {
+                    "description": "max number of backup dirs to check in one page, check all if not set",
+                    "type": "integer"
+                },
+                "requestId": {
+                    "description": "uuid of request, will generate one if not set",
+                    "type": "string"
+                }
+            }
+        },
+        "backuppb.CleanupOrphansResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "response code. 0 means success. others are fail",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/backuppb.ResponseCode"
+                        }
+                    ]
+                },
+                "msg": {
+                    "description": "error msg if fail",
+                    "type": "string"
+                },
+                "next_continuation_token": {
+                    "description": "pass it as continuation_token to cleanup the next page, empty if all backups are checked",
+                    "type": "string"
+                },
+                "orphans": {
+                    "description": "names of the orphan backups, removed if not dry run",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "requestId": {
+                    "description": "uuid of the request to response",
+                    "type": "string"
+                }
+            }
+        },
         "backuppb.CollectionBackupInfo": {
             "type": "object",
             "properties": {
+                "aliases": {
+                    "description": "aliases of the collection in its database",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "backup_physical_timestamp": {
                     "description": "physical unix time of backup",
                     "type": "integer"
                 },
+                "backup_time": {
+                    "description": "backup_timestamp in UTC, RFC3339 with milliseconds",
+                    "type": "string"
+                },
                 "backup_timestamp": {
                     "description": "logical time of backup, used for restore",
                     "type": "integer"
@@ -415,8 +708,13 @@ const docTemplate = `{
                     }
                 },
                 "load_state": {
+                    "description": "NotLoad, Loading or Loaded at backup time",
                     "type": "string"
                 },
+                "num_partitions": {
+                    "description": "num_partitions of a partition key collection set at creation, 0 if unknown or no partition key",
+                    "type": "integer"
+                },
                 "partition_backups": {
                     "type": "array",
                     "items": {
@@ -426,9 +724,26 @@ const docTemplate = `{
                 "progress": {
                     "type": "integer"
                 },
+                "properties": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "row_count": {
+                    "description": "row count of the collection from GetCollectionStatistics at backup time",
+                    "type": "integer"
+                },
                 "schema": {
                     "$ref": "#/definitions/backuppb.CollectionSchema"
                 },
+                "shard_channels": {
+                    "description": "virtual and physical channels of the shards at backup time, set if backup.captureShardChannels is enabled.\nonly for tracing the backup data to the shards, restore doesn't use them",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/backuppb.ShardChannel"
+                    }
+                },
                 "shards_num": {
                     "type": "integer"
                 },
@@ -461,6 +776,13 @@ const docTemplate = `{
                         "$ref": "#/definitions/backuppb.FieldSchema"
                     }
                 },
+                "functions": {
+                    "description": "functions of the collection, read from the raw describe response. the milvus sdk in use can't create them,\nrestore rejects a backup that has functions instead of silently dropping them",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/backuppb.FunctionSchema"
+                    }
+                },
                 "name": {
                     "type": "string"
                 }
@@ -483,6 +805,33 @@ const docTemplate = `{
                 "ConsistencyLevel_Customized"
             ]
         },
+        "backuppb.CopyStats": {
+            "type": "object",
+            "properties": {
+                "avg_mb_per_second": {
+                    "description": "copied_bytes over elapsed_ms",
+                    "type": "number"
+                },
+                "copied_bytes": {
+                    "type": "integer"
+                },
+                "copied_objects": {
+                    "type": "integer"
+                },
+                "copy_time_ms": {
+                    "description": "time spent in the copy calls of all workers in milliseconds, much less than elapsed_ms * parallelism\nmeans the backup waits on listing binlogs or milvus instead of the storage",
+                    "type": "integer"
+                },
+                "elapsed_ms": {
+                    "description": "wall time of the copy phase in milliseconds, including the list of binlogs",
+                    "type": "integer"
+                },
+                "provider": {
+                    "description": "storage types of the milvus bucket and the backup bucket, like minio-\u003eaws",
+                    "type": "string"
+                }
+            }
+        },
         "backuppb.CreateBackupRequest": {
             "type": "object",
             "properties": {
@@ -490,10 +839,32 @@ const docTemplate = `{
                     "description": "async or not",
                     "type": "boolean"
                 },
+                "backup_databases": {
+                    "description": "backup all databases of the cluster with their properties and collection names, to recreate them in restore",
+                    "type": "boolean"
+                },
                 "backup_name": {
                     "description": "backup name, will generate one if not set",
                     "type": "string"
                 },
+                "base_backup_name": {
+                    "description": "create an incremental backup on top of the backup with the name, only the segments new or changed since it are copied.\nThe base must be a complete backup with data, and is needed to restore the incremental backup.",
+                    "type": "string"
+                },
+                "binlog_types": {
+                    "description": "binlog types to copy, support insert, delta, stats and index. insert is required. empty to use backup.binlogTypes in config",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "collection_ids": {
+                    "description": "ids of the collections to backup, resolved to the current names when the backup starts.\ncan not be used with collection_names or db_collections",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "collection_names": {
                     "description": "collection names to backup, empty to backup all",
                     "type": "array",
@@ -501,8 +872,12 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "continue_on_error": {
+                    "description": "if true, skip the collections dropped during the backup or exceeding backup.collectionCopyTimeoutSeconds\nand record them in skipped_collections of the backup, otherwise the backup fails on them",
+                    "type": "boolean"
+                },
                 "db_collections": {
-                    "description": "database and collections to backup. A json string. To support database. 2023.7.7",
+                    "description": "database and collections to backup. A json string. To support database. 2023.7.7\na collection can also be {\"name\": \"coll\", \"force\": true} to skip flush of only this collection",
                     "type": "string"
                 },
                 "force": {
@@ -521,16 +896,43 @@ const docTemplate = `{
                     "description": "gc pause seconds, set it larger than the time cost of backup",
                     "type": "integer"
                 },
+                "max_snapshot_spread_seconds": {
+                    "description": "fail the backup if backup timestamps of the collections differ by more than it, 0 to use backup.maxSnapshotSpreadSeconds in config",
+                    "type": "integer"
+                },
                 "meta_only": {
                     "description": "only backup meta, including collection schema and index info",
                     "type": "boolean"
                 },
-                "requestId": {
-                    "description": "uuid of request, will generate one if not set",
+                "partition_scope": {
+                    "description": "partitions of the collections to backup: all, default_only or exclude_default. empty means all.\npartition key collections only support all",
                     "type": "string"
-                }
-            }
-        },
+                },
+                "property_selector": {
+                    "description": "only backup the collections having all the properties, among the collections selected by the other fields,\ne.g. {\"tier\": \"gold\"} with no collections set backups all the collections with property tier=gold",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "requestId": {
+                    "description": "uuid of request, will generate one if not set",
+                    "type": "string"
+                },
+                "resume": {
+                    "description": "resume the interrupted backup with the name instead of failing because it exists, the collections prepared by it\nare not flushed again and the binlogs already copied with the recorded sizes are not copied again",
+                    "type": "boolean"
+                },
+                "schema_template_only": {
+                    "description": "only backup schema, index and properties of collections, without flush and segments",
+                    "type": "boolean"
+                },
+                "verify": {
+                    "description": "after backup, check all the segments existing at the flush of the collections are backed up",
+                    "type": "boolean"
+                }
+            }
+        },
         "backuppb.DataType": {
             "type": "integer",
             "enum": [
@@ -572,6 +974,27 @@ const docTemplate = `{
                 "DataType_SparseFloatVector"
             ]
         },
+        "backuppb.DatabaseBackupInfo": {
+            "type": "object",
+            "properties": {
+                "collection_names": {
+                    "description": "all collections in the database, including the ones not in the backup",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "db_name": {
+                    "type": "string"
+                },
+                "properties": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "backuppb.DeleteBackupResponse": {
             "type": "object",
             "properties": {
@@ -637,6 +1060,9 @@ const docTemplate = `{
                 "is_dynamic": {
                     "type": "boolean"
                 },
+                "is_function_output": {
+                    "type": "boolean"
+                },
                 "is_partition_key": {
                     "type": "boolean"
                 },
@@ -672,6 +1098,94 @@ const docTemplate = `{
                 "FieldState_FieldDropped"
             ]
         },
+        "backuppb.FunctionSchema": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "input_field_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "input_field_names": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "output_field_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "output_field_names": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "params": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/backuppb.KeyValuePair"
+                    }
+                },
+                "type": {
+                    "$ref": "#/definitions/backuppb.FunctionType"
+                }
+            }
+        },
+        "backuppb.FunctionType": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2
+            ],
+            "x-enum-varnames": [
+                "FunctionType_Unknown",
+                "FunctionType_BM25",
+                "FunctionType_TextEmbedding"
+            ]
+        },
+        "backuppb.GetEventsResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "response code. 0 means success. others are fail",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/backuppb.ResponseCode"
+                        }
+                    ]
+                },
+                "data": {
+                    "description": "events in seq order",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/backuppb.OperationEvent"
+                    }
+                },
+                "msg": {
+                    "description": "error msg if fail",
+                    "type": "string"
+                },
+                "requestId": {
+                    "description": "uuid of the request to response",
+                    "type": "string"
+                }
+            }
+        },
         "backuppb.IndexInfo": {
             "type": "object",
             "properties": {
@@ -692,6 +1206,30 @@ const docTemplate = `{
                 }
             }
         },
+        "backuppb.IndexParamOverride": {
+            "type": "object",
+            "properties": {
+                "collection_name": {
+                    "description": "collection in backup, format db.collection, db can be omitted for default db. empty means all collections",
+                    "type": "string"
+                },
+                "field_name": {
+                    "description": "field of the index",
+                    "type": "string"
+                },
+                "index_type": {
+                    "description": "new index type, keep the original one if empty",
+                    "type": "string"
+                },
+                "params": {
+                    "description": "params merged into the original index params, e.g. nlist, metric_type",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "backuppb.KeyValuePair": {
             "type": "object",
             "properties": {
@@ -725,12 +1263,42 @@ const docTemplate = `{
                     "description": "error msg if fail",
                     "type": "string"
                 },
+                "next_continuation_token": {
+                    "description": "pass it as continuation_token to list the next page, empty if all backups are listed",
+                    "type": "string"
+                },
                 "requestId": {
                     "description": "uuid of the request to response",
                     "type": "string"
                 }
             }
         },
+        "backuppb.OperationEvent": {
+            "type": "object",
+            "properties": {
+                "collection_name": {
+                    "type": "string"
+                },
+                "db_name": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "seq": {
+                    "description": "sequence number of the event in an operation, starts from 1",
+                    "type": "integer"
+                },
+                "time": {
+                    "description": "unix time of the event",
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "event type, state/collection_start/collection_finish/collection_fail/progress",
+                    "type": "string"
+                }
+            }
+        },
         "backuppb.PartitionBackupInfo": {
             "type": "object",
             "properties": {
@@ -784,6 +1352,10 @@ const docTemplate = `{
                     "description": "execute asynchronously or not",
                     "type": "boolean"
                 },
+                "auto_reload_previously_loaded": {
+                    "description": "if true load the collections and partitions which were loaded or loading at backup time after restore, index is needed to load",
+                    "type": "boolean"
+                },
                 "backup_name": {
                     "description": "backup name to restore",
                     "type": "string"
@@ -792,6 +1364,14 @@ const docTemplate = `{
                     "description": "if bucket_name and path is set. will override bucket/path in config.",
                     "type": "string"
                 },
+                "build_index_before_import": {
+                    "description": "if true create the indexes before importing the data, otherwise after the import, which is usually faster.\nonly works with restoreIndex",
+                    "type": "boolean"
+                },
+                "checkPrivileges": {
+                    "description": "if true, check the milvus user has the privileges needed by the restore before starting, only for clusters with RBAC",
+                    "type": "boolean"
+                },
                 "collection_names": {
                     "description": "collections to restore",
                     "type": "array",
@@ -810,10 +1390,22 @@ const docTemplate = `{
                     "description": "Support two ways to rename the collections while recover\n1, set a suffix",
                     "type": "string"
                 },
+                "continueOnError": {
+                    "description": "if true, keep restoring the remaining collections when one collection fails",
+                    "type": "boolean"
+                },
+                "create_missing_database": {
+                    "description": "if true create the target databases which don't exist, otherwise the restore fails on them",
+                    "type": "boolean"
+                },
                 "db_collections": {
-                    "description": "database and collections to restore. A json string. for example: {\"db1\":[\"collection1\"],\"db2\":[\"collection2\",\"collection3\"]}",
+                    "description": "database and collections to restore. A json string. for example: {\"db1\":[\"collection1\"],\"db2\":[\"collection2\",\"collection3\"]}\na collection can also be {\"name\": \"coll\", \"shards_num\": 4} to create this collection with another shards num",
                     "type": "string"
                 },
+                "delta_only": {
+                    "description": "if true only import the delta logs of the backup as deletions into the existing collections, which must have the schema\nand primary key of the backup. For the case the data was restored separately but the deletions were lost.\nNeeds skipCreateCollection and a milvus supporting l0 import.",
+                    "type": "boolean"
+                },
                 "dropExistCollection": {
                     "description": "if true, drop existing target collection before create",
                     "type": "boolean"
@@ -822,9 +1414,32 @@ const docTemplate = `{
                     "description": "if true, drop existing index of target collection before create",
                     "type": "boolean"
                 },
+                "dynamic_field": {
+                    "description": "dynamic field of the created collections: empty keeps the one of the backup, disable drops the dynamic field\nand its data, enable adds an empty dynamic field to the collections without it, only with meta_only if they have data",
+                    "type": "string"
+                },
+                "existing_collection_policy": {
+                    "description": "with skipCreateCollection, what to do with an existing collection having rows: empty imports the data beside them,\nfail fails the restore. An existing empty collection is always restored into.",
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "index_overrides": {
+                    "description": "override index params in backup when restoreIndex, e.g. change nlist or metric type",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/backuppb.IndexParamOverride"
+                    }
+                },
+                "latest_name_pattern": {
+                    "description": "if backup_name is \"latest\", restore the complete backup with the latest start time whose name matches this glob pattern,\ne.g. \"daily_*\", all the backups match if not set. Only the backups containing all the collection_names are considered.",
+                    "type": "string"
+                },
+                "load_restored_partitions_only": {
+                    "description": "if true auto_reload_previously_loaded only loads the restored partitions which were loaded, instead of the whole collection,\nfor restoring a partition scoped backup into an existing collection",
+                    "type": "boolean"
+                },
                 "metaOnly": {
                     "description": "if true only restore meta, not restore data",
                     "type": "boolean"
@@ -841,6 +1456,22 @@ const docTemplate = `{
                     "description": "if true restore index info",
                     "type": "boolean"
                 },
+                "restore_aliases": {
+                    "description": "if true, create the aliases of the collections in the backup after all the collections are restored,\nthe aliases are created in the target databases and point to the target collections",
+                    "type": "boolean"
+                },
+                "restore_collection_properties": {
+                    "description": "if true, create the collections with the properties of the backup, e.g. collection.ttl.seconds,\notherwise they are created with the default properties of the target milvus",
+                    "type": "boolean"
+                },
+                "restore_databases": {
+                    "description": "if true, create all the databases in the backup with their properties before restoring collections, for full cluster restore",
+                    "type": "boolean"
+                },
+                "sanitize_collection_names": {
+                    "description": "if true, target collection names invalid under the naming rules of milvus are sanitized instead of failing the restore,\nillegal characters are replaced by '_' and too long names are truncated, the renames are returned in the restore task",
+                    "type": "boolean"
+                },
                 "skipCreateCollection": {
                     "description": "if true, will skip collection, use when collection exist, restore index or data",
                     "type": "boolean"
@@ -849,9 +1480,20 @@ const docTemplate = `{
                     "description": "if true, skip the diskQuota in Import",
                     "type": "boolean"
                 },
+                "timeout_seconds": {
+                    "description": "timeout of the whole restore in seconds, 0 to use backup.restoreTimeoutSeconds in config",
+                    "type": "integer"
+                },
                 "useAutoIndex": {
                     "description": "if true use autoindex when restore vector index",
                     "type": "boolean"
+                },
+                "vector_transformers": {
+                    "description": "opt in transforming the vectors of float vector fields before bulkinsert, e.g. to restore into another dimension.\nIt needs the insert binlogs rewritten, which is very expensive and not supported yet, so only noop is accepted.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/backuppb.VectorTransformerOption"
+                    }
                 }
             }
         },
@@ -887,6 +1529,10 @@ const docTemplate = `{
         "backuppb.RestoreBackupTask": {
             "type": "object",
             "properties": {
+                "backup_name": {
+                    "description": "name of the restored backup, the resolved one if the request asked for the latest backup",
+                    "type": "string"
+                },
                 "collection_restore_tasks": {
                     "type": "array",
                     "items": {
@@ -908,6 +1554,13 @@ const docTemplate = `{
                 "restored_size": {
                     "type": "integer"
                 },
+                "sanitized_collection_names": {
+                    "description": "db.collection of the invalid target names -\u003e db.collection sanitized by sanitize_collection_names",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "start_time": {
                     "type": "integer"
                 },
@@ -922,9 +1575,21 @@ const docTemplate = `{
         "backuppb.RestoreCollectionTask": {
             "type": "object",
             "properties": {
+                "auto_reload_previously_loaded": {
+                    "description": "if true load the collection or partitions loaded at backup time after restore",
+                    "type": "boolean"
+                },
+                "build_index_before_import": {
+                    "description": "if true create the indexes before importing the data",
+                    "type": "boolean"
+                },
                 "coll_backup": {
                     "$ref": "#/definitions/backuppb.CollectionBackupInfo"
                 },
+                "delta_only": {
+                    "description": "if true only import the delta logs as deletions",
+                    "type": "boolean"
+                },
                 "dropExistCollection": {
                     "description": "if true drop the collections",
                     "type": "boolean"
@@ -933,6 +1598,10 @@ const docTemplate = `{
                     "description": "if true drop index info",
                     "type": "boolean"
                 },
+                "dynamic_field": {
+                    "description": "dynamic_field of the restore request",
+                    "type": "string"
+                },
                 "end_time": {
                     "type": "integer"
                 },
@@ -942,6 +1611,17 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "index_overrides": {
+                    "description": "index overrides matching this collection",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/backuppb.IndexParamOverride"
+                    }
+                },
+                "load_restored_partitions_only": {
+                    "description": "if true only load the restored partitions when auto_reload_previously_loaded",
+                    "type": "boolean"
+                },
                 "metaOnly": {
                     "description": "if true only restore meta",
                     "type": "boolean"
@@ -959,9 +1639,21 @@ const docTemplate = `{
                     "description": "if true restore index info",
                     "type": "boolean"
                 },
+                "restore_aliases": {
+                    "description": "if true create the aliases of the collection after all collections of the restore are done",
+                    "type": "boolean"
+                },
+                "restore_collection_properties": {
+                    "description": "if true create the collection with the properties of the backup",
+                    "type": "boolean"
+                },
                 "restored_size": {
                     "type": "integer"
                 },
+                "shards_num": {
+                    "description": "shards num to create the collection with, 0 means the shards num of the backup",
+                    "type": "integer"
+                },
                 "skipCreateCollection": {
                     "description": "if true will skip create collections",
                     "type": "boolean"
@@ -969,12 +1661,26 @@ const docTemplate = `{
                 "skipDiskQuotaCheck": {
                     "type": "boolean"
                 },
+                "source_collection_id": {
+                    "type": "integer"
+                },
+                "source_collection_name": {
+                    "type": "string"
+                },
+                "source_db_name": {
+                    "description": "the source collection in the backup and the id of the restored collection, to trace the restored collection back",
+                    "type": "string"
+                },
                 "start_time": {
                     "type": "integer"
                 },
                 "state_code": {
                     "$ref": "#/definitions/backuppb.RestoreTaskStateCode"
                 },
+                "target_collection_id": {
+                    "description": "set once the target collection is created or found, 0 before that",
+                    "type": "integer"
+                },
                 "target_collection_name": {
                     "type": "string"
                 },
@@ -1005,14 +1711,26 @@ const docTemplate = `{
                 "part_backup": {
                     "$ref": "#/definitions/backuppb.PartitionBackupInfo"
                 },
+                "partition_id": {
+                    "type": "integer"
+                },
+                "partition_name": {
+                    "type": "string"
+                },
                 "progress": {
                     "type": "integer"
                 },
+                "restored_size": {
+                    "type": "integer"
+                },
                 "start_time": {
                     "type": "integer"
                 },
                 "state_code": {
                     "$ref": "#/definitions/backuppb.RestoreTaskStateCode"
+                },
+                "to_restore_size": {
+                    "type": "integer"
                 }
             }
         },
@@ -1039,6 +1757,10 @@ const docTemplate = `{
                 "backuped": {
                     "type": "boolean"
                 },
+                "base_backup_name": {
+                    "description": "name of the backup holding the binlogs of the segment, set if the segment is reused from the base of an incremental backup",
+                    "type": "string"
+                },
                 "binlogs": {
                     "type": "array",
                     "items": {
@@ -1058,6 +1780,13 @@ const docTemplate = `{
                     "description": "separate segments into multi groups by size,\nsegments in one group will be copied into one directory during backup\nand will bulkinsert in one call during restore",
                     "type": "integer"
                 },
+                "index_files": {
+                    "description": "index files built by milvus for the segment, only copied with the binlog type index",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/backuppb.SegmentIndexFiles"
+                    }
+                },
                 "is_l0": {
                     "type": "boolean"
                 },
@@ -1081,11 +1810,64 @@ const docTemplate = `{
                 }
             }
         },
+        "backuppb.SegmentIndexFiles": {
+            "type": "object",
+            "properties": {
+                "build_id": {
+                    "type": "integer"
+                },
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/backuppb.Binlog"
+                    }
+                },
+                "index_version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "backuppb.ShardChannel": {
+            "type": "object",
+            "properties": {
+                "physical_channel": {
+                    "type": "string"
+                },
+                "shard": {
+                    "description": "index of the shard in the virtual channels of the collection",
+                    "type": "integer"
+                },
+                "virtual_channel": {
+                    "type": "string"
+                }
+            }
+        },
         "backuppb.ValueField": {
             "type": "object",
             "properties": {
                 "data": {
-                    "description": "Types that are valid to be assigned to Data:\n\n\t*ValueField_BoolData\n\t*ValueField_IntData\n\t*ValueField_LongData\n\t*ValueField_FloatData\n\t*ValueField_DoubleData\n\t*ValueField_StringData\n\t*ValueField_BytesData"
+                    "description": "Types that are valid to be assigned to Data:\n\t*ValueField_BoolData\n\t*ValueField_IntData\n\t*ValueField_LongData\n\t*ValueField_FloatData\n\t*ValueField_DoubleData\n\t*ValueField_StringData\n\t*ValueField_BytesData"
+                }
+            }
+        },
+        "backuppb.VectorTransformerOption": {
+            "type": "object",
+            "properties": {
+                "collection_name": {
+                    "description": "collection in backup, format db.collection, db can be omitted for default db. empty means all collections",
+                    "type": "string"
+                },
+                "dim": {
+                    "description": "target dimension of pad_truncate",
+                    "type": "integer"
+                },
+                "field_name": {
+                    "description": "float vector field to transform",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "transformer name, noop or pad_truncate",
+                    "type": "string"
                 }
             }
         }
@@ -1102,6 +1884,8 @@ var SwaggerInfo = &swag.Spec{
 	Description:      "A data backup & restore tool for Milvus",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
+	LeftDelim:        "{{",
+	RightDelim:       "}}",
 }
 
 func init() {
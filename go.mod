@@ -32,6 +32,7 @@ require (
 	github.com/uber/jaeger-client-go v2.25.0+incompatible
 	go.uber.org/atomic v1.10.0
 	go.uber.org/zap v1.17.0
+	golang.org/x/crypto v0.14.0
 	golang.org/x/oauth2 v0.0.0-20210402161424-2e8d93401602
 	golang.org/x/sync v0.3.0
 	golang.org/x/time v0.0.0-20201208040808-7e3f01d25324
@@ -40,7 +41,10 @@ require (
 	gopkg.in/yaml.v3 v3.0.1
 )
 
-require github.com/milvus-io/milvus-proto/go-api/v2 v2.4.6
+require (
+	github.com/milvus-io/milvus-proto/go-api/v2 v2.4.6
+	google.golang.org/protobuf v1.33.0
+)
 
 require (
 	cloud.google.com/go v0.81.0 // indirect
@@ -100,7 +104,6 @@ require (
 	github.com/tidwall/pretty v1.2.0 // indirect
 	github.com/ugorji/go/codec v1.2.7 // indirect
 	go.uber.org/multierr v1.6.0 // indirect
-	golang.org/x/crypto v0.14.0 // indirect
 	golang.org/x/exp v0.0.0-20220303212507-bbda1eaf7a17 // indirect
 	golang.org/x/net v0.17.0 // indirect
 	golang.org/x/sys v0.13.0 // indirect
@@ -108,7 +111,6 @@ require (
 	golang.org/x/tools v0.11.0 // indirect
 	google.golang.org/appengine v1.6.7 // indirect
 	google.golang.org/genproto v0.0.0-20220503193339-ba3ae3f07e29 // indirect
-	google.golang.org/protobuf v1.33.0 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 )
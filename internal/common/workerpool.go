@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"go.uber.org/atomic"
+	"runtime/debug"
 	"sync"
 	"time"
 
@@ -67,7 +68,7 @@ func (p *WorkerPool) work() error {
 					return fmt.Errorf("workerpool: wait token %w", err)
 				}
 			}
-			if err := jobWithId.job(p.subCtx); err != nil {
+			if err := p.runJob(jobWithId.job); err != nil {
 				p.jobsError.Store(jobWithId.id, err)
 				p.jobsStatus.Store(jobWithId.id, "done")
 				p.jobNum.Dec()
@@ -81,6 +82,17 @@ func (p *WorkerPool) work() error {
 	return nil
 }
 
+// runJob runs a job, a panic of the job is returned as its error with the stack instead of crashing the process,
+// so the waiters of the job and the pool fail like on any other job error
+func (p *WorkerPool) runJob(job Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("workerpool: job panic: %v\n%s", r, debug.Stack())
+		}
+	}()
+	return job(p.subCtx)
+}
+
 func (p *WorkerPool) Submit(job Job) {
 	jobId := p.nextId.Inc()
 	p.jobNum.Inc()
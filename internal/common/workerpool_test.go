@@ -76,3 +76,24 @@ func TestWaitJobs(t *testing.T) {
 	assert.True(t, duration >= 8)
 	//wp.Done()
 }
+
+func TestRunTaskPanic(t *testing.T) {
+	wp, err := NewWorkerPool(context.Background(), 3, 0)
+	assert.Nil(t, err)
+
+	wp.Start()
+	okJob := wp.SubmitWithId(func(ctx context.Context) error {
+		return nil
+	})
+	assert.NoError(t, wp.WaitJobs([]int64{okJob}))
+	panicJob := wp.SubmitWithId(func(ctx context.Context) error {
+		panic("injected panic")
+	})
+	err = wp.WaitJobs([]int64{panicJob})
+	assert.ErrorContains(t, err, "injected panic")
+	assert.ErrorContains(t, err, "TestRunTaskPanic")
+	assert.Equal(t, int32(0), wp.JobNum())
+
+	wp.Done()
+	assert.ErrorContains(t, wp.Wait(), "injected panic")
+}
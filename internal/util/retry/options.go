@@ -17,6 +17,7 @@ type config struct {
 	attempts     uint
 	sleep        time.Duration
 	maxSleepTime time.Duration
+	jitter       float64
 }
 
 func newDefaultConfig() *config {
@@ -59,3 +60,17 @@ func MaxSleepTime(maxSleepTime time.Duration) Option {
 		}
 	}
 }
+
+// Jitter is used to randomize each interval within [sleep*(1-jitter), sleep*(1+jitter)],
+// so that concurrent retries do not fire at the same time. jitter is limited to [0, 1].
+func Jitter(jitter float64) Option {
+	return func(c *config) {
+		if jitter < 0 {
+			jitter = 0
+		}
+		if jitter > 1 {
+			jitter = 1
+		}
+		c.jitter = jitter
+	}
+}
@@ -13,6 +13,7 @@ package retry
 
 import (
 	"context"
+	"math/rand"
 	"time"
 
 	"go.uber.org/zap"
@@ -46,7 +47,7 @@ func Do(ctx context.Context, fn func() error, opts ...Option) error {
 			}
 
 			select {
-			case <-time.After(c.sleep):
+			case <-time.After(jitterSleep(c.sleep, c.jitter)):
 			case <-ctx.Done():
 				el = append(el, ctx.Err())
 				return el
@@ -63,6 +64,15 @@ func Do(ctx context.Context, fn func() error, opts ...Option) error {
 	return el
 }
 
+// jitterSleep returns a random duration in [sleep*(1-jitter), sleep*(1+jitter)]
+func jitterSleep(sleep time.Duration, jitter float64) time.Duration {
+	if jitter <= 0 || sleep <= 0 {
+		return sleep
+	}
+	delta := float64(sleep) * jitter
+	return time.Duration(float64(sleep) - delta + rand.Float64()*2*delta)
+}
+
 type unrecoverableError struct {
 	error
 }
@@ -73,6 +73,23 @@ func TestSleep(t *testing.T) {
 	fmt.Println(err)
 }
 
+func TestJitter(t *testing.T) {
+	ctx := context.Background()
+
+	testFn := func() error {
+		return fmt.Errorf("some error")
+	}
+
+	err := Do(ctx, testFn, Attempts(3), Sleep(100*time.Millisecond), Jitter(0.5))
+	assert.NotNil(t, err)
+
+	for i := 0; i < 100; i++ {
+		sleep := jitterSleep(time.Second, 0.2)
+		assert.True(t, sleep >= 800*time.Millisecond && sleep <= 1200*time.Millisecond)
+	}
+	assert.Equal(t, time.Second, jitterSleep(time.Second, 0))
+}
+
 func TestAllError(t *testing.T) {
 	ctx := context.Background()
 